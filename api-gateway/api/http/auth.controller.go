@@ -0,0 +1,89 @@
+package http
+
+import (
+	"errors"
+	"library-management-api/api-gateway/third-party/auth"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthController struct {
+	authClient auth.IClient
+}
+
+func NewAuthController(authClient auth.IClient) *AuthController {
+	return &AuthController{
+		authClient: authClient,
+	}
+}
+
+// sessionError answers an error of the auth service. A session that is not found is answered
+// with 404 rather than the 401 of the other session errors.
+func sessionError(c *gin.Context, err error) {
+	if errors.Is(err, errorhandler.ErrSessionNotFound) {
+		c.JSON(http.StatusNotFound, errorhandler.ErrorResponse(http.StatusNotFound, err))
+		return
+	}
+	abortWithError(c, err)
+}
+
+// Login handles POST requests for Auth login
+func (ac *AuthController) Login(c *gin.Context) {
+	var req dto.AuthLoginReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := ac.authClient.Login(callContext(c), req)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// Logout handles POST requests for Auth logout
+func (ac *AuthController) Logout(c *gin.Context) {
+	err := ac.authClient.Logout(callContext(c))
+	if err != nil {
+		sessionError(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// RefreshToken handles POST requests for refreshing a token
+func (ac *AuthController) RefreshToken(c *gin.Context) {
+	var req dto.AuthRefreshTokenReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := ac.authClient.RefreshToken(callContext(c), req)
+	if err != nil {
+		sessionError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// RevokeToken handles POST requests for revoking a token
+func (ac *AuthController) RevokeToken(c *gin.Context) {
+	var req dto.AuthRevokeTokenReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	err := ac.authClient.RevokeToken(callContext(c), req)
+	if err != nil {
+		sessionError(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
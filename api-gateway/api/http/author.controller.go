@@ -0,0 +1,134 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthorController struct {
+	booksClient books.IClient
+}
+
+func NewAuthorController(booksClient books.IClient) *AuthorController {
+	return &AuthorController{
+		booksClient: booksClient,
+	}
+}
+
+func (ac *AuthorController) AddAuthor(c *gin.Context) {
+	var addAuthorReq dto.AddAuthorReq
+	if err := c.ShouldBindJSON(&addAuthorReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := ac.booksClient.AddAuthor(callContext(c), addAuthorReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+func (ac *AuthorController) GetAuthors(c *gin.Context) {
+	var getAuthorsReq dto.GetAuthorsReq
+	if err := c.ShouldBindQuery(&getAuthorsReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := ac.booksClient.ListAuthors(callContext(c), getAuthorsReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (ac *AuthorController) GetAuthor(c *gin.Context) {
+	authorID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getAuthorReq := dto.GetAuthorReq{
+		ID: authorID,
+	}
+
+	res, err := ac.booksClient.GetAuthor(callContext(c), getAuthorReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (ac *AuthorController) UpdateAuthor(c *gin.Context) {
+	authorID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateAuthorReq dto.UpdateAuthorReq
+	if err := c.ShouldBindJSON(&updateAuthorReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateAuthorReq.ID = authorID
+
+	res, err := ac.booksClient.UpdateAuthor(callContext(c), updateAuthorReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (ac *AuthorController) DeleteAuthor(c *gin.Context) {
+	authorID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteAuthorReq := dto.DeleteAuthorReq{
+		ID: authorID,
+	}
+
+	err = ac.booksClient.DeleteAuthor(callContext(c), deleteAuthorReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, nil)
+}
+
+// AuthorBooks handles GET requests for the books credited to an author
+func (ac *AuthorController) AuthorBooks(c *gin.Context) {
+	authorID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	authorBooksReq := dto.AuthorBooksReq{
+		ID: authorID,
+	}
+	if err := c.ShouldBindQuery(&authorBooksReq.ListBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := ac.booksClient.AuthorBooks(callContext(c), authorBooksReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
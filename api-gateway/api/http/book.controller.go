@@ -0,0 +1,284 @@
+package http
+
+import (
+	"errors"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookController struct {
+	booksClient books.IClient
+}
+
+func NewBookController(booksClient books.IClient) *BookController {
+	return &BookController{
+		booksClient: booksClient,
+	}
+}
+
+func (bc *BookController) AddBook(c *gin.Context) {
+	var book dto.AddBookReq
+
+	if err := c.ShouldBindJSON(&book); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := bc.booksClient.AddBook(callContext(c), book)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+func (bc *BookController) GetBooks(c *gin.Context) {
+	var getBooksReq dto.GetBooksReq
+	if err := c.ShouldBindQuery(&getBooksReq.ListBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := bc.booksClient.ListBooks(callContext(c), getBooksReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (bc *BookController) GetBook(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getBookReq := dto.GetBookReq{
+		ID: bookID,
+	}
+
+	res, err := bc.booksClient.GetBook(callContext(c), getBookReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetBookByISBN handles GET requests for a book by its ISBN-10 or ISBN-13, with or without hyphens
+func (bc *BookController) GetBookByISBN(c *gin.Context) {
+	getBookByISBNReq := dto.GetBookByISBNReq{
+		ISBN: c.Param("isbn"),
+	}
+
+	res, err := bc.booksClient.GetBookByISBN(callContext(c), getBookByISBNReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (bc *BookController) UpdateBook(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateBookReq dto.UpdateBookReq
+	if err := c.ShouldBindJSON(&updateBookReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateBookReq.ID = bookID
+
+	res, err := bc.booksClient.UpdateBook(callContext(c), updateBookReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateBookTags handles PUT requests for replacing the tags of a book
+func (bc *BookController) UpdateBookTags(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateBookTagsReq dto.UpdateBookTagsReq
+	if err := c.ShouldBindJSON(&updateBookTagsReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateBookTagsReq.ID = bookID
+
+	res, err := bc.booksClient.UpdateBookTags(callContext(c), updateBookTagsReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (bc *BookController) DeleteBook(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteBookReq := dto.DeleteBookReq{
+		ID: bookID,
+	}
+
+	err = bc.booksClient.DeleteBook(callContext(c), deleteBookReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, nil)
+}
+
+func (bc *BookController) BorrowBook(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	borrowBookReq := dto.BorrowBookReq{
+		ID: bookID,
+	}
+
+	res, err := bc.booksClient.BorrowBook(callContext(c), borrowBookReq)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrBookNotFound) {
+			c.JSON(http.StatusConflict, errorhandler.ErrorResponse(http.StatusConflict, err))
+		} else {
+			abortWithError(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (bc *BookController) ReturnBook(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	returnBookReq := dto.ReturnBookReq{
+		ID: bookID,
+	}
+
+	res, err := bc.booksClient.ReturnBook(callContext(c), returnBookReq)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrBookNotFound) {
+			c.JSON(http.StatusConflict, errorhandler.ErrorResponse(http.StatusConflict, err))
+		} else {
+			abortWithError(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (bc *BookController) RenewBook(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	renewBookReq := dto.RenewBookReq{
+		ID: bookID,
+	}
+
+	res, err := bc.booksClient.RenewBook(callContext(c), renewBookReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// SearchBooks handles GET requests for searching books by free text, title, author, or category
+func (bc *BookController) SearchBooks(c *gin.Context) {
+	searchBooksReq := dto.SearchBooksReq{
+		Query:    c.Query("q"),
+		Title:    c.Query("title"),
+		Author:   c.Query("author"),
+		Category: c.Query("category"),
+	}
+	if err := c.ShouldBindQuery(&searchBooksReq.ListBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := bc.booksClient.SearchBooks(callContext(c), searchBooksReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (bc *BookController) CategoryBooks(c *gin.Context) {
+	categoryBooksReq := dto.CategoryBooksReq{
+		CategoryType:  c.Query("type"),
+		CategoryValue: c.Query("value"),
+	}
+	if err := c.ShouldBindQuery(&categoryBooksReq.ListBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := bc.booksClient.CategoryBooks(callContext(c), categoryBooksReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (bc *BookController) AvailableBooks(c *gin.Context) {
+	var availableBooksReq dto.AvailableBooksReq
+	if err := c.ShouldBindQuery(&availableBooksReq.ListBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := bc.booksClient.AvailableBooks(callContext(c), availableBooksReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// SuggestBooks handles GET requests for title and author completions of a typed prefix
+func (bc *BookController) SuggestBooks(c *gin.Context) {
+	var suggestBooksReq dto.SuggestBooksReq
+	if err := c.ShouldBindQuery(&suggestBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := bc.booksClient.SuggestBooks(callContext(c), suggestBooksReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
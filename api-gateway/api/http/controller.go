@@ -0,0 +1,37 @@
+package http
+
+import (
+	"context"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/grpcconn"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// callContext returns the context of the call to a service: it carries the access token set by
+// the auth middleware and the ID of the request, which is sent back to the client.
+func callContext(c *gin.Context) context.Context {
+	requestID := c.GetHeader(grpcconn.RequestIDHeader)
+	if requestID == "" {
+		requestID = grpcconn.NewRequestID()
+	}
+	c.Header(grpcconn.RequestIDHeader, requestID)
+
+	ctx := context.WithValue(c.Request.Context(), "token", c.GetString("token"))
+	return grpcconn.WithRequestID(ctx, requestID)
+}
+
+// paramID parses the id path parameter. IDs are int32 in the services' APIs, so larger ones are
+// rejected here rather than wrapped around.
+func paramID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 31)
+	return uint(id), err
+}
+
+// abortWithError answers an error returned by a service client with the HTTP status of its
+// sentinel. Handlers that answer a sentinel differently check for it before.
+func abortWithError(c *gin.Context, err error) {
+	statusCode := grpcconn.HTTPStatus(err)
+	c.JSON(statusCode, errorhandler.ErrorResponse(statusCode, err))
+}
@@ -0,0 +1,219 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"library-management-api/api-gateway/third-party/auth"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/grpcconn"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fakeBooksClient answers the calls the tests make with book, or with err when it is set. Any
+// other call panics on the nil embedded client.
+type fakeBooksClient struct {
+	books.IClient
+	book   dto.BookRes
+	err    error
+	token  string
+	called bool
+	// export is written before the export fails with err, if it is not empty.
+	export string
+}
+
+func (f *fakeBooksClient) GetBook(ctx context.Context, req dto.GetBookReq) (dto.BookRes, error) {
+	f.token, _ = ctx.Value("token").(string)
+	f.called = true
+	return f.book, f.err
+}
+
+func (f *fakeBooksClient) BorrowBook(ctx context.Context, req dto.BorrowBookReq) (dto.LoanRes, error) {
+	return dto.LoanRes{BookID: req.ID}, f.err
+}
+
+func (f *fakeBooksClient) ReturnBook(ctx context.Context, req dto.ReturnBookReq) (dto.LoanRes, error) {
+	return dto.LoanRes{BookID: req.ID}, f.err
+}
+
+func (f *fakeBooksClient) UpdateSubject(ctx context.Context, req dto.UpdateSubjectReq) (dto.SubjectRes, error) {
+	return dto.SubjectRes{}, f.err
+}
+
+func (f *fakeBooksClient) UpdateBookCover(ctx context.Context, req dto.UpdateBookCoverReq, image io.Reader) (dto.BookRes, error) {
+	return f.book, f.err
+}
+
+func (f *fakeBooksClient) ImportBooks(ctx context.Context, req dto.ImportBooksReq, file io.Reader) (dto.ImportReportRes, error) {
+	if req.Format != "csv" && req.Format != "ndjson" {
+		return dto.ImportReportRes{}, errorhandler.ErrInvalidImportFormat
+	}
+	return dto.ImportReportRes{}, f.err
+}
+
+func (f *fakeBooksClient) ExportBooks(ctx context.Context, req dto.ExportBooksReq, w books.FileWriter) error {
+	if f.export != "" {
+		w.Start("application/x-ndjson", "catalogue.ndjson")
+		io.WriteString(w, f.export)
+	}
+	return f.err
+}
+
+type fakeAuthClient struct {
+	auth.IClient
+	err error
+}
+
+func (f *fakeAuthClient) Logout(ctx context.Context) error {
+	return f.err
+}
+
+// serve routes one request the way the gateway does, with the token the auth middleware sets.
+func serve(booksClient books.IClient, authClient auth.IClient, method, path string, body io.Reader, header http.Header) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	bc := NewBookController(booksClient)
+	r := gin.New()
+	r.Use(func(c *gin.Context) { c.Set("token", "secret") })
+	r.GET("/books/:id", bc.GetBook)
+	r.POST("/books/borrow/:id", bc.BorrowBook)
+	r.POST("/books/return/:id", bc.ReturnBook)
+	r.PUT("/books/:id/cover", NewCoverController(booksClient).UpdateBookCover)
+	r.POST("/books/import", NewImportController(booksClient).ImportBooks)
+	r.GET("/books/export", NewExportController(booksClient).ExportBooks)
+	r.PUT("/subjects/:id", NewSubjectController(booksClient).UpdateSubject)
+	r.POST("/logout", NewAuthController(authClient).Logout)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, body)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestGetBook(t *testing.T) {
+	client := &fakeBooksClient{book: dto.BookRes{ID: 7, Title: "Dune"}}
+
+	w := serve(client, nil, http.MethodGet, "/books/7", nil, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET /books/7 status = %d, body %s", w.Code, w.Body)
+	}
+	if client.token != "secret" {
+		t.Errorf("token passed on = %q, want secret", client.token)
+	}
+	if w.Header().Get(grpcconn.RequestIDHeader) == "" {
+		t.Errorf("no %s header", grpcconn.RequestIDHeader)
+	}
+	var res dto.BookRes
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || res.Title != "Dune" {
+		t.Errorf("body %s, error %v", w.Body, err)
+	}
+
+	w = serve(client, nil, http.MethodGet, "/books/7", nil, http.Header{grpcconn.RequestIDHeader: {"req-1"}})
+	if got := w.Header().Get(grpcconn.RequestIDHeader); got != "req-1" {
+		t.Errorf("%s = %q, want the one sent, req-1", grpcconn.RequestIDHeader, got)
+	}
+}
+
+// TestGetBookIDOutOfRange checks that an id the books service cannot take is rejected rather
+// than wrapped around into another book's.
+func TestGetBookIDOutOfRange(t *testing.T) {
+	client := &fakeBooksClient{}
+	w := serve(client, nil, http.MethodGet, "/books/2147483648", nil, nil)
+	if w.Code != http.StatusBadRequest || client.called {
+		t.Errorf("status = %d, called = %v, want 400 without a call", w.Code, client.called)
+	}
+}
+
+// TestErrors checks that the gateway answers with the status codes the services answered with,
+// including those of the routes that answer a sentinel differently from the others.
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		err    error
+		want   int
+	}{
+		{http.MethodGet, "/books/x", nil, http.StatusBadRequest},
+		{http.MethodGet, "/books/7", errorhandler.ErrBookNotFound, http.StatusNotFound},
+		{http.MethodGet, "/books/7", errorhandler.ErrInvalidSession, http.StatusUnauthorized},
+		{http.MethodGet, "/books/7", errorhandler.ErrForbidden, http.StatusForbidden},
+		{http.MethodGet, "/books/7", errorhandler.ErrAuthUnavailable, http.StatusServiceUnavailable},
+		{http.MethodGet, "/books/7", errorhandler.ErrServiceUnavailable, http.StatusServiceUnavailable},
+		{http.MethodGet, "/books/7", fmt.Errorf("boom"), http.StatusInternalServerError},
+		{http.MethodPost, "/books/borrow/7", errorhandler.ErrBookNotFound, http.StatusConflict},
+		{http.MethodPost, "/books/borrow/7", errorhandler.ErrLoanLimitReached, http.StatusConflict},
+		{http.MethodPost, "/books/borrow/7", errorhandler.ErrOutstandingFines, http.StatusConflict},
+		{http.MethodPost, "/books/borrow/7", errorhandler.ErrForbidden, http.StatusForbidden},
+		{http.MethodPost, "/books/return/7", errorhandler.ErrBookNotFound, http.StatusConflict},
+		{http.MethodPost, "/books/return/7", errorhandler.ErrBorrowerIDMismatch, http.StatusConflict},
+		{http.MethodPost, "/books/return/7", errorhandler.ErrServiceUnavailable, http.StatusServiceUnavailable},
+		{http.MethodPut, "/books/7/cover", errorhandler.ErrUnsupportedCoverType, http.StatusUnsupportedMediaType},
+		{http.MethodPut, "/books/7/cover", errorhandler.ErrCoverTooLarge, http.StatusRequestEntityTooLarge},
+		{http.MethodPost, "/books/import?format=csv", errorhandler.ErrImportTooLarge, http.StatusRequestEntityTooLarge},
+		{http.MethodPost, "/books/import?format=xlsx", nil, http.StatusBadRequest},
+		{http.MethodPut, "/subjects/3", errorhandler.ErrSubjectCycle, http.StatusBadRequest},
+		{http.MethodPut, "/subjects/3", errorhandler.ErrParentNotFound, http.StatusBadRequest},
+		{http.MethodPut, "/subjects/3", errorhandler.ErrDuplicateSubject, http.StatusConflict},
+		{http.MethodPost, "/logout", errorhandler.ErrSessionNotFound, http.StatusNotFound},
+		{http.MethodPost, "/logout", errorhandler.ErrSessionRevoked, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s %v", tt.method, tt.path, tt.err), func(t *testing.T) {
+			body := strings.NewReader(`{"name":"Science"}`)
+			w := serve(&fakeBooksClient{err: tt.err}, &fakeAuthClient{err: tt.err}, tt.method, tt.path, body, nil)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d (body %s)", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+}
+
+func TestImportFormatFromContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        int
+	}{
+		{"text/csv; charset=utf-8", http.StatusOK},
+		{"application/x-ndjson", http.StatusOK},
+		{"application/jsonl", http.StatusOK},
+		{"application/pdf", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		w := serve(&fakeBooksClient{}, nil, http.MethodPost, "/books/import", strings.NewReader(""), http.Header{"Content-Type": {tt.contentType}})
+		if w.Code != tt.want {
+			t.Errorf("Content-Type %s: status = %d, want %d (body %s)", tt.contentType, w.Code, tt.want, w.Body)
+		}
+	}
+}
+
+func TestExportBooks(t *testing.T) {
+	w := serve(&fakeBooksClient{export: "{\"id\":1}\n"}, nil, http.MethodGet, "/books/export", nil, nil)
+	if w.Code != http.StatusOK || w.Body.String() != "{\"id\":1}\n" {
+		t.Fatalf("status = %d, body %q", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="catalogue.ndjson"` {
+		t.Errorf("Content-Disposition = %q", got)
+	}
+
+	// A failure before the file starts is answered on its own
+	w = serve(&fakeBooksClient{err: errorhandler.ErrForbidden}, nil, http.MethodGet, "/books/export", nil, nil)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want 403 (body %s)", w.Code, w.Body)
+	}
+
+	// Once it has started, the file is only cut short
+	w = serve(&fakeBooksClient{export: "{\"id\":1}\n", err: errorhandler.ErrServiceUnavailable}, nil, http.MethodGet, "/books/export", nil, nil)
+	if w.Code != http.StatusOK || w.Body.String() != "{\"id\":1}\n" {
+		t.Errorf("status = %d, body %q, want the part sent", w.Code, w.Body)
+	}
+}
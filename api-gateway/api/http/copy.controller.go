@@ -0,0 +1,106 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CopyController struct {
+	booksClient books.IClient
+}
+
+func NewCopyController(booksClient books.IClient) *CopyController {
+	return &CopyController{
+		booksClient: booksClient,
+	}
+}
+
+// AddCopy handles POST requests for registering a physical copy of a book
+func (cc *CopyController) AddCopy(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var addCopyReq dto.AddCopyReq
+	if err := c.ShouldBindJSON(&addCopyReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	addCopyReq.BookID = bookID
+
+	res, err := cc.booksClient.AddCopy(callContext(c), addCopyReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetCopies handles GET requests for listing the copies of a book
+func (cc *CopyController) GetCopies(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getCopiesReq := dto.GetCopiesReq{
+		BookID: bookID,
+	}
+
+	res, err := cc.booksClient.ListCopies(callContext(c), getCopiesReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateCopy handles PUT requests for updating a copy
+func (cc *CopyController) UpdateCopy(c *gin.Context) {
+	copyID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateCopyReq dto.UpdateCopyReq
+	if err := c.ShouldBindJSON(&updateCopyReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateCopyReq.ID = copyID
+
+	res, err := cc.booksClient.UpdateCopy(callContext(c), updateCopyReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteCopy handles DELETE requests for withdrawing a copy
+func (cc *CopyController) DeleteCopy(c *gin.Context) {
+	copyID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteCopyReq := dto.DeleteCopyReq{
+		ID: copyID,
+	}
+
+	err = cc.booksClient.DeleteCopy(callContext(c), deleteCopyReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CoverController struct {
+	booksClient books.IClient
+}
+
+func NewCoverController(booksClient books.IClient) *CoverController {
+	return &CoverController{
+		booksClient: booksClient,
+	}
+}
+
+// UpdateBookCover handles PUT requests whose body is the cover image of a book. The image type
+// is taken from its content, not from the Content-Type header.
+func (cc *CoverController) UpdateBookCover(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	updateBookCoverReq := dto.UpdateBookCoverReq{
+		ID: bookID,
+	}
+
+	res, err := cc.booksClient.UpdateBookCover(callContext(c), updateBookCoverReq, c.Request.Body)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrUnsupportedCoverType) {
+			c.JSON(http.StatusUnsupportedMediaType, errorhandler.ErrorResponse(http.StatusUnsupportedMediaType, err))
+		} else {
+			abortWithError(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (cc *CoverController) DeleteBookCover(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteBookCoverReq := dto.DeleteBookCoverReq{
+		ID: bookID,
+	}
+
+	err = cc.booksClient.DeleteBookCover(callContext(c), deleteBookCoverReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, nil)
+}
+
+// GetBookCover serves the cover image of a book, or its thumbnail with size=thumbnail.
+// Conditional requests are answered by http.ServeContent, with the storage key as the ETag.
+func (cc *CoverController) GetBookCover(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var getBookCoverReq dto.GetBookCoverReq
+	if err := c.ShouldBindQuery(&getBookCoverReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	getBookCoverReq.ID = bookID
+
+	coverImage, err := cc.booksClient.GetBookCover(callContext(c), getBookCoverReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", coverImage.ContentType)
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Header("ETag", strconv.Quote(coverImage.Key))
+	http.ServeContent(c.Writer, c.Request, "", coverImage.UpdatedAt, bytes.NewReader(coverImage.Data))
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+type ExportController struct {
+	booksClient books.IClient
+}
+
+func NewExportController(booksClient books.IClient) *ExportController {
+	return &ExportController{
+		booksClient: booksClient,
+	}
+}
+
+// exportWriter writes an exported file to the client, sending the response headers once the
+// books service starts the file.
+type exportWriter struct {
+	c       *gin.Context
+	started bool
+}
+
+func (w *exportWriter) Start(contentType, filename string) {
+	w.started = true
+	w.c.Header("Content-Type", contentType)
+	w.c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.c.Status(http.StatusOK)
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	return w.c.Writer.Write(p)
+}
+
+// exportError answers an export that failed. Failures before the file started, such as an
+// invalid session, are answered with a JSON error.
+func exportError(c *gin.Context, w *exportWriter, err error) {
+	if w.started {
+		// The status line is already sent; all that is left is to cut the response short
+		log.Error().Err(err).Msg("catalogue export interrupted")
+		return
+	}
+	abortWithError(c, err)
+}
+
+// ExportBooks handles GET requests for the whole catalogue as CSV, JSON Lines or Dublin Core XML
+func (ec *ExportController) ExportBooks(c *gin.Context) {
+	var exportBooksReq dto.ExportBooksReq
+	if err := c.ShouldBindQuery(&exportBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	w := &exportWriter{c: c}
+	if err := ec.booksClient.ExportBooks(callContext(c), exportBooksReq, w); err != nil {
+		exportError(c, w, err)
+	}
+}
+
+// ExportMarc handles GET requests for the whole catalogue as MARC records, in MARCXML unless
+// binary ISO 2709 is asked for
+func (ec *ExportController) ExportMarc(c *gin.Context) {
+	var exportMarcReq dto.ExportMarcReq
+	if err := c.ShouldBindQuery(&exportMarcReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	w := &exportWriter{c: c}
+	if err := ec.booksClient.ExportMarc(callContext(c), exportMarcReq, w); err != nil {
+		exportError(c, w, err)
+	}
+}
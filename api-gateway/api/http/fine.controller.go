@@ -0,0 +1,96 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FineController struct {
+	booksClient books.IClient
+}
+
+func NewFineController(booksClient books.IClient) *FineController {
+	return &FineController{
+		booksClient: booksClient,
+	}
+}
+
+// GetMyFines handles GET requests for the fine balance of the caller
+func (fc *FineController) GetMyFines(c *gin.Context) {
+	res, err := fc.booksClient.GetMyFines(callContext(c))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetUserFines handles GET requests for the fine balance of a single patron
+func (fc *FineController) GetUserFines(c *gin.Context) {
+	userID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getUserFinesReq := dto.GetUserFinesReq{
+		UserID: userID,
+	}
+
+	res, err := fc.booksClient.GetUserFines(callContext(c), getUserFinesReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// RecordPayment handles POST requests for recording a fine payment
+func (fc *FineController) RecordPayment(c *gin.Context) {
+	userID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var settleFineReq dto.SettleFineReq
+	if err := c.ShouldBindJSON(&settleFineReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	settleFineReq.UserID = userID
+
+	res, err := fc.booksClient.RecordPayment(callContext(c), settleFineReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// WaiveFine handles POST requests for waiving fines
+func (fc *FineController) WaiveFine(c *gin.Context) {
+	userID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var settleFineReq dto.SettleFineReq
+	if err := c.ShouldBindJSON(&settleFineReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	settleFineReq.UserID = userID
+
+	res, err := fc.booksClient.WaiveFine(callContext(c), settleFineReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
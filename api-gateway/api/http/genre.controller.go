@@ -0,0 +1,108 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GenreController struct {
+	booksClient books.IClient
+}
+
+func NewGenreController(booksClient books.IClient) *GenreController {
+	return &GenreController{
+		booksClient: booksClient,
+	}
+}
+
+func (gc *GenreController) AddGenre(c *gin.Context) {
+	var addGenreReq dto.AddGenreReq
+	if err := c.ShouldBindJSON(&addGenreReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := gc.booksClient.AddGenre(callContext(c), addGenreReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+func (gc *GenreController) GetGenres(c *gin.Context) {
+	res, err := gc.booksClient.ListGenres(callContext(c))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (gc *GenreController) UpdateGenre(c *gin.Context) {
+	genreID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateGenreReq dto.UpdateGenreReq
+	if err := c.ShouldBindJSON(&updateGenreReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateGenreReq.ID = genreID
+
+	res, err := gc.booksClient.UpdateGenre(callContext(c), updateGenreReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (gc *GenreController) DeleteGenre(c *gin.Context) {
+	genreID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteGenreReq := dto.DeleteGenreReq{
+		ID: genreID,
+	}
+
+	err = gc.booksClient.DeleteGenre(callContext(c), deleteGenreReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, nil)
+}
+
+// MergeGenre folds the genre of the path into the genre given by into_id and responds with the latter.
+func (gc *GenreController) MergeGenre(c *gin.Context) {
+	genreID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var mergeGenreReq dto.MergeGenreReq
+	if err := c.ShouldBindJSON(&mergeGenreReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	mergeGenreReq.ID = genreID
+
+	res, err := gc.booksClient.MergeGenre(callContext(c), mergeGenreReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
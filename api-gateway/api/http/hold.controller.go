@@ -0,0 +1,93 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HoldController struct {
+	booksClient books.IClient
+}
+
+func NewHoldController(booksClient books.IClient) *HoldController {
+	return &HoldController{
+		booksClient: booksClient,
+	}
+}
+
+// PlaceHold handles POST requests for joining the hold queue of a book
+func (hc *HoldController) PlaceHold(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var placeHoldReq dto.PlaceHoldReq
+	if err := c.ShouldBindQuery(&placeHoldReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	placeHoldReq.BookID = bookID
+
+	res, err := hc.booksClient.PlaceHold(callContext(c), placeHoldReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetMyHolds handles GET requests for the holds of the caller
+func (hc *HoldController) GetMyHolds(c *gin.Context) {
+	res, err := hc.booksClient.ListMyHolds(callContext(c))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetBookHolds handles GET requests for the hold queue of a book
+func (hc *HoldController) GetBookHolds(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getBookHoldsReq := dto.GetBookHoldsReq{
+		BookID: bookID,
+	}
+
+	res, err := hc.booksClient.ListBookHolds(callContext(c), getBookHoldsReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// CancelHold handles DELETE requests for withdrawing a hold
+func (hc *HoldController) CancelHold(c *gin.Context) {
+	holdID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	cancelHoldReq := dto.CancelHoldReq{
+		ID: holdID,
+	}
+
+	res, err := hc.booksClient.CancelHold(callContext(c), cancelHoldReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
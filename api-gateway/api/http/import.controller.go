@@ -0,0 +1,88 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ImportController struct {
+	booksClient books.IClient
+}
+
+func NewImportController(booksClient books.IClient) *ImportController {
+	return &ImportController{
+		booksClient: booksClient,
+	}
+}
+
+// importFormat picks the decoder of the request body from the format query parameter,
+// falling back to the Content-Type header.
+func importFormat(c *gin.Context, importBooksReq dto.ImportBooksReq) string {
+	if importBooksReq.Format != "" {
+		return strings.ToLower(importBooksReq.Format)
+	}
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		return "csv"
+	case "application/x-ndjson", "application/ndjson", "application/jsonl", "application/x-jsonlines":
+		return "ndjson"
+	}
+	return mediaType
+}
+
+// importMarcFormat picks the MARC decoder of the request body from the format query parameter,
+// falling back to the Content-Type header.
+func importMarcFormat(c *gin.Context, importMarcReq dto.ImportMarcReq) string {
+	if importMarcReq.Format != "" {
+		return importMarcReq.Format
+	}
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	switch mediaType {
+	case "application/marc":
+		return "iso2709"
+	case "application/marcxml+xml", "application/xml", "text/xml":
+		return "marcxml"
+	}
+	return mediaType
+}
+
+// ImportBooks handles POST requests that add a CSV or JSON Lines file of books to the catalogue
+func (ic *ImportController) ImportBooks(c *gin.Context) {
+	var importBooksReq dto.ImportBooksReq
+	if err := c.ShouldBindQuery(&importBooksReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	importBooksReq.Format = importFormat(c, importBooksReq)
+
+	res, err := ic.booksClient.ImportBooks(callContext(c), importBooksReq, c.Request.Body)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ImportMarc handles POST requests that add a file of MARC records to the catalogue
+func (ic *ImportController) ImportMarc(c *gin.Context) {
+	var importMarcReq dto.ImportMarcReq
+	if err := c.ShouldBindQuery(&importMarcReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	importMarcReq.Format = importMarcFormat(c, importMarcReq)
+
+	res, err := ic.booksClient.ImportMarc(callContext(c), importMarcReq, c.Request.Body)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
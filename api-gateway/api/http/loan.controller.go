@@ -0,0 +1,80 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LoanController struct {
+	booksClient books.IClient
+}
+
+func NewLoanController(booksClient books.IClient) *LoanController {
+	return &LoanController{
+		booksClient: booksClient,
+	}
+}
+
+// GetLoans handles GET requests for the loan history of the whole library
+func (lc *LoanController) GetLoans(c *gin.Context) {
+	res, err := lc.booksClient.ListLoans(callContext(c))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetUserLoans handles GET requests for the loans of a single patron
+func (lc *LoanController) GetUserLoans(c *gin.Context) {
+	userID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getUserLoansReq := dto.GetUserLoansReq{
+		UserID: userID,
+	}
+
+	res, err := lc.booksClient.ListUserLoans(callContext(c), getUserLoansReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetBookLoans handles GET requests for the loan history of a book
+func (lc *LoanController) GetBookLoans(c *gin.Context) {
+	bookID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getBookLoansReq := dto.GetBookLoansReq{
+		BookID: bookID,
+	}
+
+	res, err := lc.booksClient.ListBookLoans(callContext(c), getBookLoansReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetMyLoans handles GET requests for the loans of the caller
+func (lc *LoanController) GetMyLoans(c *gin.Context) {
+	res, err := lc.booksClient.ListMyLoans(callContext(c))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OaiController struct {
+	booksClient books.IClient
+}
+
+func NewOaiController(booksClient books.IClient) *OaiController {
+	return &OaiController{
+		booksClient: booksClient,
+	}
+}
+
+// protocolValues returns the parameters of a protocol request, sent either as GET query
+// parameters or as a POST form.
+func protocolValues(c *gin.Context) (url.Values, error) {
+	if c.Request.Method != http.MethodPost {
+		return c.Request.URL.Query(), nil
+	}
+	if err := c.Request.ParseForm(); err != nil {
+		return nil, err
+	}
+	return c.Request.PostForm, nil
+}
+
+// Harvest handles OAI-PMH requests. Protocol errors are reported inside the XML response, which
+// is always sent with status 200; only failures of the service itself are answered with an HTTP
+// error.
+func (oc *OaiController) Harvest(c *gin.Context) {
+	values, err := protocolValues(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	doc, err := oc.booksClient.Harvest(callContext(c), values)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, doc.ContentType, doc.Data)
+}
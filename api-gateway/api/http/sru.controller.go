@@ -0,0 +1,37 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SruController struct {
+	booksClient books.IClient
+}
+
+func NewSruController(booksClient books.IClient) *SruController {
+	return &SruController{
+		booksClient: booksClient,
+	}
+}
+
+// SearchRetrieve handles SRU 2.0 requests. A request without a query is answered with the
+// explain record. Diagnostics are reported inside the XML response, which is sent with status
+// 200; only failures of the service itself are answered with an HTTP error.
+func (sc *SruController) SearchRetrieve(c *gin.Context) {
+	values, err := protocolValues(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	doc, err := sc.booksClient.SearchRetrieve(callContext(c), values)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, doc.ContentType, doc.Data)
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"errors"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SubjectController struct {
+	booksClient books.IClient
+}
+
+func NewSubjectController(booksClient books.IClient) *SubjectController {
+	return &SubjectController{
+		booksClient: booksClient,
+	}
+}
+
+func (sc *SubjectController) AddSubject(c *gin.Context) {
+	var addSubjectReq dto.AddSubjectReq
+	if err := c.ShouldBindJSON(&addSubjectReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := sc.booksClient.AddSubject(callContext(c), addSubjectReq)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrParentNotFound) {
+			c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		} else {
+			abortWithError(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+func (sc *SubjectController) GetSubjects(c *gin.Context) {
+	res, err := sc.booksClient.ListSubjects(callContext(c))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (sc *SubjectController) UpdateSubject(c *gin.Context) {
+	subjectID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateSubjectReq dto.UpdateSubjectReq
+	if err := c.ShouldBindJSON(&updateSubjectReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateSubjectReq.ID = subjectID
+
+	res, err := sc.booksClient.UpdateSubject(callContext(c), updateSubjectReq)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrParentNotFound) || errors.Is(err, errorhandler.ErrSubjectCycle) {
+			c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		} else {
+			abortWithError(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (sc *SubjectController) DeleteSubject(c *gin.Context) {
+	subjectID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteSubjectReq := dto.DeleteSubjectReq{
+		ID: subjectID,
+	}
+
+	err = sc.booksClient.DeleteSubject(callContext(c), deleteSubjectReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, nil)
+}
+
+// MergeSubject folds the subject of the path into the subject given by into_id and responds with the latter.
+func (sc *SubjectController) MergeSubject(c *gin.Context) {
+	subjectID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var mergeSubjectReq dto.MergeSubjectReq
+	if err := c.ShouldBindJSON(&mergeSubjectReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	mergeSubjectReq.ID = subjectID
+
+	res, err := sc.booksClient.MergeSubject(callContext(c), mergeSubjectReq)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrSubjectCycle) {
+			c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		} else {
+			abortWithError(c, err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
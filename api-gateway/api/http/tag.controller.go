@@ -0,0 +1,85 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagController struct {
+	booksClient books.IClient
+}
+
+func NewTagController(booksClient books.IClient) *TagController {
+	return &TagController{
+		booksClient: booksClient,
+	}
+}
+
+func (tc *TagController) AddTag(c *gin.Context) {
+	var addTagReq dto.AddTagReq
+	if err := c.ShouldBindJSON(&addTagReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := tc.booksClient.AddTag(callContext(c), addTagReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+func (tc *TagController) GetTags(c *gin.Context) {
+	res, err := tc.booksClient.ListTags(callContext(c))
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (tc *TagController) UpdateTag(c *gin.Context) {
+	tagID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateTagReq dto.UpdateTagReq
+	if err := c.ShouldBindJSON(&updateTagReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateTagReq.ID = tagID
+
+	res, err := tc.booksClient.UpdateTag(callContext(c), updateTagReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (tc *TagController) DeleteTag(c *gin.Context) {
+	tagID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteTagReq := dto.DeleteTagReq{
+		ID: tagID,
+	}
+
+	err = tc.booksClient.DeleteTag(callContext(c), deleteTagReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, nil)
+}
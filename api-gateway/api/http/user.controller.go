@@ -0,0 +1,114 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/users"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserController struct {
+	usersClient users.IClient
+}
+
+func NewUserController(usersClient users.IClient) *UserController {
+	return &UserController{
+		usersClient: usersClient,
+	}
+}
+
+func (uc *UserController) AddUser(c *gin.Context) {
+	var user dto.AddUserReq
+	if err := c.ShouldBindJSON(&user); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := uc.usersClient.AddUser(callContext(c), user)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetUsers handles GET requests for retrieving all users
+func (uc *UserController) GetUsers(c *gin.Context) {
+	var getUsersReq dto.GetUsersReq
+	if err := c.ShouldBindQuery(&getUsersReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := uc.usersClient.ListUsers(callContext(c), getUsersReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetUserByID handles GET requests for retrieving a single user by ID
+func (uc *UserController) GetUserByID(c *gin.Context) {
+	userID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getUserReq := dto.GetUserReq{
+		ID: userID,
+	}
+
+	res, err := uc.usersClient.GetUserByID(callContext(c), getUserReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateUser handles PUT requests for updating a user
+func (uc *UserController) UpdateUser(c *gin.Context) {
+	userID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	var updateUserReq dto.UpdateUserReq
+	if err := c.ShouldBindJSON(&updateUserReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+	updateUserReq.ID = userID
+
+	res, err := uc.usersClient.UpdateUser(callContext(c), updateUserReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteUser handles DELETE requests for deleting a user
+func (uc *UserController) DeleteUser(c *gin.Context) {
+	userID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	deleteUserReq := dto.DeleteUserReq{
+		ID: userID,
+	}
+
+	err = uc.usersClient.DeleteUser(callContext(c), deleteUserReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
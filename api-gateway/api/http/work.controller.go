@@ -0,0 +1,54 @@
+package http
+
+import (
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkController struct {
+	booksClient books.IClient
+}
+
+func NewWorkController(booksClient books.IClient) *WorkController {
+	return &WorkController{
+		booksClient: booksClient,
+	}
+}
+
+func (wc *WorkController) AddWork(c *gin.Context) {
+	var addWorkReq dto.AddWorkReq
+	if err := c.ShouldBindJSON(&addWorkReq); err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	res, err := wc.booksClient.AddWork(callContext(c), addWorkReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+func (wc *WorkController) GetWork(c *gin.Context) {
+	workID, err := paramID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorhandler.ErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	getWorkReq := dto.GetWorkReq{
+		ID: workID,
+	}
+
+	res, err := wc.booksClient.GetWork(callContext(c), getWorkReq)
+	if err != nil {
+		abortWithError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
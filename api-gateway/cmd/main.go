@@ -1,13 +1,11 @@
 package main
 
 import (
+	"library-management-api/api-gateway/configs"
 	"library-management-api/api-gateway/routes"
-	authConfigs "library-management-api/auth-service/configs"
-	authDB "library-management-api/auth-service/init/database"
-	bookConfigs "library-management-api/books-service/configs"
-	bookDB "library-management-api/books-service/init/database"
-	userConfigs "library-management-api/users-service/configs"
-	userDB "library-management-api/users-service/init/database"
+	"library-management-api/api-gateway/third-party/auth"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/api-gateway/third-party/users"
 	"net/http"
 	"os"
 
@@ -18,19 +16,38 @@ import (
 
 func init() {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
-	authConfigs.RunConfig("auth-service")
-	userConfigs.RunConfig("users-service")
-	bookConfigs.RunConfig("books-service")
-	authDB.RunDB()
-	userDB.RunDB()
-	bookDB.RunDB()
+	configs.RunConfig("api-gateway")
 }
 
 func main() {
+	// The connections are established lazily, so the services may start after the gateway.
+	authClient, err := auth.NewClient()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create auth client")
+	}
+	usersClient, err := users.NewClient()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create users client")
+	}
+	booksClient, err := books.NewClient()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create books client")
+	}
+
 	r := gin.Default()
-	routes.AuthRoutes(r)
-	routes.UserRoutes(r)
-	routes.BookRoutes(r)
+	routes.AuthRoutes(r, authClient)
+	routes.UserRoutes(r, usersClient)
+	routes.BookRoutes(r, booksClient)
+	routes.AuthorRoutes(r, booksClient)
+	routes.WorkRoutes(r, booksClient)
+	routes.TagRoutes(r, booksClient)
+	routes.SubjectRoutes(r, booksClient)
+	routes.GenreRoutes(r, booksClient)
+	routes.LoanRoutes(r, booksClient)
+	routes.HoldRoutes(r, booksClient)
+	routes.FineRoutes(r, booksClient)
+	routes.OaiRoutes(r, booksClient)
+	routes.SruRoutes(r, booksClient)
 
 	r.NoRoute(func(c *gin.Context) {
 		log.Warn().Str("path", c.Request.URL.Path).Int("status", http.StatusNotFound).Str("status_text", http.StatusText(http.StatusNotFound)).Msg("page not found")
@@ -39,8 +56,9 @@ func main() {
 
 	r.Static("/swagger", "./util/swagger")
 
-	log.Info().Msg("Starting api-gateway on :8080")
-	err := http.ListenAndServe(":8080", r)
+	address := configs.C().Server.Address
+	log.Info().Msgf("Starting api-gateway on %s", address)
+	err = http.ListenAndServe(address, r)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to start api gateway service")
 	}
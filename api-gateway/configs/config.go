@@ -0,0 +1,150 @@
+package configs
+
+import (
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"library-management-api/util/grpcconn"
+)
+
+// Config holds the application-wide configurations.
+// The values are read by viper from the config file or environment variables.
+type Config struct {
+	Server   Server   `mapstructure:"server"`
+	Services Services `mapstructure:"services"`
+}
+
+// Server holds the address the gateway listens on for HTTP requests.
+type Server struct {
+	Address string `mapstructure:"address"`
+}
+
+// Services holds how to reach the services the gateway calls, and the policy of the calls.
+type Services struct {
+	Auth  grpcconn.Peer `mapstructure:"auth"`
+	Users grpcconn.Peer `mapstructure:"users"`
+	Books grpcconn.Peer `mapstructure:"books"`
+}
+
+var c *Config
+
+// C returns the loaded configuration globally.
+func C() *Config {
+	if c == nil {
+		log.Fatal().Msg("Configuration not initialized. Call RunConfig() first.")
+	}
+	return c
+}
+
+// LoadConfig reads configuration from file or environment variables.
+func LoadConfig(path string) (*Config, error) {
+	v := viper.New()
+
+	// Set default values for the configuration.
+	setDefaults(v)
+
+	// Read from environment variables
+	v.AutomaticEnv()
+
+	// Try to read from config file, but continue if not found
+	v.AddConfigPath(path)
+	v.SetConfigName("config")
+	v.SetConfigType("json")
+
+	// Try to read config file, but log the error instead of failing
+	if err := v.ReadInConfig(); err != nil {
+		log.Warn().Msgf("No config file found; using environment variables: %v", err)
+	}
+
+	// Unmarshal the configuration into the config struct
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("could not unmarshal config: %w", err)
+	}
+
+	// Validate essential configuration values
+	if err := validateServerConfig(config.Server); err != nil {
+		return nil, err
+	}
+	if err := validateServicesConfig(config.Services); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// setDefaults sets default configuration values in viper.
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("server.address", ":8080")
+	v.SetDefault("services.auth.addresses", []string{"localhost:8081"})
+	v.SetDefault("services.auth.dial_timeout", "5s")
+	v.SetDefault("services.auth.keepalive_time", "30s")
+	v.SetDefault("services.auth.keepalive_timeout", "10s")
+	v.SetDefault("services.auth.policy.call_timeout", "2s")
+	v.SetDefault("services.auth.policy.max_retries", 2)
+	v.SetDefault("services.auth.policy.retry_backoff", "100ms")
+	v.SetDefault("services.auth.policy.max_retry_backoff", "1s")
+	v.SetDefault("services.auth.policy.breaker_failures", 5)
+	v.SetDefault("services.auth.policy.breaker_cooldown", "30s")
+	v.SetDefault("services.users.addresses", []string{"localhost:8082"})
+	v.SetDefault("services.users.dial_timeout", "5s")
+	v.SetDefault("services.users.keepalive_time", "30s")
+	v.SetDefault("services.users.keepalive_timeout", "10s")
+	v.SetDefault("services.users.policy.call_timeout", "2s")
+	v.SetDefault("services.users.policy.max_retries", 2)
+	v.SetDefault("services.users.policy.retry_backoff", "100ms")
+	v.SetDefault("services.users.policy.max_retry_backoff", "1s")
+	v.SetDefault("services.users.policy.breaker_failures", 5)
+	v.SetDefault("services.users.policy.breaker_cooldown", "30s")
+	v.SetDefault("services.books.addresses", []string{"localhost:8083"})
+	v.SetDefault("services.books.dial_timeout", "5s")
+	v.SetDefault("services.books.keepalive_time", "30s")
+	v.SetDefault("services.books.keepalive_timeout", "10s")
+	v.SetDefault("services.books.policy.call_timeout", "2s")
+	v.SetDefault("services.books.policy.max_retries", 2)
+	v.SetDefault("services.books.policy.retry_backoff", "100ms")
+	v.SetDefault("services.books.policy.max_retry_backoff", "1s")
+	v.SetDefault("services.books.policy.breaker_failures", 5)
+	v.SetDefault("services.books.policy.breaker_cooldown", "30s")
+}
+
+// validateServerConfig ensures that the gateway has an address to listen on.
+func validateServerConfig(serverConfig Server) error {
+	if serverConfig.Address == "" {
+		return fmt.Errorf("server address is required")
+	}
+	return nil
+}
+
+// validateServicesConfig ensures that every service the gateway calls can be reached, and that
+// the calls to it are bounded.
+func validateServicesConfig(servicesConfig Services) error {
+	if err := servicesConfig.Auth.Validate("auth service"); err != nil {
+		return err
+	}
+	if err := servicesConfig.Auth.Policy.Validate("auth service"); err != nil {
+		return err
+	}
+	if err := servicesConfig.Users.Validate("users service"); err != nil {
+		return err
+	}
+	if err := servicesConfig.Users.Policy.Validate("users service"); err != nil {
+		return err
+	}
+	if err := servicesConfig.Books.Validate("books service"); err != nil {
+		return err
+	}
+	if err := servicesConfig.Books.Policy.Validate("books service"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RunConfig initializes and loads the configuration.
+func RunConfig(path string) {
+	config, err := LoadConfig(path)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load configuration")
+	}
+	c = config
+}
@@ -1,16 +1,17 @@
 package routes
 
 import (
-	"library-management-api/api-gateway/middleware"
-	"library-management-api/auth-service/api/http"
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/auth"
+	"library-management-api/util/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
 var authController *http.AuthController
 
-func AuthRoutes(r *gin.Engine) {
-	authController = http.NewAuthController()
+func AuthRoutes(r *gin.Engine, authClient auth.IClient) {
+	authController = http.NewAuthController(authClient)
 
 	r.POST("/login", authController.Login)
 	r.POST("/logout", middleware.AuthMiddleware(), authController.Logout)
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var authorController *http.AuthorController
+
+func AuthorRoutes(r *gin.Engine, booksClient books.IClient) {
+	authorController = http.NewAuthorController(booksClient)
+
+	authorsGroup := r.Group("/authors", middleware.AuthMiddleware())
+	{
+		authorsGroup.POST("/", authorController.AddAuthor)
+		authorsGroup.GET("/", authorController.GetAuthors)
+		authorsGroup.GET("/:id", authorController.GetAuthor)
+		authorsGroup.PUT("/:id", authorController.UpdateAuthor)
+		authorsGroup.DELETE("/:id", authorController.DeleteAuthor)
+		authorsGroup.GET("/:id/books", authorController.AuthorBooks)
+	}
+}
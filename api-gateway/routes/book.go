@@ -1,28 +1,54 @@
 package routes
 
 import (
-	"library-management-api/api-gateway/middleware"
-	"library-management-api/books-service/api/http"
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
 var bookController *http.BookController
+var copyController *http.CopyController
+var importController *http.ImportController
+var exportController *http.ExportController
+var coverController *http.CoverController
 
-func BookRoutes(r *gin.Engine) {
-	bookController = http.NewBookController()
+func BookRoutes(r *gin.Engine, booksClient books.IClient) {
+	bookController = http.NewBookController(booksClient)
+	copyController = http.NewCopyController(booksClient)
+	importController = http.NewImportController(booksClient)
+	exportController = http.NewExportController(booksClient)
+	coverController = http.NewCoverController(booksClient)
 
 	booksGroup := r.Group("/books", middleware.AuthMiddleware())
 	{
 		booksGroup.POST("/", bookController.AddBook)
+		booksGroup.POST("/import", importController.ImportBooks)
+		booksGroup.POST("/import/marc", importController.ImportMarc)
+		booksGroup.GET("/export", exportController.ExportBooks)
+		booksGroup.GET("/export/marc", exportController.ExportMarc)
 		booksGroup.GET("/", bookController.GetBooks)
 		booksGroup.GET("/:id", bookController.GetBook)
+		booksGroup.GET("/isbn/:isbn", bookController.GetBookByISBN)
 		booksGroup.PUT("/:id", bookController.UpdateBook)
 		booksGroup.DELETE("/:id", bookController.DeleteBook)
+		booksGroup.PUT("/:id/tags", bookController.UpdateBookTags)
+		booksGroup.PUT("/:id/cover", coverController.UpdateBookCover)
+		booksGroup.DELETE("/:id/cover", coverController.DeleteBookCover)
 		booksGroup.POST("/borrow/:id", bookController.BorrowBook)
 		booksGroup.POST("/return/:id", bookController.ReturnBook)
+		booksGroup.POST("/renew/:id", bookController.RenewBook)
 		booksGroup.GET("/search", bookController.SearchBooks)
 		booksGroup.GET("/category", bookController.CategoryBooks)
 		booksGroup.GET("/available", bookController.AvailableBooks)
+		booksGroup.GET("/suggest", bookController.SuggestBooks)
+		booksGroup.POST("/:id/copies", copyController.AddCopy)
+		booksGroup.GET("/:id/copies", copyController.GetCopies)
+		booksGroup.PUT("/copies/:id", copyController.UpdateCopy)
+		booksGroup.DELETE("/copies/:id", copyController.DeleteCopy)
 	}
+
+	// Covers are shown in image tags, which cannot send a token, so they are public
+	r.GET("/books/:id/cover", coverController.GetBookCover)
 }
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var fineController *http.FineController
+
+func FineRoutes(r *gin.Engine, booksClient books.IClient) {
+	fineController = http.NewFineController(booksClient)
+
+	r.GET("/fines/me", middleware.AuthMiddleware(), fineController.GetMyFines)
+	r.GET("/users/:id/fines", middleware.AuthMiddleware(), fineController.GetUserFines)
+	r.POST("/users/:id/fines/payments", middleware.AuthMiddleware(), fineController.RecordPayment)
+	r.POST("/users/:id/fines/waivers", middleware.AuthMiddleware(), fineController.WaiveFine)
+}
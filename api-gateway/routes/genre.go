@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var genreController *http.GenreController
+
+func GenreRoutes(r *gin.Engine, booksClient books.IClient) {
+	genreController = http.NewGenreController(booksClient)
+
+	genresGroup := r.Group("/genres", middleware.AuthMiddleware())
+	{
+		genresGroup.POST("/", genreController.AddGenre)
+		genresGroup.GET("/", genreController.GetGenres)
+		genresGroup.PUT("/:id", genreController.UpdateGenre)
+		genresGroup.DELETE("/:id", genreController.DeleteGenre)
+		genresGroup.POST("/:id/merge", genreController.MergeGenre)
+	}
+}
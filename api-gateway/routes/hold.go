@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var holdController *http.HoldController
+
+func HoldRoutes(r *gin.Engine, booksClient books.IClient) {
+	holdController = http.NewHoldController(booksClient)
+
+	holdsGroup := r.Group("/holds", middleware.AuthMiddleware())
+	{
+		holdsGroup.GET("/me", holdController.GetMyHolds)
+		holdsGroup.DELETE("/:id", holdController.CancelHold)
+	}
+	r.POST("/books/:id/holds", middleware.AuthMiddleware(), holdController.PlaceHold)
+	r.GET("/books/:id/holds", middleware.AuthMiddleware(), holdController.GetBookHolds)
+}
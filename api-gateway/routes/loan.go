@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var loanController *http.LoanController
+
+func LoanRoutes(r *gin.Engine, booksClient books.IClient) {
+	loanController = http.NewLoanController(booksClient)
+
+	loansGroup := r.Group("/loans", middleware.AuthMiddleware())
+	{
+		loansGroup.GET("/", loanController.GetLoans)
+		loansGroup.GET("/me", loanController.GetMyLoans)
+	}
+	r.GET("/users/:id/loans", middleware.AuthMiddleware(), loanController.GetUserLoans)
+	r.GET("/books/:id/loans", middleware.AuthMiddleware(), loanController.GetBookLoans)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+
+	"github.com/gin-gonic/gin"
+)
+
+var oaiController *http.OaiController
+
+// OaiRoutes exposes the OAI-PMH endpoint. Harvesters do not log in, so it is public.
+func OaiRoutes(r *gin.Engine, booksClient books.IClient) {
+	oaiController = http.NewOaiController(booksClient)
+
+	r.GET("/oai", oaiController.Harvest)
+	r.POST("/oai", oaiController.Harvest)
+}
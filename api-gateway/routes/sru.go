@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+
+	"github.com/gin-gonic/gin"
+)
+
+var sruController *http.SruController
+
+// SruRoutes exposes the SRU search endpoint. Partner discovery tools search anonymously, so it
+// is public.
+func SruRoutes(r *gin.Engine, booksClient books.IClient) {
+	sruController = http.NewSruController(booksClient)
+
+	r.GET("/sru", sruController.SearchRetrieve)
+	r.POST("/sru", sruController.SearchRetrieve)
+}
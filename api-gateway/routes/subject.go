@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var subjectController *http.SubjectController
+
+func SubjectRoutes(r *gin.Engine, booksClient books.IClient) {
+	subjectController = http.NewSubjectController(booksClient)
+
+	subjectsGroup := r.Group("/subjects", middleware.AuthMiddleware())
+	{
+		subjectsGroup.POST("/", subjectController.AddSubject)
+		subjectsGroup.GET("/", subjectController.GetSubjects)
+		subjectsGroup.PUT("/:id", subjectController.UpdateSubject)
+		subjectsGroup.DELETE("/:id", subjectController.DeleteSubject)
+		subjectsGroup.POST("/:id/merge", subjectController.MergeSubject)
+	}
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var tagController *http.TagController
+
+func TagRoutes(r *gin.Engine, booksClient books.IClient) {
+	tagController = http.NewTagController(booksClient)
+
+	tagsGroup := r.Group("/tags", middleware.AuthMiddleware())
+	{
+		tagsGroup.POST("/", tagController.AddTag)
+		tagsGroup.GET("/", tagController.GetTags)
+		tagsGroup.PUT("/:id", tagController.UpdateTag)
+		tagsGroup.DELETE("/:id", tagController.DeleteTag)
+	}
+}
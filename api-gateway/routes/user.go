@@ -1,16 +1,17 @@
 package routes
 
 import (
-	"library-management-api/api-gateway/middleware"
-	"library-management-api/users-service/api/http"
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/users"
+	"library-management-api/util/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
 var userController *http.UserController
 
-func UserRoutes(r *gin.Engine) {
-	userController = http.NewUserController()
+func UserRoutes(r *gin.Engine, usersClient users.IClient) {
+	userController = http.NewUserController(usersClient)
 	usersGroup := r.Group("/users")
 	{
 		usersGroup.POST("/", userController.AddUser)
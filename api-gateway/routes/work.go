@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"library-management-api/api-gateway/api/http"
+	"library-management-api/api-gateway/third-party/books"
+	"library-management-api/util/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+var workController *http.WorkController
+
+func WorkRoutes(r *gin.Engine, booksClient books.IClient) {
+	workController = http.NewWorkController(booksClient)
+
+	worksGroup := r.Group("/works", middleware.AuthMiddleware())
+	{
+		worksGroup.POST("/", workController.AddWork)
+		worksGroup.GET("/:id", workController.GetWork)
+	}
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"library-management-api/api-gateway/configs"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/auth"
+	"library-management-api/util/grpcconn"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Client interface for AuthService
+type IClient interface {
+	Login(ctx context.Context, req dto.AuthLoginReq) (dto.AuthLoginRes, error)
+	Logout(ctx context.Context) error
+	RefreshToken(ctx context.Context, req dto.AuthRefreshTokenReq) (dto.AuthRefreshTokenRes, error)
+	RevokeToken(ctx context.Context, req dto.AuthRevokeTokenReq) error
+}
+
+// Client struct for managing connection
+type Client struct {
+	c      auth.AuthServiceClient // gRPC client
+	caller *grpcconn.Caller       // timeouts, retries and circuit breaking of the calls
+}
+
+// NewClient creates a new gRPC client for AuthService
+func NewClient() (IClient, error) {
+	// Share the long-lived connection to the server
+	conn, err := grpcconn.Conn("auth-service", configs.C().Services.Auth)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create grpc client")
+		return nil, err
+	}
+
+	return &Client{
+		c:      auth.NewAuthServiceClient(conn),
+		caller: grpcconn.CallerFor("auth-service", configs.C().Services.Auth.Policy),
+	}, nil
+}
+
+// Every call opens, renews or closes a session, so none is retried: a call that timed out may
+// still have done so.
+func (c *Client) Login(ctx context.Context, req dto.AuthLoginReq) (dto.AuthLoginRes, error) {
+	var res *auth.LoginRes
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.c.Login(ctx, MapDtoAuthLoginReqToPbLoginReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.AuthLoginRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbLoginResToDtoAuthLoginRes(res), nil
+}
+
+func (c *Client) Logout(ctx context.Context) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.c.Logout(ctx, &emptypb.Empty{})
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
+
+func (c *Client) RefreshToken(ctx context.Context, req dto.AuthRefreshTokenReq) (dto.AuthRefreshTokenRes, error) {
+	var res *auth.RefreshTokenRes
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.c.RefreshToken(ctx, MapDtoAuthRefreshTokenReqToPbRefreshTokenReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.AuthRefreshTokenRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbRefreshTokenResToDtoAuthRefreshTokenRes(res), nil
+}
+
+func (c *Client) RevokeToken(ctx context.Context, req dto.AuthRevokeTokenReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.c.RevokeToken(ctx, MapDtoAuthRevokeTokenReqToPbRevokeTokenReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
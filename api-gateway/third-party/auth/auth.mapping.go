@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/auth"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mapTime returns the zero time for an unset timestamp. Times are given in the local zone, as
+// the auth service reads them from the database.
+func mapTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime().Local()
+}
+
+func MapDtoAuthLoginReqToPbLoginReq(req dto.AuthLoginReq) *auth.LoginReq {
+	return &auth.LoginReq{
+		Username: req.Username,
+		Password: req.Password,
+	}
+}
+
+func MapPbLoginResToDtoAuthLoginRes(pb *auth.LoginRes) dto.AuthLoginRes {
+	return dto.AuthLoginRes{
+		ID:                    uint(pb.Id),
+		AccessToken:           pb.AccessToken,
+		RefreshToken:          pb.RefreshToken,
+		AccessTokenExpiresAt:  mapTime(pb.AccessTokenExpiresAt),
+		RefreshTokenExpiresAt: mapTime(pb.RefreshTokenExpiresAt),
+		UserID:                uint(pb.UserId),
+	}
+}
+
+func MapDtoAuthRefreshTokenReqToPbRefreshTokenReq(req dto.AuthRefreshTokenReq) *auth.RefreshTokenReq {
+	return &auth.RefreshTokenReq{
+		RefreshToken: req.RefreshToken,
+	}
+}
+
+func MapPbRefreshTokenResToDtoAuthRefreshTokenRes(pb *auth.RefreshTokenRes) dto.AuthRefreshTokenRes {
+	return dto.AuthRefreshTokenRes{
+		AccessToken:          pb.AccessToken,
+		AccessTokenExpiresAt: mapTime(pb.AccessTokenExpiresAt),
+	}
+}
+
+func MapDtoAuthRevokeTokenReqToPbRevokeTokenReq(req dto.AuthRevokeTokenReq) *auth.RevokeTokenReq {
+	return &auth.RevokeTokenReq{
+		RefreshToken: req.RefreshToken,
+	}
+}
@@ -0,0 +1,79 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) AddAuthor(ctx context.Context, req dto.AddAuthorReq) (dto.AuthorRes, error) {
+	var res *books.Author
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.authors.AddAuthor(ctx, MapDtoAddAuthorReqToPbAddAuthorReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.AuthorRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbAuthorToDtoAuthorRes(res), nil
+}
+
+func (c *Client) ListAuthors(ctx context.Context, req dto.GetAuthorsReq) (dto.AuthorListRes, error) {
+	var res *books.AuthorPage
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.authors.ListAuthors(ctx, MapDtoGetAuthorsReqToPbListAuthorsReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.AuthorListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbAuthorPageToDtoAuthorListRes(res), nil
+}
+
+func (c *Client) GetAuthor(ctx context.Context, req dto.GetAuthorReq) (dto.AuthorRes, error) {
+	var res *books.Author
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.authors.GetAuthor(ctx, MapDtoGetAuthorReqToPbGetAuthorReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.AuthorRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbAuthorToDtoAuthorRes(res), nil
+}
+
+func (c *Client) UpdateAuthor(ctx context.Context, req dto.UpdateAuthorReq) (dto.AuthorRes, error) {
+	var res *books.Author
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.authors.UpdateAuthor(ctx, MapDtoUpdateAuthorReqToPbUpdateAuthorReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.AuthorRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbAuthorToDtoAuthorRes(res), nil
+}
+
+func (c *Client) DeleteAuthor(ctx context.Context, req dto.DeleteAuthorReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.authors.DeleteAuthor(ctx, MapDtoDeleteAuthorReqToPbDeleteAuthorReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
+
+func (c *Client) AuthorBooks(ctx context.Context, req dto.AuthorBooksReq) (dto.BookListRes, error) {
+	var res *books.BookPage
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.authors.AuthorBooks(ctx, MapDtoAuthorBooksReqToPbAuthorBooksReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookPageToDtoBookListRes(res), nil
+}
@@ -0,0 +1,73 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoAddAuthorReqToPbAddAuthorReq(req dto.AddAuthorReq) *books.AddAuthorReq {
+	return &books.AddAuthorReq{
+		Name: req.Name,
+	}
+}
+
+func MapDtoGetAuthorsReqToPbListAuthorsReq(req dto.GetAuthorsReq) *books.ListAuthorsReq {
+	return &books.ListAuthorsReq{
+		Name: req.Name,
+		List: &books.ListQuery{
+			Limit:  int32(req.Limit),
+			Page:   int32(req.Page),
+			Cursor: req.Cursor,
+			Sort:   req.Sort,
+			Order:  req.Order,
+		},
+	}
+}
+
+func MapDtoGetAuthorReqToPbGetAuthorReq(req dto.GetAuthorReq) *books.GetAuthorReq {
+	return &books.GetAuthorReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoUpdateAuthorReqToPbUpdateAuthorReq(req dto.UpdateAuthorReq) *books.UpdateAuthorReq {
+	return &books.UpdateAuthorReq{
+		Id:   int32(req.ID),
+		Name: req.Name,
+	}
+}
+
+func MapDtoDeleteAuthorReqToPbDeleteAuthorReq(req dto.DeleteAuthorReq) *books.DeleteAuthorReq {
+	return &books.DeleteAuthorReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoAuthorBooksReqToPbAuthorBooksReq(req dto.AuthorBooksReq) *books.AuthorBooksReq {
+	return &books.AuthorBooksReq{
+		Id:     int32(req.ID),
+		Filter: MapDtoListBooksReqToPbBookFilter(req.ListBooksReq),
+		List:   MapDtoListBooksReqToPbListQuery(req.ListBooksReq),
+	}
+}
+
+func MapPbAuthorToDtoAuthorRes(pb *books.Author) dto.AuthorRes {
+	return dto.AuthorRes{
+		ID:        uint(pb.Id),
+		Name:      pb.Name,
+		BookCount: uint(pb.BookCount),
+		CreatedAt: mapTime(pb.CreatedAt),
+	}
+}
+
+func MapPbAuthorPageToDtoAuthorListRes(pb *books.AuthorPage) dto.AuthorListRes {
+	res := dto.AuthorListRes{
+		Data:       []dto.AuthorRes{},
+		Total:      int(pb.Total),
+		NextCursor: pb.NextCursor,
+	}
+	for _, author := range pb.Authors {
+		res.Data = append(res.Data, MapPbAuthorToDtoAuthorRes(author))
+	}
+	return res
+}
@@ -0,0 +1,307 @@
+package books
+
+import (
+	"context"
+	"io"
+	"library-management-api/api-gateway/configs"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+	"net/url"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Client interface for the services of the books service
+type IClient interface {
+	AddBook(ctx context.Context, req dto.AddBookReq) (dto.BookRes, error)
+	GetBook(ctx context.Context, req dto.GetBookReq) (dto.BookRes, error)
+	GetBookByISBN(ctx context.Context, req dto.GetBookByISBNReq) (dto.BookRes, error)
+	ListBooks(ctx context.Context, req dto.GetBooksReq) (dto.BookListRes, error)
+	UpdateBook(ctx context.Context, req dto.UpdateBookReq) (dto.BookRes, error)
+	UpdateBookTags(ctx context.Context, req dto.UpdateBookTagsReq) (dto.BookRes, error)
+	DeleteBook(ctx context.Context, req dto.DeleteBookReq) error
+	SearchBooks(ctx context.Context, req dto.SearchBooksReq) (dto.BookMatchListRes, error)
+	CategoryBooks(ctx context.Context, req dto.CategoryBooksReq) (dto.BookListRes, error)
+	AvailableBooks(ctx context.Context, req dto.AvailableBooksReq) (dto.BookListRes, error)
+	SuggestBooks(ctx context.Context, req dto.SuggestBooksReq) (dto.SuggestionListRes, error)
+	BorrowBook(ctx context.Context, req dto.BorrowBookReq) (dto.LoanRes, error)
+	ReturnBook(ctx context.Context, req dto.ReturnBookReq) (dto.LoanRes, error)
+	RenewBook(ctx context.Context, req dto.RenewBookReq) (dto.LoanRes, error)
+
+	AddAuthor(ctx context.Context, req dto.AddAuthorReq) (dto.AuthorRes, error)
+	ListAuthors(ctx context.Context, req dto.GetAuthorsReq) (dto.AuthorListRes, error)
+	GetAuthor(ctx context.Context, req dto.GetAuthorReq) (dto.AuthorRes, error)
+	UpdateAuthor(ctx context.Context, req dto.UpdateAuthorReq) (dto.AuthorRes, error)
+	DeleteAuthor(ctx context.Context, req dto.DeleteAuthorReq) error
+	AuthorBooks(ctx context.Context, req dto.AuthorBooksReq) (dto.BookListRes, error)
+
+	AddWork(ctx context.Context, req dto.AddWorkReq) (dto.WorkRes, error)
+	GetWork(ctx context.Context, req dto.GetWorkReq) (dto.WorkRes, error)
+
+	AddTag(ctx context.Context, req dto.AddTagReq) (dto.TagRes, error)
+	ListTags(ctx context.Context) ([]dto.TagRes, error)
+	UpdateTag(ctx context.Context, req dto.UpdateTagReq) (dto.TagRes, error)
+	DeleteTag(ctx context.Context, req dto.DeleteTagReq) error
+
+	AddSubject(ctx context.Context, req dto.AddSubjectReq) (dto.SubjectRes, error)
+	ListSubjects(ctx context.Context) ([]dto.SubjectRes, error)
+	UpdateSubject(ctx context.Context, req dto.UpdateSubjectReq) (dto.SubjectRes, error)
+	DeleteSubject(ctx context.Context, req dto.DeleteSubjectReq) error
+	MergeSubject(ctx context.Context, req dto.MergeSubjectReq) (dto.SubjectRes, error)
+
+	AddGenre(ctx context.Context, req dto.AddGenreReq) (dto.GenreRes, error)
+	ListGenres(ctx context.Context) ([]dto.GenreRes, error)
+	UpdateGenre(ctx context.Context, req dto.UpdateGenreReq) (dto.GenreRes, error)
+	DeleteGenre(ctx context.Context, req dto.DeleteGenreReq) error
+	MergeGenre(ctx context.Context, req dto.MergeGenreReq) (dto.GenreRes, error)
+
+	AddCopy(ctx context.Context, req dto.AddCopyReq) (dto.CopyRes, error)
+	ListCopies(ctx context.Context, req dto.GetCopiesReq) ([]dto.CopyRes, error)
+	UpdateCopy(ctx context.Context, req dto.UpdateCopyReq) (dto.CopyRes, error)
+	DeleteCopy(ctx context.Context, req dto.DeleteCopyReq) error
+
+	ListLoans(ctx context.Context) ([]dto.LoanRes, error)
+	ListMyLoans(ctx context.Context) ([]dto.LoanRes, error)
+	ListUserLoans(ctx context.Context, req dto.GetUserLoansReq) ([]dto.LoanRes, error)
+	ListBookLoans(ctx context.Context, req dto.GetBookLoansReq) ([]dto.LoanRes, error)
+
+	PlaceHold(ctx context.Context, req dto.PlaceHoldReq) (dto.HoldRes, error)
+	ListMyHolds(ctx context.Context) ([]dto.HoldRes, error)
+	ListBookHolds(ctx context.Context, req dto.GetBookHoldsReq) ([]dto.HoldRes, error)
+	CancelHold(ctx context.Context, req dto.CancelHoldReq) (dto.HoldRes, error)
+
+	GetMyFines(ctx context.Context) (dto.FineBalanceRes, error)
+	GetUserFines(ctx context.Context, req dto.GetUserFinesReq) (dto.FineBalanceRes, error)
+	RecordPayment(ctx context.Context, req dto.SettleFineReq) (dto.FineRes, error)
+	WaiveFine(ctx context.Context, req dto.SettleFineReq) (dto.FineRes, error)
+
+	UpdateBookCover(ctx context.Context, req dto.UpdateBookCoverReq, image io.Reader) (dto.BookRes, error)
+	DeleteBookCover(ctx context.Context, req dto.DeleteBookCoverReq) error
+	GetBookCover(ctx context.Context, req dto.GetBookCoverReq) (CoverImage, error)
+
+	ImportBooks(ctx context.Context, req dto.ImportBooksReq, file io.Reader) (dto.ImportReportRes, error)
+	ImportMarc(ctx context.Context, req dto.ImportMarcReq, file io.Reader) (dto.ImportReportRes, error)
+	ExportBooks(ctx context.Context, req dto.ExportBooksReq, w FileWriter) error
+	ExportMarc(ctx context.Context, req dto.ExportMarcReq, w FileWriter) error
+
+	Harvest(ctx context.Context, params url.Values) (Document, error)
+	SearchRetrieve(ctx context.Context, params url.Values) (Document, error)
+}
+
+// Client struct for managing connection
+//
+// Errors of the books service are turned back into their errorhandler sentinels, so callers
+// handle them as the books service does. Lookups are retried as idempotent calls; changes are
+// not, as a call that timed out may still have been applied. Uploads and downloads are streamed
+// and never retried or given the per-call timeout; see grpcconn.Caller.Stream.
+type Client struct {
+	books     books.BooksServiceClient // gRPC clients
+	authors   books.AuthorsServiceClient
+	works     books.WorksServiceClient
+	tags      books.TagsServiceClient
+	subjects  books.SubjectsServiceClient
+	genres    books.GenresServiceClient
+	copies    books.CopiesServiceClient
+	loans     books.LoansServiceClient
+	holds     books.HoldsServiceClient
+	fines     books.FinesServiceClient
+	covers    books.CoversServiceClient
+	catalogue books.CatalogueServiceClient
+	protocol  books.ProtocolServiceClient
+	caller    *grpcconn.Caller // timeouts, retries and circuit breaking of the calls
+}
+
+// NewClient creates a new gRPC client for the books service
+func NewClient() (IClient, error) {
+	// Share the long-lived connection to the server
+	conn, err := grpcconn.Conn("books-service", configs.C().Services.Books)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create grpc client")
+		return nil, err
+	}
+
+	return &Client{
+		books:     books.NewBooksServiceClient(conn),
+		authors:   books.NewAuthorsServiceClient(conn),
+		works:     books.NewWorksServiceClient(conn),
+		tags:      books.NewTagsServiceClient(conn),
+		subjects:  books.NewSubjectsServiceClient(conn),
+		genres:    books.NewGenresServiceClient(conn),
+		copies:    books.NewCopiesServiceClient(conn),
+		loans:     books.NewLoansServiceClient(conn),
+		holds:     books.NewHoldsServiceClient(conn),
+		fines:     books.NewFinesServiceClient(conn),
+		covers:    books.NewCoversServiceClient(conn),
+		catalogue: books.NewCatalogueServiceClient(conn),
+		protocol:  books.NewProtocolServiceClient(conn),
+		caller:    grpcconn.CallerFor("books-service", configs.C().Services.Books.Policy),
+	}, nil
+}
+
+func (c *Client) AddBook(ctx context.Context, req dto.AddBookReq) (dto.BookRes, error) {
+	var res *books.Book
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.books.AddBook(ctx, MapDtoAddBookReqToPbAddBookReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookToDtoBookRes(res), nil
+}
+
+func (c *Client) GetBook(ctx context.Context, req dto.GetBookReq) (dto.BookRes, error) {
+	var res *books.Book
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.books.GetBook(ctx, MapDtoGetBookReqToPbGetBookReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookToDtoBookRes(res), nil
+}
+
+func (c *Client) GetBookByISBN(ctx context.Context, req dto.GetBookByISBNReq) (dto.BookRes, error) {
+	var res *books.Book
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.books.GetBookByISBN(ctx, MapDtoGetBookByISBNReqToPbGetBookByISBNReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookToDtoBookRes(res), nil
+}
+
+func (c *Client) ListBooks(ctx context.Context, req dto.GetBooksReq) (dto.BookListRes, error) {
+	var res *books.BookPage
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.books.ListBooks(ctx, MapDtoGetBooksReqToPbListBooksReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookPageToDtoBookListRes(res), nil
+}
+
+func (c *Client) UpdateBook(ctx context.Context, req dto.UpdateBookReq) (dto.BookRes, error) {
+	var res *books.Book
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.books.UpdateBook(ctx, MapDtoUpdateBookReqToPbUpdateBookReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookToDtoBookRes(res), nil
+}
+
+func (c *Client) UpdateBookTags(ctx context.Context, req dto.UpdateBookTagsReq) (dto.BookRes, error) {
+	var res *books.Book
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.books.UpdateBookTags(ctx, MapDtoUpdateBookTagsReqToPbUpdateBookTagsReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookToDtoBookRes(res), nil
+}
+
+func (c *Client) DeleteBook(ctx context.Context, req dto.DeleteBookReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.books.DeleteBook(ctx, MapDtoDeleteBookReqToPbDeleteBookReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
+
+func (c *Client) SearchBooks(ctx context.Context, req dto.SearchBooksReq) (dto.BookMatchListRes, error) {
+	var res *books.SearchBooksRes
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.books.SearchBooks(ctx, MapDtoSearchBooksReqToPbSearchBooksReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookMatchListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbSearchBooksResToDtoBookMatchListRes(res), nil
+}
+
+func (c *Client) CategoryBooks(ctx context.Context, req dto.CategoryBooksReq) (dto.BookListRes, error) {
+	var res *books.BookPage
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.books.CategoryBooks(ctx, MapDtoCategoryBooksReqToPbCategoryBooksReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookPageToDtoBookListRes(res), nil
+}
+
+func (c *Client) AvailableBooks(ctx context.Context, req dto.AvailableBooksReq) (dto.BookListRes, error) {
+	var res *books.BookPage
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.books.AvailableBooks(ctx, MapDtoAvailableBooksReqToPbListBooksReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.BookListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookPageToDtoBookListRes(res), nil
+}
+
+func (c *Client) SuggestBooks(ctx context.Context, req dto.SuggestBooksReq) (dto.SuggestionListRes, error) {
+	var res *books.SuggestBooksRes
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.books.SuggestBooks(ctx, MapDtoSuggestBooksReqToPbSuggestBooksReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.SuggestionListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbSuggestBooksResToDtoSuggestionListRes(res), nil
+}
+
+func (c *Client) BorrowBook(ctx context.Context, req dto.BorrowBookReq) (dto.LoanRes, error) {
+	var res *books.Loan
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.books.BorrowBook(ctx, MapDtoBorrowBookReqToPbBorrowBookReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.LoanRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbLoanToDtoLoanRes(res), nil
+}
+
+func (c *Client) ReturnBook(ctx context.Context, req dto.ReturnBookReq) (dto.LoanRes, error) {
+	var res *books.Loan
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.books.ReturnBook(ctx, MapDtoReturnBookReqToPbReturnBookReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.LoanRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbLoanToDtoLoanRes(res), nil
+}
+
+func (c *Client) RenewBook(ctx context.Context, req dto.RenewBookReq) (dto.LoanRes, error) {
+	var res *books.Loan
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.books.RenewBook(ctx, MapDtoRenewBookReqToPbRenewBookReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.LoanRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbLoanToDtoLoanRes(res), nil
+}
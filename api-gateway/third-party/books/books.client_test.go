@@ -0,0 +1,230 @@
+package books
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/grpcconn"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeBooksServer answers like the books service does for book 1, reports book 2 as missing and
+// fails to check the token for book 3 as if the auth service were down. Borrowing needs the
+// token "secret".
+type fakeBooksServer struct {
+	books.UnimplementedBooksServiceServer
+}
+
+func (fakeBooksServer) GetBook(ctx context.Context, req *books.GetBookReq) (*books.Book, error) {
+	switch req.Id {
+	case 1:
+	case 3:
+		return nil, errorhandler.ErrAuthUnavailable
+	default:
+		return nil, errorhandler.ErrBookNotFound
+	}
+	return &books.Book{
+		Id:              1,
+		Title:           "Dune",
+		Author:          "Frank Herbert",
+		Series:          &books.BookSeries{Id: 3, Title: "Dune", Volume: 1},
+		TotalCopies:     2,
+		AvailableCopies: 1,
+		CreatedAt:       timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}, nil
+}
+
+func (fakeBooksServer) BorrowBook(ctx context.Context, req *books.BorrowBookReq) (*books.Loan, error) {
+	if token, _ := ctx.Value("token").(string); token != "secret" {
+		return nil, errorhandler.ErrForbidden
+	}
+	return &books.Loan{Id: 9, BookId: req.Id, BorrowedAt: timestamppb.Now()}, nil
+}
+
+// fakeCoversServer stores the cover of book 1 and serves it back in chunks of two bytes.
+type fakeCoversServer struct {
+	books.UnimplementedCoversServiceServer
+	image []byte
+}
+
+func (s *fakeCoversServer) UpdateBookCover(stream books.CoversService_UpdateBookCoverServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.GetId() != 1 {
+		return errorhandler.ErrBookNotFound
+	}
+	var image []byte
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		image = append(image, req.GetData()...)
+	}
+	s.image = image
+	return stream.SendAndClose(&books.Book{Id: 1, Cover: &books.BookCover{Key: "covers/1", UpdatedAt: timestamppb.Now()}})
+}
+
+func (s *fakeCoversServer) GetBookCover(req *books.GetBookCoverReq, stream books.CoversService_GetBookCoverServer) error {
+	if req.Id != 1 || s.image == nil {
+		return errorhandler.ErrCoverNotFound
+	}
+	chunk := &books.CoverChunk{Key: "covers/1", ContentType: "image/png", UpdatedAt: timestamppb.Now()}
+	for data := s.image; len(data) > 0; data = data[min(len(data), 2):] {
+		chunk.Data = data[:min(len(data), 2)]
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+		chunk = &books.CoverChunk{}
+	}
+	return nil
+}
+
+// startBooksServer serves fakeBooksServer on a loopback port and returns a client of it, named
+// name so that every test gets a connection and circuit breaker of its own.
+func startBooksServer(t *testing.T, name string) (*Client, *grpc.Server) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpc.NewServer(grpcconn.ServerOptions(time.Second, time.Second)...)
+	books.RegisterBooksServiceServer(srv, fakeBooksServer{})
+	books.RegisterCoversServiceServer(srv, &fakeCoversServer{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpcconn.Conn(name, grpcconn.Peer{
+		Addresses:        []string{lis.Addr().String()},
+		DialTimeout:      time.Second,
+		KeepaliveTime:    10 * time.Second,
+		KeepaliveTimeout: time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Conn() error = %v", err)
+	}
+	caller := grpcconn.CallerFor(name, grpcconn.Policy{
+		CallTimeout:     time.Second,
+		MaxRetries:      1,
+		RetryBackoff:    10 * time.Millisecond,
+		MaxRetryBackoff: 10 * time.Millisecond,
+		BreakerFailures: 5,
+		BreakerCooldown: time.Minute,
+	})
+	return &Client{books: books.NewBooksServiceClient(conn), covers: books.NewCoversServiceClient(conn), caller: caller}, srv
+}
+
+func TestClientGetBook(t *testing.T) {
+	client, _ := startBooksServer(t, "books-get")
+
+	book, err := client.GetBook(context.Background(), dto.GetBookReq{ID: 1})
+	if err != nil {
+		t.Fatalf("GetBook() error = %v", err)
+	}
+	if book.Title != "Dune" || book.Series == nil || book.Series.Volume != 1 || book.CoverURL != "" || book.AvailableCopies != 1 {
+		t.Errorf("GetBook() = %+v", book)
+	}
+	if !book.CreatedAt.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) || !book.UpdatedAt.IsZero() {
+		t.Errorf("GetBook() times = %v, %v", book.CreatedAt, book.UpdatedAt)
+	}
+
+	if _, err := client.GetBook(context.Background(), dto.GetBookReq{ID: 2}); !errors.Is(err, errorhandler.ErrBookNotFound) {
+		t.Errorf("GetBook() of a missing book error = %v, want ErrBookNotFound", err)
+	}
+}
+
+func TestClientBorrowBookPassesToken(t *testing.T) {
+	client, _ := startBooksServer(t, "books-borrow")
+
+	if _, err := client.BorrowBook(context.Background(), dto.BorrowBookReq{ID: 1}); !errors.Is(err, errorhandler.ErrForbidden) {
+		t.Errorf("BorrowBook() without a token error = %v, want ErrForbidden", err)
+	}
+
+	ctx := context.WithValue(context.Background(), "token", "secret")
+	loan, err := client.BorrowBook(ctx, dto.BorrowBookReq{ID: 1})
+	if err != nil {
+		t.Fatalf("BorrowBook() error = %v", err)
+	}
+	if loan.ID != 9 || loan.BookID != 1 || loan.BorrowedAt.IsZero() || loan.ReturnedAt != nil {
+		t.Errorf("BorrowBook() = %+v", loan)
+	}
+}
+
+func TestClientUnimplemented(t *testing.T) {
+	client, _ := startBooksServer(t, "books-unimplemented")
+
+	_, err := client.RenewBook(context.Background(), dto.RenewBookReq{ID: 1})
+	if err == nil || errors.Is(err, errorhandler.ErrServiceUnavailable) {
+		t.Errorf("RenewBook() error = %v, want the status unchanged", err)
+	}
+}
+
+func TestClientBookCover(t *testing.T) {
+	client, _ := startBooksServer(t, "books-cover")
+
+	if _, err := client.GetBookCover(context.Background(), dto.GetBookCoverReq{ID: 1}); !errors.Is(err, errorhandler.ErrCoverNotFound) {
+		t.Errorf("GetBookCover() before an upload error = %v, want ErrCoverNotFound", err)
+	}
+	if _, err := client.UpdateBookCover(context.Background(), dto.UpdateBookCoverReq{ID: 2}, bytes.NewReader([]byte("png"))); !errors.Is(err, errorhandler.ErrBookNotFound) {
+		t.Errorf("UpdateBookCover() of a missing book error = %v, want ErrBookNotFound", err)
+	}
+
+	image := bytes.Repeat([]byte("cover"), chunkSize/3)
+	book, err := client.UpdateBookCover(context.Background(), dto.UpdateBookCoverReq{ID: 1}, bytes.NewReader(image))
+	if err != nil {
+		t.Fatalf("UpdateBookCover() error = %v", err)
+	}
+	if book.CoverURL == "" || book.CoverThumbURL == "" {
+		t.Errorf("UpdateBookCover() = %+v, want the cover URLs", book)
+	}
+
+	cover, err := client.GetBookCover(context.Background(), dto.GetBookCoverReq{ID: 1})
+	if err != nil {
+		t.Fatalf("GetBookCover() error = %v", err)
+	}
+	if cover.Key != "covers/1" || cover.ContentType != "image/png" || cover.UpdatedAt.IsZero() || !bytes.Equal(cover.Data, image) {
+		t.Errorf("GetBookCover() = %q, %q, %v, %d bytes", cover.Key, cover.ContentType, cover.UpdatedAt, len(cover.Data))
+	}
+}
+
+func TestClientAuthUnavailableKeepsBreakerClosed(t *testing.T) {
+	client, _ := startBooksServer(t, "books-auth-unavailable")
+
+	// More answers than it takes to open the breaker: the books service is reachable, so they
+	// must neither be retried as failures to reach it nor fail the calls that follow.
+	for i := 0; i < 10; i++ {
+		if _, err := client.GetBook(context.Background(), dto.GetBookReq{ID: 3}); !errors.Is(err, errorhandler.ErrAuthUnavailable) {
+			t.Fatalf("GetBook() error = %v, want ErrAuthUnavailable", err)
+		}
+	}
+	if _, err := client.GetBook(context.Background(), dto.GetBookReq{ID: 1}); err != nil {
+		t.Errorf("GetBook() after auth unavailable answers error = %v, want nil", err)
+	}
+}
+
+func TestClientServiceDown(t *testing.T) {
+	client, srv := startBooksServer(t, "books-down")
+	srv.Stop()
+
+	if _, err := client.GetBook(context.Background(), dto.GetBookReq{ID: 1}); !errors.Is(err, errorhandler.ErrServiceUnavailable) {
+		t.Errorf("GetBook() of a stopped service error = %v, want ErrServiceUnavailable", err)
+	}
+	if _, err := client.ReturnBook(context.Background(), dto.ReturnBookReq{ID: 1}); !errors.Is(err, errorhandler.ErrServiceUnavailable) {
+		t.Errorf("ReturnBook() of a stopped service error = %v, want ErrServiceUnavailable", err)
+	}
+}
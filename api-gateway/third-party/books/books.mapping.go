@@ -0,0 +1,308 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// mapTime returns the zero time for an unset timestamp. Times are given in the local zone, as
+// the books service reads them from the database.
+func mapTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime().Local()
+}
+
+// mapOptionalTime maps an unset timestamp to a JSON null.
+func mapOptionalTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	res := mapTime(t)
+	return &res
+}
+
+func MapDtoListBooksReqToPbBookFilter(req dto.ListBooksReq) *books.BookFilter {
+	res := &books.BookFilter{
+		YearFrom: int32(req.YearFrom),
+		YearTo:   int32(req.YearTo),
+		Author:   req.Author,
+		Category: req.Category,
+		Subject:  req.Subject,
+		Genre:    req.Genre,
+		Tags:     req.Tags,
+	}
+	if req.Available != nil {
+		res.Available = wrapperspb.Bool(*req.Available)
+	}
+	return res
+}
+
+func MapDtoListBooksReqToPbListQuery(req dto.ListBooksReq) *books.ListQuery {
+	return &books.ListQuery{
+		Limit:  int32(req.Limit),
+		Page:   int32(req.Page),
+		Cursor: req.Cursor,
+		Sort:   req.Sort,
+		Order:  req.Order,
+	}
+}
+
+func MapDtoBookSeriesReqToPbBookSeriesInput(req *dto.BookSeriesReq) *books.BookSeriesInput {
+	if req == nil {
+		return nil
+	}
+	return &books.BookSeriesInput{
+		Id:     int32(req.ID),
+		Title:  req.Title,
+		Volume: int32(req.Volume),
+	}
+}
+
+func MapDtoAuthorIDsToPbAuthorIDs(ids []uint) []int32 {
+	var res []int32
+	for _, id := range ids {
+		res = append(res, int32(id))
+	}
+	return res
+}
+
+func MapDtoAddBookReqToPbAddBookReq(req dto.AddBookReq) *books.AddBookReq {
+	return &books.AddBookReq{
+		Book: &books.BookInput{
+			Title:         req.Title,
+			Author:        req.Author,
+			AuthorIds:     MapDtoAuthorIDsToPbAuthorIDs(req.AuthorIDs),
+			Category:      req.Category,
+			Subject:       req.Subject,
+			Genre:         req.Genre,
+			PublishedYear: int32(req.PublishedYear),
+			Isbn_10:       req.ISBN10,
+			Isbn_13:       req.ISBN13,
+			WorkId:        int32(req.WorkID),
+			Edition:       req.Edition,
+			Language:      req.Language,
+			Format:        req.Format,
+			Series:        MapDtoBookSeriesReqToPbBookSeriesInput(req.Series),
+		},
+	}
+}
+
+func MapDtoGetBookReqToPbGetBookReq(req dto.GetBookReq) *books.GetBookReq {
+	return &books.GetBookReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoGetBookByISBNReqToPbGetBookByISBNReq(req dto.GetBookByISBNReq) *books.GetBookByISBNReq {
+	return &books.GetBookByISBNReq{
+		Isbn: req.ISBN,
+	}
+}
+
+func MapDtoGetBooksReqToPbListBooksReq(req dto.GetBooksReq) *books.ListBooksReq {
+	return &books.ListBooksReq{
+		Filter: MapDtoListBooksReqToPbBookFilter(req.ListBooksReq),
+		List:   MapDtoListBooksReqToPbListQuery(req.ListBooksReq),
+	}
+}
+
+func MapDtoUpdateBookReqToPbUpdateBookReq(req dto.UpdateBookReq) *books.UpdateBookReq {
+	return &books.UpdateBookReq{
+		Id: int32(req.ID),
+		Book: &books.BookInput{
+			Title:         req.Title,
+			Author:        req.Author,
+			AuthorIds:     MapDtoAuthorIDsToPbAuthorIDs(req.AuthorIDs),
+			Category:      req.Category,
+			Subject:       req.Subject,
+			Genre:         req.Genre,
+			PublishedYear: int32(req.PublishedYear),
+			Isbn_10:       req.ISBN10,
+			Isbn_13:       req.ISBN13,
+			WorkId:        int32(req.WorkID),
+			Edition:       req.Edition,
+			Language:      req.Language,
+			Format:        req.Format,
+			Series:        MapDtoBookSeriesReqToPbBookSeriesInput(req.Series),
+		},
+	}
+}
+
+func MapDtoUpdateBookTagsReqToPbUpdateBookTagsReq(req dto.UpdateBookTagsReq) *books.UpdateBookTagsReq {
+	return &books.UpdateBookTagsReq{
+		Id:   int32(req.ID),
+		Tags: req.Tags,
+	}
+}
+
+func MapDtoDeleteBookReqToPbDeleteBookReq(req dto.DeleteBookReq) *books.DeleteBookReq {
+	return &books.DeleteBookReq{
+		Id: int32(req.ID),
+	}
+}
+
+// MapDtoSearchBooksReqToPbSearchBooksReq drops the author and category filters, which a search
+// already matches fuzzily.
+func MapDtoSearchBooksReqToPbSearchBooksReq(req dto.SearchBooksReq) *books.SearchBooksReq {
+	filter := MapDtoListBooksReqToPbBookFilter(req.ListBooksReq)
+	filter.Author = ""
+	filter.Category = ""
+	return &books.SearchBooksReq{
+		Search: &books.BookSearch{
+			Query:    req.Query,
+			Title:    req.Title,
+			Author:   req.Author,
+			Category: req.Category,
+		},
+		Filter: filter,
+		List:   MapDtoListBooksReqToPbListQuery(req.ListBooksReq),
+	}
+}
+
+func MapDtoCategoryBooksReqToPbCategoryBooksReq(req dto.CategoryBooksReq) *books.CategoryBooksReq {
+	return &books.CategoryBooksReq{
+		Type:   req.CategoryType,
+		Value:  req.CategoryValue,
+		Filter: MapDtoListBooksReqToPbBookFilter(req.ListBooksReq),
+		List:   MapDtoListBooksReqToPbListQuery(req.ListBooksReq),
+	}
+}
+
+func MapDtoAvailableBooksReqToPbListBooksReq(req dto.AvailableBooksReq) *books.ListBooksReq {
+	return &books.ListBooksReq{
+		Filter: MapDtoListBooksReqToPbBookFilter(req.ListBooksReq),
+		List:   MapDtoListBooksReqToPbListQuery(req.ListBooksReq),
+	}
+}
+
+func MapDtoSuggestBooksReqToPbSuggestBooksReq(req dto.SuggestBooksReq) *books.SuggestBooksReq {
+	return &books.SuggestBooksReq{
+		Prefix: req.Prefix,
+		Limit:  int32(req.Limit),
+	}
+}
+
+func MapDtoBorrowBookReqToPbBorrowBookReq(req dto.BorrowBookReq) *books.BorrowBookReq {
+	return &books.BorrowBookReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoReturnBookReqToPbReturnBookReq(req dto.ReturnBookReq) *books.ReturnBookReq {
+	return &books.ReturnBookReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoRenewBookReqToPbRenewBookReq(req dto.RenewBookReq) *books.RenewBookReq {
+	return &books.RenewBookReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapPbBookToDtoBookRes(pb *books.Book) dto.BookRes {
+	res := dto.BookRes{
+		ID:              uint(pb.Id),
+		Title:           pb.Title,
+		Author:          pb.Author,
+		Authors:         []dto.BookAuthorRes{},
+		Tags:            []dto.BookTagRes{},
+		Category:        pb.Category,
+		Subject:         pb.Subject,
+		Genre:           pb.Genre,
+		PublishedYear:   uint(pb.PublishedYear),
+		ISBN10:          pb.Isbn_10,
+		ISBN13:          pb.Isbn_13,
+		Edition:         pb.Edition,
+		Language:        pb.Language,
+		Format:          pb.Format,
+		TotalCopies:     uint(pb.TotalCopies),
+		AvailableCopies: uint(pb.AvailableCopies),
+		CreatedAt:       mapTime(pb.CreatedAt),
+		UpdatedAt:       mapTime(pb.UpdatedAt),
+	}
+	for _, author := range pb.Authors {
+		res.Authors = append(res.Authors, dto.BookAuthorRes{ID: uint(author.Id), Name: author.Name})
+	}
+	for _, tag := range pb.Tags {
+		res.Tags = append(res.Tags, dto.BookTagRes{ID: uint(tag.Id), Name: tag.Name})
+	}
+	if pb.Work != nil {
+		res.Work = &dto.BookWorkRes{ID: uint(pb.Work.Id), Title: pb.Work.Title}
+	}
+	if pb.Series != nil {
+		res.Series = &dto.BookSeriesRes{ID: uint(pb.Series.Id), Title: pb.Series.Title, Volume: uint(pb.Series.Volume)}
+	}
+	if pb.Cover != nil {
+		res.CoverURL = dto.CoverURL(res.ID, mapTime(pb.Cover.UpdatedAt), "original")
+		res.CoverThumbURL = dto.CoverURL(res.ID, mapTime(pb.Cover.UpdatedAt), "thumbnail")
+	}
+	return res
+}
+
+func MapPbBooksToDtoBooksRes(pb []*books.Book) []dto.BookRes {
+	res := []dto.BookRes{}
+	for _, book := range pb {
+		res = append(res, MapPbBookToDtoBookRes(book))
+	}
+	return res
+}
+
+func MapPbBookPageToDtoBookListRes(pb *books.BookPage) dto.BookListRes {
+	return dto.BookListRes{
+		Data:       MapPbBooksToDtoBooksRes(pb.Books),
+		Total:      int(pb.Total),
+		NextCursor: pb.NextCursor,
+	}
+}
+
+func MapPbFacetsToDtoFacetsRes(pb []*books.Facet) []dto.FacetRes {
+	res := []dto.FacetRes{}
+	for _, facet := range pb {
+		res = append(res, dto.FacetRes{Value: facet.Value, Count: uint(facet.Count)})
+	}
+	return res
+}
+
+func MapPbSearchBooksResToDtoBookMatchListRes(pb *books.SearchBooksRes) dto.BookMatchListRes {
+	res := dto.BookMatchListRes{
+		Data:       []dto.BookMatchRes{},
+		Total:      int(pb.Total),
+		NextCursor: pb.NextCursor,
+		Facets: dto.BookFacetsRes{
+			Genre:        MapPbFacetsToDtoFacetsRes(pb.GetFacets().GetGenres()),
+			Subject:      MapPbFacetsToDtoFacetsRes(pb.GetFacets().GetSubjects()),
+			Tag:          MapPbFacetsToDtoFacetsRes(pb.GetFacets().GetTags()),
+			Decade:       MapPbFacetsToDtoFacetsRes(pb.GetFacets().GetDecades()),
+			Availability: MapPbFacetsToDtoFacetsRes(pb.GetFacets().GetAvailability()),
+		},
+	}
+	for _, match := range pb.Matches {
+		res.Data = append(res.Data, dto.BookMatchRes{
+			BookRes:   MapPbBookToDtoBookRes(match.Book),
+			Score:     match.Score,
+			Highlight: match.Highlight,
+		})
+	}
+	return res
+}
+
+func MapPbSuggestBooksResToDtoSuggestionListRes(pb *books.SuggestBooksRes) dto.SuggestionListRes {
+	res := dto.SuggestionListRes{
+		Data: []dto.SuggestionRes{},
+	}
+	for _, suggestion := range pb.Suggestions {
+		res.Data = append(res.Data, dto.SuggestionRes{
+			Field: suggestion.Field,
+			Value: suggestion.Value,
+			Count: uint(suggestion.Count),
+		})
+	}
+	return res
+}
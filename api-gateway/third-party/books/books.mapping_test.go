@@ -0,0 +1,64 @@
+package books
+
+import (
+	"encoding/json"
+	"fmt"
+	"library-management-api/pkg/proto/books"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// marshal returns the JSON object the gateway answers v with.
+func marshal(t *testing.T, v any) map[string]interface{} {
+	t.Helper()
+	body, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var res map[string]interface{}
+	if err := json.Unmarshal(body, &res); err != nil {
+		t.Fatalf("body %s: %v", body, err)
+	}
+	return res
+}
+
+// TestMapPbBookToDtoBookRes checks that books are answered as the books service answered them
+// before the gateway called it over gRPC.
+func TestMapPbBookToDtoBookRes(t *testing.T) {
+	coverUpdatedAt := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	res := marshal(t, MapPbBookToDtoBookRes(&books.Book{
+		Id:     7,
+		Title:  "Dune",
+		Series: &books.BookSeries{Id: 2, Title: "Dune"},
+		Cover:  &books.BookCover{UpdatedAt: timestamppb.New(coverUpdatedAt)},
+	}))
+
+	if got, want := res["cover_url"], fmt.Sprintf("/books/7/cover?v=%d", coverUpdatedAt.Unix()); got != want {
+		t.Errorf("cover_url = %v, want %v", got, want)
+	}
+	if got, want := res["cover_thumbnail_url"], fmt.Sprintf("/books/7/cover?size=thumbnail&v=%d", coverUpdatedAt.Unix()); got != want {
+		t.Errorf("cover_thumbnail_url = %v, want %v", got, want)
+	}
+	if authors, ok := res["authors"].([]interface{}); !ok || len(authors) != 0 {
+		t.Errorf("authors = %v, want []", res["authors"])
+	}
+	if series := res["series"].(map[string]interface{}); series["volume"] != nil {
+		t.Errorf("series = %v, want no volume", series)
+	}
+	if _, ok := res["work"]; ok {
+		t.Errorf("work = %v, want it left out", res["work"])
+	}
+}
+
+func TestMapPbLoanToDtoLoanRes(t *testing.T) {
+	res := marshal(t, MapPbLoanToDtoLoanRes(&books.Loan{Id: 3, BookId: 7, BorrowedAt: timestamppb.Now()}))
+
+	if v, ok := res["due_at"]; !ok || v != nil {
+		t.Errorf("due_at = %v, want null", v)
+	}
+	if _, ok := res["returned_by"]; ok {
+		t.Errorf("returned_by = %v, want it left out", res["returned_by"])
+	}
+}
@@ -0,0 +1,93 @@
+package books
+
+import (
+	"context"
+	"errors"
+	"io"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+
+	"google.golang.org/grpc"
+)
+
+// FileWriter receives an exported file. Start is called with the type and name of the file
+// before any of its content is written, and only once the export has started, so that earlier
+// failures can still be answered on their own.
+type FileWriter interface {
+	Start(contentType, filename string)
+	io.Writer
+}
+
+func (c *Client) ImportBooks(ctx context.Context, req dto.ImportBooksReq, file io.Reader) (dto.ImportReportRes, error) {
+	return c.importFile(ctx, c.catalogue.ImportBooks, MapDtoImportBooksReqToPbImportHead(req), file)
+}
+
+func (c *Client) ImportMarc(ctx context.Context, req dto.ImportMarcReq, file io.Reader) (dto.ImportReportRes, error) {
+	return c.importFile(ctx, c.catalogue.ImportMarc, MapDtoImportMarcReqToPbImportHead(req), file)
+}
+
+func (c *Client) importFile(ctx context.Context, rpc func(context.Context, ...grpc.CallOption) (grpc.ClientStreamingClient[books.ImportReq, books.ImportReport], error), head *books.ImportHead, file io.Reader) (dto.ImportReportRes, error) {
+	var res *books.ImportReport
+	err := c.caller.Stream(ctx, func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := rpc(ctx)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&books.ImportReq{Part: &books.ImportReq_Head{Head: head}}); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		err = sendFile(file, func(data []byte) error {
+			return stream.Send(&books.ImportReq{Part: &books.ImportReq_Data{Data: data}})
+		})
+		if err != nil {
+			return err
+		}
+		res, err = stream.CloseAndRecv()
+		return err
+	})
+	if err != nil {
+		return dto.ImportReportRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbImportReportToDtoImportReportRes(res), nil
+}
+
+func (c *Client) ExportBooks(ctx context.Context, req dto.ExportBooksReq, w FileWriter) error {
+	return c.export(ctx, c.catalogue.ExportBooks, MapDtoExportBooksReqToPbExportReq(req), w)
+}
+
+func (c *Client) ExportMarc(ctx context.Context, req dto.ExportMarcReq, w FileWriter) error {
+	return c.export(ctx, c.catalogue.ExportMarc, MapDtoExportMarcReqToPbExportReq(req), w)
+}
+
+func (c *Client) export(ctx context.Context, rpc func(context.Context, *books.ExportReq, ...grpc.CallOption) (grpc.ServerStreamingClient[books.FileChunk], error), req *books.ExportReq, w FileWriter) error {
+	err := c.caller.Stream(ctx, func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := rpc(ctx, req)
+		if err != nil {
+			return err
+		}
+		for first := true; ; first = false {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if first {
+				w.Start(chunk.ContentType, chunk.Filename)
+			}
+			if _, err := w.Write(chunk.Data); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
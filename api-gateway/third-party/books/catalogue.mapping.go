@@ -0,0 +1,53 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoImportBooksReqToPbImportHead(req dto.ImportBooksReq) *books.ImportHead {
+	return &books.ImportHead{
+		Format: req.Format,
+		DryRun: req.DryRun,
+	}
+}
+
+func MapDtoImportMarcReqToPbImportHead(req dto.ImportMarcReq) *books.ImportHead {
+	return &books.ImportHead{
+		Format: req.Format,
+		DryRun: req.DryRun,
+	}
+}
+
+func MapPbImportReportToDtoImportReportRes(pb *books.ImportReport) dto.ImportReportRes {
+	res := dto.ImportReportRes{
+		DryRun:  pb.DryRun,
+		Created: uint(pb.Created),
+		Skipped: uint(pb.Skipped),
+		Failed:  uint(pb.Failed),
+		Rows:    []dto.ImportRowRes{},
+	}
+	for _, row := range pb.Rows {
+		res.Rows = append(res.Rows, dto.ImportRowRes{
+			Line:   uint(row.Line),
+			Status: row.Status,
+			BookID: uint(row.BookId),
+			Title:  row.Title,
+			ISBN13: row.Isbn_13,
+			Reason: row.Reason,
+		})
+	}
+	return res
+}
+
+func MapDtoExportBooksReqToPbExportReq(req dto.ExportBooksReq) *books.ExportReq {
+	return &books.ExportReq{
+		Format: req.Format,
+	}
+}
+
+func MapDtoExportMarcReqToPbExportReq(req dto.ExportMarcReq) *books.ExportReq {
+	return &books.ExportReq{
+		Format: req.Format,
+	}
+}
@@ -0,0 +1,55 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) AddCopy(ctx context.Context, req dto.AddCopyReq) (dto.CopyRes, error) {
+	var res *books.Copy
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.copies.AddCopy(ctx, MapDtoAddCopyReqToPbAddCopyReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.CopyRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbCopyToDtoCopyRes(res), nil
+}
+
+func (c *Client) ListCopies(ctx context.Context, req dto.GetCopiesReq) ([]dto.CopyRes, error) {
+	var res *books.CopyList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.copies.ListCopies(ctx, MapDtoGetCopiesReqToPbListCopiesReq(req))
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbCopyListToDtoCopiesRes(res), nil
+}
+
+func (c *Client) UpdateCopy(ctx context.Context, req dto.UpdateCopyReq) (dto.CopyRes, error) {
+	var res *books.Copy
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.copies.UpdateCopy(ctx, MapDtoUpdateCopyReqToPbUpdateCopyReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.CopyRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbCopyToDtoCopyRes(res), nil
+}
+
+func (c *Client) DeleteCopy(ctx context.Context, req dto.DeleteCopyReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.copies.DeleteCopy(ctx, MapDtoDeleteCopyReqToPbDeleteCopyReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoAddCopyReqToPbAddCopyReq(req dto.AddCopyReq) *books.AddCopyReq {
+	return &books.AddCopyReq{
+		BookId:        int32(req.BookID),
+		Barcode:       req.Barcode,
+		ShelfLocation: req.ShelfLocation,
+		Status:        req.Status,
+	}
+}
+
+func MapDtoGetCopiesReqToPbListCopiesReq(req dto.GetCopiesReq) *books.ListCopiesReq {
+	return &books.ListCopiesReq{
+		BookId: int32(req.BookID),
+	}
+}
+
+func MapDtoUpdateCopyReqToPbUpdateCopyReq(req dto.UpdateCopyReq) *books.UpdateCopyReq {
+	return &books.UpdateCopyReq{
+		Id:            int32(req.ID),
+		Barcode:       req.Barcode,
+		ShelfLocation: req.ShelfLocation,
+		Status:        req.Status,
+	}
+}
+
+func MapDtoDeleteCopyReqToPbDeleteCopyReq(req dto.DeleteCopyReq) *books.DeleteCopyReq {
+	return &books.DeleteCopyReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapPbCopyToDtoCopyRes(pb *books.Copy) dto.CopyRes {
+	return dto.CopyRes{
+		ID:            uint(pb.Id),
+		BookID:        uint(pb.BookId),
+		Barcode:       pb.Barcode,
+		ShelfLocation: pb.ShelfLocation,
+		Status:        pb.Status,
+		BorrowerID:    uint(pb.BorrowerId),
+		CreatedAt:     mapTime(pb.CreatedAt),
+	}
+}
+
+func MapPbCopyListToDtoCopiesRes(pb *books.CopyList) []dto.CopyRes {
+	var res []dto.CopyRes
+	for _, bookCopy := range pb.Copies {
+		res = append(res, MapPbCopyToDtoCopyRes(bookCopy))
+	}
+	return res
+}
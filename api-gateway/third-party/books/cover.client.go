@@ -0,0 +1,87 @@
+package books
+
+import (
+	"context"
+	"errors"
+	"io"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+	"time"
+)
+
+// CoverImage is a cover image, or its thumbnail, as stored by the books service.
+type CoverImage struct {
+	Key         string
+	ContentType string
+	UpdatedAt   time.Time
+	Data        []byte
+}
+
+func (c *Client) UpdateBookCover(ctx context.Context, req dto.UpdateBookCoverReq, image io.Reader) (dto.BookRes, error) {
+	var res *books.Book
+	err := c.caller.Stream(ctx, func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := c.covers.UpdateBookCover(ctx)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(MapDtoUpdateBookCoverReqToPbUpdateBookCoverReq(req)); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		err = sendFile(image, func(data []byte) error {
+			return stream.Send(&books.UpdateBookCoverReq{Part: &books.UpdateBookCoverReq_Data{Data: data}})
+		})
+		if err != nil {
+			return err
+		}
+		res, err = stream.CloseAndRecv()
+		return err
+	})
+	if err != nil {
+		return dto.BookRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbBookToDtoBookRes(res), nil
+}
+
+func (c *Client) DeleteBookCover(ctx context.Context, req dto.DeleteBookCoverReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.covers.DeleteBookCover(ctx, MapDtoDeleteBookCoverReqToPbDeleteBookCoverReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
+
+// GetBookCover receives the whole image, as it is served with its length and a validator.
+func (c *Client) GetBookCover(ctx context.Context, req dto.GetBookCoverReq) (CoverImage, error) {
+	var res CoverImage
+	err := c.caller.Stream(ctx, func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := c.covers.GetBookCover(ctx, MapDtoGetBookCoverReqToPbGetBookCoverReq(req))
+		if err != nil {
+			return err
+		}
+		for first := true; ; first = false {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if first {
+				res = MapPbCoverChunkToCoverImage(chunk)
+			}
+			res.Data = append(res.Data, chunk.Data...)
+		}
+	})
+	if err != nil {
+		return CoverImage{}, grpcconn.FromStatus(err)
+	}
+	return res, nil
+}
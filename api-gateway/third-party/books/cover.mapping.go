@@ -0,0 +1,35 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoUpdateBookCoverReqToPbUpdateBookCoverReq(req dto.UpdateBookCoverReq) *books.UpdateBookCoverReq {
+	return &books.UpdateBookCoverReq{
+		Part: &books.UpdateBookCoverReq_Id{Id: int32(req.ID)},
+	}
+}
+
+func MapDtoDeleteBookCoverReqToPbDeleteBookCoverReq(req dto.DeleteBookCoverReq) *books.DeleteBookCoverReq {
+	return &books.DeleteBookCoverReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoGetBookCoverReqToPbGetBookCoverReq(req dto.GetBookCoverReq) *books.GetBookCoverReq {
+	return &books.GetBookCoverReq{
+		Id:   int32(req.ID),
+		Size: req.Size,
+	}
+}
+
+// MapPbCoverChunkToCoverImage describes the image from its first chunk. The data is added up
+// from every chunk.
+func MapPbCoverChunkToCoverImage(pb *books.CoverChunk) CoverImage {
+	return CoverImage{
+		Key:         pb.Key,
+		ContentType: pb.ContentType,
+		UpdatedAt:   mapTime(pb.UpdatedAt),
+	}
+}
@@ -0,0 +1,56 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) GetMyFines(ctx context.Context) (dto.FineBalanceRes, error) {
+	var res *books.FineBalance
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.fines.GetMyFines(ctx, &books.GetMyFinesReq{})
+		return err
+	})
+	if err != nil {
+		return dto.FineBalanceRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbFineBalanceToDtoFineBalanceRes(res), nil
+}
+
+func (c *Client) GetUserFines(ctx context.Context, req dto.GetUserFinesReq) (dto.FineBalanceRes, error) {
+	var res *books.FineBalance
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.fines.GetUserFines(ctx, MapDtoGetUserFinesReqToPbGetUserFinesReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.FineBalanceRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbFineBalanceToDtoFineBalanceRes(res), nil
+}
+
+func (c *Client) RecordPayment(ctx context.Context, req dto.SettleFineReq) (dto.FineRes, error) {
+	var res *books.Fine
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.fines.RecordPayment(ctx, MapDtoSettleFineReqToPbSettleFineReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.FineRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbFineToDtoFineRes(res), nil
+}
+
+func (c *Client) WaiveFine(ctx context.Context, req dto.SettleFineReq) (dto.FineRes, error) {
+	var res *books.Fine
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.fines.WaiveFine(ctx, MapDtoSettleFineReqToPbSettleFineReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.FineRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbFineToDtoFineRes(res), nil
+}
@@ -0,0 +1,47 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoGetUserFinesReqToPbGetUserFinesReq(req dto.GetUserFinesReq) *books.GetUserFinesReq {
+	return &books.GetUserFinesReq{
+		UserId: int32(req.UserID),
+	}
+}
+
+func MapDtoSettleFineReqToPbSettleFineReq(req dto.SettleFineReq) *books.SettleFineReq {
+	return &books.SettleFineReq{
+		UserId:      int32(req.UserID),
+		AmountCents: req.AmountCents,
+		Note:        req.Note,
+	}
+}
+
+func MapPbFineToDtoFineRes(pb *books.Fine) dto.FineRes {
+	return dto.FineRes{
+		ID:          uint(pb.Id),
+		UserID:      uint(pb.UserId),
+		LoanID:      uint(pb.LoanId),
+		Kind:        pb.Kind,
+		AmountCents: pb.AmountCents,
+		Note:        pb.Note,
+		CreatedBy:   uint(pb.CreatedBy),
+		CreatedAt:   mapTime(pb.CreatedAt),
+	}
+}
+
+func MapPbFineBalanceToDtoFineBalanceRes(pb *books.FineBalance) dto.FineBalanceRes {
+	res := dto.FineBalanceRes{
+		UserID:           uint(pb.UserId),
+		ChargedCents:     pb.ChargedCents,
+		PaidCents:        pb.PaidCents,
+		WaivedCents:      pb.WaivedCents,
+		OutstandingCents: pb.OutstandingCents,
+	}
+	for _, fine := range pb.Entries {
+		res.Entries = append(res.Entries, MapPbFineToDtoFineRes(fine))
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) AddGenre(ctx context.Context, req dto.AddGenreReq) (dto.GenreRes, error) {
+	var res *books.Genre
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.genres.AddGenre(ctx, MapDtoAddGenreReqToPbAddGenreReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.GenreRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbGenreToDtoGenreRes(res), nil
+}
+
+func (c *Client) ListGenres(ctx context.Context) ([]dto.GenreRes, error) {
+	var res *books.GenreList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.genres.ListGenres(ctx, &books.ListGenresReq{})
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbGenreListToDtoGenresRes(res), nil
+}
+
+func (c *Client) UpdateGenre(ctx context.Context, req dto.UpdateGenreReq) (dto.GenreRes, error) {
+	var res *books.Genre
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.genres.UpdateGenre(ctx, MapDtoUpdateGenreReqToPbUpdateGenreReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.GenreRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbGenreToDtoGenreRes(res), nil
+}
+
+func (c *Client) DeleteGenre(ctx context.Context, req dto.DeleteGenreReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.genres.DeleteGenre(ctx, MapDtoDeleteGenreReqToPbDeleteGenreReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
+
+func (c *Client) MergeGenre(ctx context.Context, req dto.MergeGenreReq) (dto.GenreRes, error) {
+	var res *books.Genre
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.genres.MergeGenre(ctx, MapDtoMergeGenreReqToPbMergeGenreReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.GenreRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbGenreToDtoGenreRes(res), nil
+}
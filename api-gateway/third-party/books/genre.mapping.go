@@ -0,0 +1,56 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoAddGenreReqToPbAddGenreReq(req dto.AddGenreReq) *books.AddGenreReq {
+	return &books.AddGenreReq{
+		Name:     req.Name,
+		Synonyms: req.Synonyms,
+	}
+}
+
+func MapDtoUpdateGenreReqToPbUpdateGenreReq(req dto.UpdateGenreReq) *books.UpdateGenreReq {
+	return &books.UpdateGenreReq{
+		Id:       int32(req.ID),
+		Name:     req.Name,
+		Synonyms: req.Synonyms,
+	}
+}
+
+func MapDtoDeleteGenreReqToPbDeleteGenreReq(req dto.DeleteGenreReq) *books.DeleteGenreReq {
+	return &books.DeleteGenreReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoMergeGenreReqToPbMergeGenreReq(req dto.MergeGenreReq) *books.MergeGenreReq {
+	return &books.MergeGenreReq{
+		Id:     int32(req.ID),
+		IntoId: int32(req.IntoID),
+	}
+}
+
+func MapPbGenreToDtoGenreRes(pb *books.Genre) dto.GenreRes {
+	synonyms := pb.Synonyms
+	if synonyms == nil {
+		synonyms = []string{}
+	}
+	return dto.GenreRes{
+		ID:        uint(pb.Id),
+		Name:      pb.Name,
+		Synonyms:  synonyms,
+		BookCount: uint(pb.BookCount),
+		CreatedAt: mapTime(pb.CreatedAt),
+	}
+}
+
+func MapPbGenreListToDtoGenresRes(pb *books.GenreList) []dto.GenreRes {
+	res := []dto.GenreRes{}
+	for _, genre := range pb.Genres {
+		res = append(res, MapPbGenreToDtoGenreRes(genre))
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) PlaceHold(ctx context.Context, req dto.PlaceHoldReq) (dto.HoldRes, error) {
+	var res *books.Hold
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.holds.PlaceHold(ctx, MapDtoPlaceHoldReqToPbPlaceHoldReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.HoldRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbHoldToDtoHoldRes(res), nil
+}
+
+func (c *Client) ListMyHolds(ctx context.Context) ([]dto.HoldRes, error) {
+	var res *books.HoldList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.holds.ListMyHolds(ctx, &books.ListMyHoldsReq{})
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbHoldListToDtoHoldsRes(res), nil
+}
+
+func (c *Client) ListBookHolds(ctx context.Context, req dto.GetBookHoldsReq) ([]dto.HoldRes, error) {
+	var res *books.HoldList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.holds.ListBookHolds(ctx, MapDtoGetBookHoldsReqToPbListBookHoldsReq(req))
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbHoldListToDtoHoldsRes(res), nil
+}
+
+func (c *Client) CancelHold(ctx context.Context, req dto.CancelHoldReq) (dto.HoldRes, error) {
+	var res *books.Hold
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.holds.CancelHold(ctx, MapDtoCancelHoldReqToPbCancelHoldReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.HoldRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbHoldToDtoHoldRes(res), nil
+}
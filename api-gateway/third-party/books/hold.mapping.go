@@ -0,0 +1,48 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoPlaceHoldReqToPbPlaceHoldReq(req dto.PlaceHoldReq) *books.PlaceHoldReq {
+	return &books.PlaceHoldReq{
+		BookId:     int32(req.BookID),
+		AnyEdition: req.AnyEdition,
+	}
+}
+
+func MapDtoGetBookHoldsReqToPbListBookHoldsReq(req dto.GetBookHoldsReq) *books.ListBookHoldsReq {
+	return &books.ListBookHoldsReq{
+		BookId: int32(req.BookID),
+	}
+}
+
+func MapDtoCancelHoldReqToPbCancelHoldReq(req dto.CancelHoldReq) *books.CancelHoldReq {
+	return &books.CancelHoldReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapPbHoldToDtoHoldRes(pb *books.Hold) dto.HoldRes {
+	return dto.HoldRes{
+		ID:         uint(pb.Id),
+		BookID:     uint(pb.BookId),
+		UserID:     uint(pb.UserId),
+		CopyID:     uint(pb.CopyId),
+		AnyEdition: pb.AnyEdition,
+		Status:     pb.Status,
+		Position:   uint(pb.Position),
+		CreatedAt:  mapTime(pb.CreatedAt),
+		ReadyAt:    mapOptionalTime(pb.ReadyAt),
+		ExpiresAt:  mapOptionalTime(pb.ExpiresAt),
+	}
+}
+
+func MapPbHoldListToDtoHoldsRes(pb *books.HoldList) []dto.HoldRes {
+	var res []dto.HoldRes
+	for _, hold := range pb.Holds {
+		res = append(res, MapPbHoldToDtoHoldRes(hold))
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) ListLoans(ctx context.Context) ([]dto.LoanRes, error) {
+	var res *books.LoanList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.loans.ListLoans(ctx, &books.ListLoansReq{})
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbLoanListToDtoLoansRes(res), nil
+}
+
+func (c *Client) ListMyLoans(ctx context.Context) ([]dto.LoanRes, error) {
+	var res *books.LoanList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.loans.ListMyLoans(ctx, &books.ListMyLoansReq{})
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbLoanListToDtoLoansRes(res), nil
+}
+
+func (c *Client) ListUserLoans(ctx context.Context, req dto.GetUserLoansReq) ([]dto.LoanRes, error) {
+	var res *books.LoanList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.loans.ListUserLoans(ctx, MapDtoGetUserLoansReqToPbListUserLoansReq(req))
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbLoanListToDtoLoansRes(res), nil
+}
+
+func (c *Client) ListBookLoans(ctx context.Context, req dto.GetBookLoansReq) ([]dto.LoanRes, error) {
+	var res *books.LoanList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.loans.ListBookLoans(ctx, MapDtoGetBookLoansReqToPbListBookLoansReq(req))
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbLoanListToDtoLoansRes(res), nil
+}
@@ -0,0 +1,41 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoGetUserLoansReqToPbListUserLoansReq(req dto.GetUserLoansReq) *books.ListUserLoansReq {
+	return &books.ListUserLoansReq{
+		UserId: int32(req.UserID),
+	}
+}
+
+func MapDtoGetBookLoansReqToPbListBookLoansReq(req dto.GetBookLoansReq) *books.ListBookLoansReq {
+	return &books.ListBookLoansReq{
+		BookId: int32(req.BookID),
+	}
+}
+
+func MapPbLoanToDtoLoanRes(pb *books.Loan) dto.LoanRes {
+	return dto.LoanRes{
+		ID:         uint(pb.Id),
+		CopyID:     uint(pb.CopyId),
+		BookID:     uint(pb.BookId),
+		UserID:     uint(pb.UserId),
+		BorrowedAt: mapTime(pb.BorrowedAt),
+		DueAt:      mapOptionalTime(pb.DueAt),
+		ReturnedAt: mapOptionalTime(pb.ReturnedAt),
+		BorrowedBy: uint(pb.BorrowedBy),
+		ReturnedBy: uint(pb.ReturnedBy),
+		Renewals:   uint(pb.Renewals),
+	}
+}
+
+func MapPbLoanListToDtoLoansRes(pb *books.LoanList) []dto.LoanRes {
+	var res []dto.LoanRes
+	for _, loan := range pb.Loans {
+		res = append(res, MapPbLoanToDtoLoanRes(loan))
+	}
+	return res
+}
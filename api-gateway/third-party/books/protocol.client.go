@@ -0,0 +1,39 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+	"net/url"
+)
+
+// Document is a response of a metadata protocol, sent to the client as it is.
+type Document struct {
+	ContentType string
+	Data        []byte
+}
+
+// Harvesting and searching change nothing, so both are retried as idempotent calls.
+func (c *Client) Harvest(ctx context.Context, params url.Values) (Document, error) {
+	var res *books.Document
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.protocol.Harvest(ctx, MapUrlValuesToPbProtocolReq(params))
+		return err
+	})
+	if err != nil {
+		return Document{}, grpcconn.FromStatus(err)
+	}
+	return MapPbDocumentToDocument(res), nil
+}
+
+func (c *Client) SearchRetrieve(ctx context.Context, params url.Values) (Document, error) {
+	var res *books.Document
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.protocol.SearchRetrieve(ctx, MapUrlValuesToPbProtocolReq(params))
+		return err
+	})
+	if err != nil {
+		return Document{}, grpcconn.FromStatus(err)
+	}
+	return MapPbDocumentToDocument(res), nil
+}
@@ -0,0 +1,25 @@
+package books
+
+import (
+	"library-management-api/pkg/proto/books"
+	"maps"
+	"net/url"
+	"slices"
+)
+
+// MapUrlValuesToPbProtocolReq keeps the order of the values of a parameter, which the protocols
+// may rely on; parameters are sorted by name.
+func MapUrlValuesToPbProtocolReq(params url.Values) *books.ProtocolReq {
+	res := &books.ProtocolReq{}
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		res.Parameters = append(res.Parameters, &books.Parameter{Name: name, Values: params[name]})
+	}
+	return res
+}
+
+func MapPbDocumentToDocument(pb *books.Document) Document {
+	return Document{
+		ContentType: pb.ContentType,
+		Data:        pb.Data,
+	}
+}
@@ -0,0 +1,32 @@
+package books
+
+import (
+	"errors"
+	"io"
+)
+
+// chunkSize bounds the data sent in one message of an upload.
+const chunkSize = 32 * 1024
+
+// sendFile sends the content of r in chunks. It stops without an error when the books service
+// has ended the stream early, as the final receive then returns its status.
+func sendFile(r io.Reader, send func(data []byte) error) error {
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if err := send(buf[:n]); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) AddSubject(ctx context.Context, req dto.AddSubjectReq) (dto.SubjectRes, error) {
+	var res *books.Subject
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.subjects.AddSubject(ctx, MapDtoAddSubjectReqToPbAddSubjectReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.SubjectRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbSubjectToDtoSubjectRes(res), nil
+}
+
+func (c *Client) ListSubjects(ctx context.Context) ([]dto.SubjectRes, error) {
+	var res *books.SubjectList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.subjects.ListSubjects(ctx, &books.ListSubjectsReq{})
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbSubjectListToDtoSubjectsRes(res), nil
+}
+
+func (c *Client) UpdateSubject(ctx context.Context, req dto.UpdateSubjectReq) (dto.SubjectRes, error) {
+	var res *books.Subject
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.subjects.UpdateSubject(ctx, MapDtoUpdateSubjectReqToPbUpdateSubjectReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.SubjectRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbSubjectToDtoSubjectRes(res), nil
+}
+
+func (c *Client) DeleteSubject(ctx context.Context, req dto.DeleteSubjectReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.subjects.DeleteSubject(ctx, MapDtoDeleteSubjectReqToPbDeleteSubjectReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
+
+func (c *Client) MergeSubject(ctx context.Context, req dto.MergeSubjectReq) (dto.SubjectRes, error) {
+	var res *books.Subject
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.subjects.MergeSubject(ctx, MapDtoMergeSubjectReqToPbMergeSubjectReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.SubjectRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbSubjectToDtoSubjectRes(res), nil
+}
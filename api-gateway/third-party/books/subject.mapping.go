@@ -0,0 +1,52 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoAddSubjectReqToPbAddSubjectReq(req dto.AddSubjectReq) *books.AddSubjectReq {
+	return &books.AddSubjectReq{
+		Name:     req.Name,
+		ParentId: int32(req.ParentID),
+	}
+}
+
+func MapDtoUpdateSubjectReqToPbUpdateSubjectReq(req dto.UpdateSubjectReq) *books.UpdateSubjectReq {
+	return &books.UpdateSubjectReq{
+		Id:       int32(req.ID),
+		Name:     req.Name,
+		ParentId: int32(req.ParentID),
+	}
+}
+
+func MapDtoDeleteSubjectReqToPbDeleteSubjectReq(req dto.DeleteSubjectReq) *books.DeleteSubjectReq {
+	return &books.DeleteSubjectReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoMergeSubjectReqToPbMergeSubjectReq(req dto.MergeSubjectReq) *books.MergeSubjectReq {
+	return &books.MergeSubjectReq{
+		Id:     int32(req.ID),
+		IntoId: int32(req.IntoID),
+	}
+}
+
+func MapPbSubjectToDtoSubjectRes(pb *books.Subject) dto.SubjectRes {
+	return dto.SubjectRes{
+		ID:        uint(pb.Id),
+		Name:      pb.Name,
+		ParentID:  uint(pb.ParentId),
+		BookCount: uint(pb.BookCount),
+		CreatedAt: mapTime(pb.CreatedAt),
+	}
+}
+
+func MapPbSubjectListToDtoSubjectsRes(pb *books.SubjectList) []dto.SubjectRes {
+	res := []dto.SubjectRes{}
+	for _, subject := range pb.Subjects {
+		res = append(res, MapPbSubjectToDtoSubjectRes(subject))
+	}
+	return res
+}
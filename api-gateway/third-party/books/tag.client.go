@@ -0,0 +1,55 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) AddTag(ctx context.Context, req dto.AddTagReq) (dto.TagRes, error) {
+	var res *books.Tag
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.tags.AddTag(ctx, MapDtoAddTagReqToPbAddTagReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.TagRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbTagToDtoTagRes(res), nil
+}
+
+func (c *Client) ListTags(ctx context.Context) ([]dto.TagRes, error) {
+	var res *books.TagList
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.tags.ListTags(ctx, &books.ListTagsReq{})
+		return err
+	})
+	if err != nil {
+		return nil, grpcconn.FromStatus(err)
+	}
+	return MapPbTagListToDtoTagsRes(res), nil
+}
+
+func (c *Client) UpdateTag(ctx context.Context, req dto.UpdateTagReq) (dto.TagRes, error) {
+	var res *books.Tag
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.tags.UpdateTag(ctx, MapDtoUpdateTagReqToPbUpdateTagReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.TagRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbTagToDtoTagRes(res), nil
+}
+
+func (c *Client) DeleteTag(ctx context.Context, req dto.DeleteTagReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.tags.DeleteTag(ctx, MapDtoDeleteTagReqToPbDeleteTagReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoAddTagReqToPbAddTagReq(req dto.AddTagReq) *books.AddTagReq {
+	return &books.AddTagReq{
+		Name: req.Name,
+	}
+}
+
+func MapDtoUpdateTagReqToPbUpdateTagReq(req dto.UpdateTagReq) *books.UpdateTagReq {
+	return &books.UpdateTagReq{
+		Id:   int32(req.ID),
+		Name: req.Name,
+	}
+}
+
+func MapDtoDeleteTagReqToPbDeleteTagReq(req dto.DeleteTagReq) *books.DeleteTagReq {
+	return &books.DeleteTagReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapPbTagToDtoTagRes(pb *books.Tag) dto.TagRes {
+	return dto.TagRes{
+		ID:        uint(pb.Id),
+		Name:      pb.Name,
+		BookCount: uint(pb.BookCount),
+		CreatedAt: mapTime(pb.CreatedAt),
+	}
+}
+
+func MapPbTagListToDtoTagsRes(pb *books.TagList) []dto.TagRes {
+	res := []dto.TagRes{}
+	for _, tag := range pb.Tags {
+		res = append(res, MapPbTagToDtoTagRes(tag))
+	}
+	return res
+}
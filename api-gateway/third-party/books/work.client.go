@@ -0,0 +1,32 @@
+package books
+
+import (
+	"context"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+)
+
+func (c *Client) AddWork(ctx context.Context, req dto.AddWorkReq) (dto.WorkRes, error) {
+	var res *books.Work
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.works.AddWork(ctx, MapDtoAddWorkReqToPbAddWorkReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.WorkRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbWorkToDtoWorkRes(res), nil
+}
+
+func (c *Client) GetWork(ctx context.Context, req dto.GetWorkReq) (dto.WorkRes, error) {
+	var res *books.Work
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.works.GetWork(ctx, MapDtoGetWorkReqToPbGetWorkReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.WorkRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbWorkToDtoWorkRes(res), nil
+}
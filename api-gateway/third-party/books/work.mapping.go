@@ -0,0 +1,34 @@
+package books
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDtoAddWorkReqToPbAddWorkReq(req dto.AddWorkReq) *books.AddWorkReq {
+	return &books.AddWorkReq{
+		Title: req.Title,
+	}
+}
+
+func MapDtoGetWorkReqToPbGetWorkReq(req dto.GetWorkReq) *books.GetWorkReq {
+	return &books.GetWorkReq{
+		Id: int32(req.ID),
+	}
+}
+
+// MapPbWorkToDtoWorkRes adds up the copies of every edition of the work.
+func MapPbWorkToDtoWorkRes(pb *books.Work) dto.WorkRes {
+	res := dto.WorkRes{
+		ID:        uint(pb.Id),
+		Title:     pb.Title,
+		Editions:  []dto.BookRes{},
+		CreatedAt: mapTime(pb.CreatedAt),
+	}
+	for _, edition := range pb.Editions {
+		res.Editions = append(res.Editions, MapPbBookToDtoBookRes(edition))
+		res.TotalCopies += uint(edition.TotalCopies)
+		res.AvailableCopies += uint(edition.AvailableCopies)
+	}
+	return res
+}
@@ -0,0 +1,102 @@
+package users
+
+import (
+	"context"
+	"library-management-api/api-gateway/configs"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/user"
+	"library-management-api/util/grpcconn"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Client interface for UsersService
+type IClient interface {
+	AddUser(ctx context.Context, req dto.AddUserReq) (dto.UserRes, error)
+	ListUsers(ctx context.Context, req dto.GetUsersReq) (dto.UserListRes, error)
+	GetUserByID(ctx context.Context, req dto.GetUserReq) (dto.UserRes, error)
+	UpdateUser(ctx context.Context, req dto.UpdateUserReq) (dto.UserRes, error)
+	DeleteUser(ctx context.Context, req dto.DeleteUserReq) error
+}
+
+// Client struct for managing connection
+type Client struct {
+	c      user.UsersServiceClient // gRPC client
+	caller *grpcconn.Caller        // timeouts, retries and circuit breaking of the calls
+}
+
+// NewClient creates a new gRPC client for UsersService
+func NewClient() (IClient, error) {
+	// Share the long-lived connection to the server
+	conn, err := grpcconn.Conn("users-service", configs.C().Services.Users)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create grpc client")
+		return nil, err
+	}
+
+	return &Client{
+		c:      user.NewUsersServiceClient(conn),
+		caller: grpcconn.CallerFor("users-service", configs.C().Services.Users.Policy),
+	}, nil
+}
+
+// Lookups are retried as idempotent calls; changes to users are not, as a call that timed out
+// may still have been applied.
+func (c *Client) AddUser(ctx context.Context, req dto.AddUserReq) (dto.UserRes, error) {
+	var res *user.User
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.c.AddUser(ctx, MapDtoAddUserReqToPbAddUserReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.UserRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbUserToDtoUserRes(res), nil
+}
+
+func (c *Client) ListUsers(ctx context.Context, req dto.GetUsersReq) (dto.UserListRes, error) {
+	var res *user.UserPage
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.c.ListUsers(ctx, MapDtoGetUsersReqToPbListUsersReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.UserListRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbUserPageToDtoUserListRes(res), nil
+}
+
+func (c *Client) GetUserByID(ctx context.Context, req dto.GetUserReq) (dto.UserRes, error) {
+	var res *user.User
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.c.GetUserByID(ctx, MapDtoGetUserReqToPbGetUserByIDReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.UserRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbUserToDtoUserRes(res), nil
+}
+
+func (c *Client) UpdateUser(ctx context.Context, req dto.UpdateUserReq) (dto.UserRes, error) {
+	var res *user.User
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.c.UpdateUser(ctx, MapDtoUpdateUserReqToPbUpdateUserReq(req))
+		return err
+	})
+	if err != nil {
+		return dto.UserRes{}, grpcconn.FromStatus(err)
+	}
+	return MapPbUserToDtoUserRes(res), nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, req dto.DeleteUserReq) error {
+	err := c.caller.Call(ctx, false, func(ctx context.Context) (err error) {
+		_, err = c.c.DeleteUser(ctx, MapDtoDeleteUserReqToPbDeleteUserReq(req))
+		return err
+	})
+	if err != nil {
+		return grpcconn.FromStatus(err)
+	}
+	return nil
+}
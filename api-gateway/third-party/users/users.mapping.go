@@ -0,0 +1,88 @@
+package users
+
+import (
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/user"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// mapTime returns the zero time for an unset timestamp. Times are given in the local zone, as
+// the users service reads them from the database.
+func mapTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime().Local()
+}
+
+func MapDtoAddUserReqToPbAddUserReq(req dto.AddUserReq) *user.AddUserReq {
+	return &user.AddUserReq{
+		Username: req.Username,
+		Password: req.Password,
+		Email:    req.Email,
+		IsAdmin:  req.IsAdmin,
+	}
+}
+
+func MapPbUserToDtoUserRes(pb *user.User) dto.UserRes {
+	return dto.UserRes{
+		ID:        uint(pb.Id),
+		Username:  pb.Username,
+		Email:     pb.Email,
+		IsAdmin:   pb.IsAdmin,
+		CreatedAt: mapTime(pb.CreatedAt),
+	}
+}
+
+func MapDtoGetUsersReqToPbListUsersReq(req dto.GetUsersReq) *user.ListUsersReq {
+	res := &user.ListUsersReq{
+		List: &user.ListQuery{
+			Limit:  int32(req.Limit),
+			Page:   int32(req.Page),
+			Cursor: req.Cursor,
+			Sort:   req.Sort,
+			Order:  req.Order,
+		},
+	}
+	if req.IsAdmin != nil {
+		res.IsAdmin = wrapperspb.Bool(*req.IsAdmin)
+	}
+	return res
+}
+
+func MapPbUserPageToDtoUserListRes(pb *user.UserPage) dto.UserListRes {
+	res := dto.UserListRes{
+		Data:       []dto.UserRes{},
+		Total:      int(pb.Total),
+		NextCursor: pb.NextCursor,
+	}
+	for _, u := range pb.Users {
+		res.Data = append(res.Data, MapPbUserToDtoUserRes(u))
+	}
+	return res
+}
+
+func MapDtoGetUserReqToPbGetUserByIDReq(req dto.GetUserReq) *user.GetUserByIDReq {
+	return &user.GetUserByIDReq{
+		Id: int32(req.ID),
+	}
+}
+
+func MapDtoUpdateUserReqToPbUpdateUserReq(req dto.UpdateUserReq) *user.UpdateUserReq {
+	return &user.UpdateUserReq{
+		Id:       int32(req.ID),
+		Username: req.Username,
+		Password: req.Password,
+		Email:    req.Email,
+		IsAdmin:  req.IsAdmin,
+	}
+}
+
+func MapDtoDeleteUserReqToPbDeleteUserReq(req dto.DeleteUserReq) *user.DeleteUserReq {
+	return &user.DeleteUserReq{
+		Id: int32(req.ID),
+	}
+}
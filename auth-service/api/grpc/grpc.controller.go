@@ -4,6 +4,8 @@ import (
 	"context"
 	"library-management-api/auth-service/core/usecase"
 	"library-management-api/pkg/proto/auth"
+
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type AuthController struct {
@@ -32,3 +34,34 @@ func (c *AuthController) VerifyToken(ctx context.Context, in *auth.VerifyTokenRe
 	}
 	return MapDomainAuthToProtoVerifyTokenRes(claims), nil
 }
+
+func (c *AuthController) Login(ctx context.Context, in *auth.LoginReq) (*auth.LoginRes, error) {
+	res, err := c.authUseCase.Login(ctx, MapProtoLoginReqToDomainAuth(in))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainAuthToProtoLoginRes(res), nil
+}
+
+// Logout ends the session of the access token sent in the metadata.
+func (c *AuthController) Logout(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := c.authUseCase.Logout(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *AuthController) RefreshToken(ctx context.Context, in *auth.RefreshTokenReq) (*auth.RefreshTokenRes, error) {
+	res, err := c.authUseCase.RefreshToken(ctx, MapProtoRefreshTokenReqToDomainAuth(in))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainAuthToProtoRefreshTokenRes(res), nil
+}
+
+func (c *AuthController) RevokeToken(ctx context.Context, in *auth.RevokeTokenReq) (*emptypb.Empty, error) {
+	if err := c.authUseCase.RevokeToken(ctx, MapProtoRevokeTokenReqToDomainAuth(in)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
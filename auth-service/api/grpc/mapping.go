@@ -3,6 +3,8 @@ package grpc
 import (
 	"library-management-api/auth-service/core/domain"
 	"library-management-api/pkg/proto/auth"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func MapProtoHashedPasswordReqToDomainAuth(in *auth.HashedPasswordReq) domain.Auth {
@@ -32,3 +34,40 @@ func MapDomainAuthToProtoVerifyTokenRes(res domain.Auth) *auth.VerifyTokenRes {
 		Duration: int64(res.Claims.Duration),
 	}
 }
+
+func MapProtoLoginReqToDomainAuth(in *auth.LoginReq) domain.Auth {
+	return domain.Auth{
+		Username: in.Username,
+		Password: in.Password,
+	}
+}
+
+func MapDomainAuthToProtoLoginRes(res domain.Auth) *auth.LoginRes {
+	return &auth.LoginRes{
+		Id:                    int32(res.RefreshTokenID),
+		AccessToken:           res.AccessToken,
+		RefreshToken:          res.RefreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(res.AccessTokenExpiresAt),
+		RefreshTokenExpiresAt: timestamppb.New(res.RefreshTokenExpiresAt),
+		UserId:                int32(res.RefreshTokenUserID),
+	}
+}
+
+func MapProtoRefreshTokenReqToDomainAuth(in *auth.RefreshTokenReq) domain.Auth {
+	return domain.Auth{
+		RefreshToken: in.RefreshToken,
+	}
+}
+
+func MapDomainAuthToProtoRefreshTokenRes(res domain.Auth) *auth.RefreshTokenRes {
+	return &auth.RefreshTokenRes{
+		AccessToken:          res.AccessToken,
+		AccessTokenExpiresAt: timestamppb.New(res.AccessTokenExpiresAt),
+	}
+}
+
+func MapProtoRevokeTokenReqToDomainAuth(in *auth.RevokeTokenReq) domain.Auth {
+	return domain.Auth{
+		RefreshToken: in.RefreshToken,
+	}
+}
@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
+	"library-management-api/util/grpcconn"
 	"time"
 )
 
 // Config holds the application wide configurations.
 // The values are read by viper from the config file or environment variables.
 type Config struct {
-	JWT  JWT  `mapstructure:"jwt"`
-	PSQL PSQL `mapstructure:"psql"`
+	JWT   JWT   `mapstructure:"jwt"`
+	PSQL  PSQL  `mapstructure:"psql"`
+	GRPC  GRPC  `mapstructure:"grpc"`
+	Peers Peers `mapstructure:"peers"`
 }
 
 type JWT struct {
@@ -29,6 +32,20 @@ type PSQL struct {
 	SSLMode  string `mapstructure:"ssl_mode"`
 }
 
+// GRPC holds the address the gRPC server of the service listens on, and the deadlines given to
+// unary calls that arrive without one. Streams are only cut once they have sent and received
+// nothing for StreamIdleTimeout.
+type GRPC struct {
+	Address           string        `mapstructure:"address"`
+	UnaryTimeout      time.Duration `mapstructure:"unary_timeout"`
+	StreamIdleTimeout time.Duration `mapstructure:"stream_idle_timeout"`
+}
+
+// Peers holds how to reach the services this one calls.
+type Peers struct {
+	Users grpcconn.Peer `mapstructure:"users"`
+}
+
 var c *Config
 
 // C returns the loaded configuration globally.
@@ -69,6 +86,12 @@ func LoadConfig(path string) (*Config, error) {
 	if err := validatePSQLConfig(config.PSQL); err != nil {
 		return nil, err
 	}
+	if err := validateGRPCConfig(config.GRPC); err != nil {
+		return nil, err
+	}
+	if err := validatePeersConfig(config.Peers); err != nil {
+		return nil, err
+	}
 
 	return &config, nil
 }
@@ -81,6 +104,19 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("psql.password", "secret")
 	v.SetDefault("psql.database", "library_auth_db")
 	v.SetDefault("psql.ssl_mode", "disable")
+	v.SetDefault("grpc.address", ":8081")
+	v.SetDefault("grpc.unary_timeout", "10s")
+	v.SetDefault("grpc.stream_idle_timeout", "5m")
+	v.SetDefault("peers.users.addresses", []string{"localhost:8082"})
+	v.SetDefault("peers.users.dial_timeout", "5s")
+	v.SetDefault("peers.users.keepalive_time", "30s")
+	v.SetDefault("peers.users.keepalive_timeout", "10s")
+	v.SetDefault("peers.users.policy.call_timeout", "2s")
+	v.SetDefault("peers.users.policy.max_retries", 2)
+	v.SetDefault("peers.users.policy.retry_backoff", "100ms")
+	v.SetDefault("peers.users.policy.max_retry_backoff", "1s")
+	v.SetDefault("peers.users.policy.breaker_failures", 5)
+	v.SetDefault("peers.users.policy.breaker_cooldown", "30s")
 }
 
 // validatePSQLConfig ensures that essential PSQL config values are present.
@@ -103,6 +139,31 @@ func validatePSQLConfig(psqlConfig PSQL) error {
 	return nil
 }
 
+// validateGRPCConfig ensures that the gRPC server has an address to listen on.
+func validateGRPCConfig(grpcConfig GRPC) error {
+	if grpcConfig.Address == "" {
+		return fmt.Errorf("grpc address is required")
+	}
+	if grpcConfig.UnaryTimeout <= 0 {
+		return fmt.Errorf("grpc unary timeout must be positive")
+	}
+	if grpcConfig.StreamIdleTimeout <= 0 {
+		return fmt.Errorf("grpc stream idle timeout must be positive")
+	}
+	return nil
+}
+
+// validatePeersConfig ensures that the services called by this one can be reached.
+func validatePeersConfig(peersConfig Peers) error {
+	if err := peersConfig.Users.Validate("users service"); err != nil {
+		return err
+	}
+	if err := peersConfig.Users.Policy.Validate("users service"); err != nil {
+		return err
+	}
+	return nil
+}
+
 // RunConfig initializes and loads the configuration.
 func RunConfig(path string) {
 	config, err := LoadConfig(path)
@@ -4,17 +4,19 @@ import (
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 	grpcController "library-management-api/auth-service/api/grpc"
+	"library-management-api/auth-service/configs"
 	"library-management-api/pkg/proto/auth"
+	"library-management-api/util/grpcconn"
 	"net"
 )
 
 func RunGRPC() {
-	lis, err := net.Listen("tcp", ":8081")
+	lis, err := net.Listen("tcp", configs.C().GRPC.Address)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to listen")
 	}
 
-	srv := grpc.NewServer()
+	srv := grpc.NewServer(grpcconn.ServerOptions(configs.C().GRPC.UnaryTimeout, configs.C().GRPC.StreamIdleTimeout)...)
 	authController := grpcController.NewAuthController()
 	auth.RegisterAuthServiceServer(srv, authController)
 
@@ -3,9 +3,13 @@ package user
 import (
 	"context"
 	"github.com/rs/zerolog/log"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"library-management-api/auth-service/configs"
 	"library-management-api/pkg/proto/user"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/grpcconn"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Client interface for UserService
@@ -15,13 +19,14 @@ type IClient interface {
 
 // Client struct for managing connection
 type Client struct {
-	c user.UsersServiceClient // gRPC client
+	c      user.UsersServiceClient // gRPC client
+	caller *grpcconn.Caller        // timeouts, retries and circuit breaking of the calls
 }
 
 // NewClient creates a new gRPC client for AuthService
 func NewClient() (IClient, error) {
-	// Establish gRPC connection with the server
-	conn, err := grpc.NewClient("localhost:8082", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Share the long-lived connection to the server
+	conn, err := grpcconn.Conn("users-service", configs.C().Peers.Users)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to create grpc client")
 		return nil, err
@@ -29,15 +34,32 @@ func NewClient() (IClient, error) {
 	client := user.NewUsersServiceClient(conn)
 
 	return &Client{
-		c: client,
+		c:      client,
+		caller: grpcconn.CallerFor("users-service", configs.C().Peers.Users.Policy),
 	}, nil
 }
 
+// GetUserByUsername only reads, so it is retried as an idempotent call.
 func (c *Client) GetUserByUsername(ctx context.Context, req GetUserReq) (UserRes, error) {
-	res, err := c.c.GetUserByUsername(ctx, MapDtoGetUserReqToPbGetUserReq(req))
+	var res *user.UserRes
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.c.GetUserByUsername(ctx, MapDtoGetUserReqToPbGetUserReq(req))
+		return err
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("failed to call GetUserByUsername")
-		return UserRes{}, err
+		return UserRes{}, mapError(err)
 	}
 	return MapPbGetUserResToDtoGetUserRes(res), nil
 }
+
+// mapError turns the errors of the users service back into the errors callers check for.
+func mapError(err error) error {
+	if grpcconn.Unreachable(err) {
+		return errorhandler.ErrServiceUnavailable
+	}
+	if status.Code(err) == codes.NotFound {
+		return errorhandler.ErrUserNotFound
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+// Package cache is a small in-memory cache for values that may be served slightly stale.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// Cache keeps up to size values for ttl each. It is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	entries map[K]entry[V]
+}
+
+func New[K comparable, V any](ttl time.Duration, size int) *Cache[K, V] {
+	return &Cache[K, V]{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[K]entry[V], size),
+	}
+}
+
+// Get returns the value stored under key unless it has expired.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Set stores value under key. When the cache is full, expired values are dropped and, if that
+// frees no room, the value closest to expiring is evicted.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		c.evict(now)
+	}
+	c.entries[key] = entry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *Cache[K, V]) evict(now time.Time) {
+	var oldest K
+	var oldestAt time.Time
+	for key, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestAt.IsZero() || e.expiresAt.Before(oldestAt) {
+			oldest, oldestAt = key, e.expiresAt
+		}
+	}
+	if len(c.entries) >= c.size {
+		delete(c.entries, oldest)
+	}
+}
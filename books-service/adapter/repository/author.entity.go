@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Author struct {
+	ID        uint
+	Name      sql.NullString
+	BookCount uint
+	CreatedAt sql.NullTime
+}
+
+func MapAuthorEntityToAuthorDomain(author Author) domain.Author {
+	return domain.Author{
+		ID:        author.ID,
+		Name:      author.Name.String,
+		BookCount: author.BookCount,
+		CreatedAt: author.CreatedAt.Time,
+	}
+}
+
+func MapAuthorsEntityToAuthorsDomain(authors []Author) []domain.Author {
+	var res []domain.Author
+	for _, author := range authors {
+		res = append(res, MapAuthorEntityToAuthorDomain(author))
+	}
+	return res
+}
+
+func MapAuthorDomainToAuthorEntity(author domain.Author) Author {
+	return Author{
+		ID:        author.ID,
+		Name:      sql.NullString{String: author.Name, Valid: author.Name != ""},
+		CreatedAt: sql.NullTime{Time: author.CreatedAt, Valid: true},
+	}
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/listquery"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgconn"
+)
+
+// authorColumns selects an author row together with the number of books credited to the author.
+// Every query using it must alias the authors table as "a".
+const authorColumns = "a.id, a.name, (SELECT COUNT(*) FROM book_authors ba WHERE ba.author_id = a.id), a.created_at"
+
+func scanAuthor(row scanner) (Author, error) {
+	var author Author
+	err := row.Scan(&author.ID, &author.Name, &author.BookCount, &author.CreatedAt)
+	return author, err
+}
+
+// mapAuthorWriteError translates constraint violations on the authors table into domain errors.
+func mapAuthorWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "authors_name_key_key":
+			return errorhandler.ErrDuplicateAuthor
+		case "authors_name_key_check":
+			return errorhandler.ErrInvalidAuthorName
+		case "book_authors_author_id_fkey":
+			return errorhandler.ErrAuthorHasBooks
+		}
+	}
+	return err
+}
+
+type AuthorRepository struct {
+	db *sql.DB
+}
+
+func NewAuthorRepository() ports.AuthorRepository {
+	return &AuthorRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddAuthor implements ports.AuthorRepository.
+func (r *AuthorRepository) AddAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	mappedAuthor := MapAuthorDomainToAuthorEntity(author)
+
+	query := "INSERT INTO authors AS a (name) VALUES ($1) RETURNING " + authorColumns
+	addedAuthor, err := scanAuthor(r.db.QueryRowContext(ctx, query, mappedAuthor.Name))
+	if err != nil {
+		return domain.Author{}, mapAuthorWriteError(err)
+	}
+	res := MapAuthorEntityToAuthorDomain(addedAuthor)
+	return res, nil
+}
+
+// authorSorting lists the columns authors can be sorted by.
+var authorSorting = listquery.Sorting{
+	Columns: map[string]listquery.Column{
+		"id":         {Expr: "a.id", Cast: "int"},
+		"name":       {Expr: "a.name", Cast: "text"},
+		"created_at": {Expr: "a.created_at", Cast: "timestamptz"},
+	},
+	Default: "id",
+	ID:      "a.id",
+}
+
+// authorSortValue returns the value an author is sorted by, as stored in a cursor.
+func authorSortValue(author Author, sort string) string {
+	switch sort {
+	case "name":
+		return author.Name.String
+	case "created_at":
+		return author.CreatedAt.Time.Format(time.RFC3339Nano)
+	}
+	return strconv.FormatUint(uint64(author.ID), 10)
+}
+
+// GetAuthors implements ports.AuthorRepository.
+func (r *AuthorRepository) GetAuthors(ctx context.Context, filter domain.AuthorFilter, query listquery.Query) (listquery.Page[domain.Author], error) {
+	if query.Sort == "" {
+		query.Sort = authorSorting.Default
+	}
+
+	var qb listquery.Builder
+	if filter.Name != "" {
+		qb.Where("a.name ILIKE " + qb.Arg("%"+likeEscaper.Replace(filter.Name)+"%"))
+	}
+
+	pageClause, pageArgs, err := qb.Page(query, authorSorting)
+	if err != nil {
+		return listquery.Page[domain.Author]{}, err
+	}
+
+	var total int
+	filterClause, filterArgs := qb.Filter()
+	err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM authors a"+filterClause, filterArgs...).Scan(&total)
+	if err != nil {
+		return listquery.Page[domain.Author]{}, err
+	}
+
+	var authors []Author
+	rows, err := r.db.QueryContext(ctx, "SELECT "+authorColumns+" FROM authors a"+pageClause, pageArgs...)
+	if err != nil {
+		return listquery.Page[domain.Author]{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		author, err := scanAuthor(rows)
+		if err != nil {
+			return listquery.Page[domain.Author]{}, err
+		}
+		authors = append(authors, author)
+	}
+	if err := rows.Err(); err != nil {
+		return listquery.Page[domain.Author]{}, err
+	}
+	authors, more := listquery.Trim(authors, query)
+
+	page := listquery.Page[domain.Author]{
+		Items: MapAuthorsEntityToAuthorsDomain(authors),
+		Total: total,
+	}
+	if more {
+		last := authors[len(authors)-1]
+		page.NextCursor = listquery.Cursor{Value: authorSortValue(last, query.Sort), ID: last.ID}.Encode()
+	}
+	return page, nil
+}
+
+// GetAuthor implements ports.AuthorRepository.
+func (r *AuthorRepository) GetAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	query := "SELECT " + authorColumns + " FROM authors a WHERE a.id=$1"
+	foundAuthor, err := scanAuthor(r.db.QueryRowContext(ctx, query, author.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Author{}, errorhandler.ErrAuthorNotFound
+		}
+		return domain.Author{}, err
+	}
+	res := MapAuthorEntityToAuthorDomain(foundAuthor)
+	return res, nil
+}
+
+// UpdateAuthor implements ports.AuthorRepository.
+// The author column of every book credited to the author is rewritten in the same transaction.
+func (r *AuthorRepository) UpdateAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	mappedAuthor := MapAuthorDomainToAuthorEntity(author)
+
+	var updatedAuthor Author
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		query := "UPDATE authors AS a SET name=$1 WHERE a.id=$2 RETURNING " + authorColumns
+		updatedAuthor, err = scanAuthor(tx.QueryRowContext(ctx, query, mappedAuthor.Name, mappedAuthor.ID))
+		if err != nil {
+			return err
+		}
+		return syncBookAuthorTx(ctx, tx, "ba.author_id = $1", mappedAuthor.ID)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Author{}, errorhandler.ErrAuthorNotFound
+		}
+		return domain.Author{}, mapAuthorWriteError(err)
+	}
+	res := MapAuthorEntityToAuthorDomain(updatedAuthor)
+	return res, nil
+}
+
+// DeleteAuthor implements ports.AuthorRepository.
+// Authors that are still credited with books cannot be deleted.
+func (r *AuthorRepository) DeleteAuthor(ctx context.Context, author domain.Author) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM authors WHERE id=$1", author.ID)
+	if err != nil {
+		return mapAuthorWriteError(err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errorhandler.ErrAuthorNotFound
+	}
+	return nil
+}
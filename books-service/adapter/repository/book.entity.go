@@ -2,35 +2,111 @@ package repository
 
 import (
 	"database/sql"
+	"encoding/json"
 	"library-management-api/books-service/core/domain"
 )
 
 type Book struct {
-	ID            uint
-	Title         sql.NullString
-	Author        sql.NullString
-	Category      sql.NullString
-	Subject       sql.NullString
-	Genre         sql.NullString
-	PublishedYear uint
-	Available     sql.NullBool
-	BorrowerID    sql.NullInt32
-	CreatedAt     sql.NullTime
+	ID              uint
+	Title           sql.NullString
+	Author          sql.NullString
+	Category        sql.NullString
+	Subject         sql.NullString
+	Genre           sql.NullString
+	PublishedYear   uint
+	ISBN10          sql.NullString
+	ISBN13          sql.NullString
+	CreatedAt       sql.NullTime
+	UpdatedAt       sql.NullTime
+	TotalCopies     uint
+	AvailableCopies uint
+	// Authors is a JSON array of the credited authors, as aggregated by bookColumns.
+	Authors      []byte
+	WorkID       sql.NullInt32
+	WorkTitle    sql.NullString
+	Edition      sql.NullString
+	Language     sql.NullString
+	Format       sql.NullString
+	SeriesID     sql.NullInt32
+	SeriesTitle  sql.NullString
+	SeriesVolume sql.NullInt32
+	// Tags is a JSON array of the tags of the book, as aggregated by bookColumns.
+	Tags              []byte
+	CoverKey          sql.NullString
+	CoverThumbnailKey sql.NullString
+	CoverContentType  sql.NullString
+	CoverUpdatedAt    sql.NullTime
+}
+
+// BookAuthor is an author as aggregated into Book.Authors.
+type BookAuthor struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// BookTag is a tag as aggregated into Book.Tags.
+type BookTag struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
 }
 
 func MapBookEntityToBookDomain(book Book) domain.Book {
-	return domain.Book{
-		ID:            book.ID,
-		Title:         book.Title.String,
-		Author:        book.Author.String,
-		Category:      book.Category.String,
-		Subject:       book.Subject.String,
-		Genre:         book.Genre.String,
-		PublishedYear: book.PublishedYear,
-		Available:     book.Available.Bool,
-		BorrowerID:    uint(book.BorrowerID.Int32),
-		CreatedAt:     book.CreatedAt.Time,
+	var authors []BookAuthor
+	_ = json.Unmarshal(book.Authors, &authors)
+	var tags []BookTag
+	_ = json.Unmarshal(book.Tags, &tags)
+	res := domain.Book{
+		ID:              book.ID,
+		Title:           book.Title.String,
+		Author:          book.Author.String,
+		Authors:         MapBookAuthorsEntityToAuthorsDomain(authors),
+		Tags:            MapBookTagsEntityToTagsDomain(tags),
+		Category:        book.Category.String,
+		Subject:         book.Subject.String,
+		Genre:           book.Genre.String,
+		PublishedYear:   book.PublishedYear,
+		ISBN10:          book.ISBN10.String,
+		ISBN13:          book.ISBN13.String,
+		Edition:         book.Edition.String,
+		Language:        book.Language.String,
+		Format:          domain.BookFormat(book.Format.String),
+		SeriesVolume:    uint(book.SeriesVolume.Int32),
+		TotalCopies:     book.TotalCopies,
+		AvailableCopies: book.AvailableCopies,
+		CreatedAt:       book.CreatedAt.Time,
+		UpdatedAt:       book.UpdatedAt.Time,
 	}
+	if book.WorkID.Valid {
+		res.Work = &domain.Work{ID: uint(book.WorkID.Int32), Title: book.WorkTitle.String}
+	}
+	if book.SeriesID.Valid {
+		res.Series = &domain.Series{ID: uint(book.SeriesID.Int32), Title: book.SeriesTitle.String}
+	}
+	if book.CoverKey.Valid {
+		res.Cover = &domain.Cover{
+			Key:          book.CoverKey.String,
+			ThumbnailKey: book.CoverThumbnailKey.String,
+			ContentType:  book.CoverContentType.String,
+			UpdatedAt:    book.CoverUpdatedAt.Time,
+		}
+	}
+	return res
+}
+
+func MapBookAuthorsEntityToAuthorsDomain(authors []BookAuthor) []domain.Author {
+	var res []domain.Author
+	for _, author := range authors {
+		res = append(res, domain.Author{ID: author.ID, Name: author.Name})
+	}
+	return res
+}
+
+func MapBookTagsEntityToTagsDomain(tags []BookTag) []domain.Tag {
+	var res []domain.Tag
+	for _, tag := range tags {
+		res = append(res, domain.Tag{ID: tag.ID, Name: tag.Name})
+	}
+	return res
 }
 
 func MapBooksEntityToBooksDomain(books []Book) []domain.Book {
@@ -42,7 +118,7 @@ func MapBooksEntityToBooksDomain(books []Book) []domain.Book {
 }
 
 func MapBookDomainToBookEntity(book domain.Book) Book {
-	return Book{
+	res := Book{
 		ID:            book.ID,
 		Title:         sql.NullString{String: book.Title, Valid: book.Title != ""},
 		Author:        sql.NullString{String: book.Author, Valid: book.Author != ""},
@@ -50,8 +126,76 @@ func MapBookDomainToBookEntity(book domain.Book) Book {
 		Subject:       sql.NullString{String: book.Subject, Valid: book.Subject != ""},
 		Genre:         sql.NullString{String: book.Genre, Valid: book.Genre != ""},
 		PublishedYear: book.PublishedYear,
-		Available:     sql.NullBool{Bool: book.Available, Valid: true},
-		BorrowerID:    sql.NullInt32{Int32: int32(book.BorrowerID), Valid: book.BorrowerID > 0},
+		ISBN10:        sql.NullString{String: book.ISBN10, Valid: book.ISBN10 != ""},
+		ISBN13:        sql.NullString{String: book.ISBN13, Valid: book.ISBN13 != ""},
+		Edition:       sql.NullString{String: book.Edition, Valid: book.Edition != ""},
+		Language:      sql.NullString{String: book.Language, Valid: book.Language != ""},
+		Format:        sql.NullString{String: string(book.Format), Valid: book.Format != ""},
+		SeriesVolume:  sql.NullInt32{Int32: int32(book.SeriesVolume), Valid: book.SeriesVolume > 0},
 		CreatedAt:     sql.NullTime{Time: book.CreatedAt, Valid: true},
+		UpdatedAt:     sql.NullTime{Time: book.UpdatedAt, Valid: true},
+	}
+	if book.Work != nil {
+		res.WorkID = sql.NullInt32{Int32: int32(book.Work.ID), Valid: true}
+	}
+	if book.Series != nil {
+		res.SeriesID = sql.NullInt32{Int32: int32(book.Series.ID), Valid: book.Series.ID > 0}
+		res.SeriesTitle = sql.NullString{String: book.Series.Title, Valid: book.Series.Title != ""}
+	}
+	if book.Cover != nil {
+		res.CoverKey = sql.NullString{String: book.Cover.Key, Valid: true}
+		res.CoverThumbnailKey = sql.NullString{String: book.Cover.ThumbnailKey, Valid: true}
+		res.CoverContentType = sql.NullString{String: book.Cover.ContentType, Valid: true}
+		res.CoverUpdatedAt = sql.NullTime{Time: book.Cover.UpdatedAt, Valid: true}
 	}
+	return res
+}
+
+type BookMatch struct {
+	Book      Book
+	Score     float64
+	Highlight string
+}
+
+func MapBookMatchEntityToBookMatchDomain(match BookMatch) domain.BookMatch {
+	return domain.BookMatch{
+		Book:      MapBookEntityToBookDomain(match.Book),
+		Score:     match.Score,
+		Highlight: match.Highlight,
+	}
+}
+
+func MapBookMatchesEntityToBookMatchesDomain(matches []BookMatch) []domain.BookMatch {
+	var res []domain.BookMatch
+	for _, match := range matches {
+		res = append(res, MapBookMatchEntityToBookMatchDomain(match))
+	}
+	return res
+}
+
+type HarvestRecord struct {
+	BookID    uint
+	Datestamp sql.NullTime
+	Deleted   bool
+	Book      Book
+}
+
+func MapHarvestRecordEntityToHarvestRecordDomain(record HarvestRecord) domain.HarvestRecord {
+	res := domain.HarvestRecord{
+		BookID:    record.BookID,
+		Datestamp: record.Datestamp.Time,
+		Deleted:   record.Deleted,
+	}
+	if !record.Deleted {
+		res.Book = MapBookEntityToBookDomain(record.Book)
+	}
+	return res
+}
+
+func MapHarvestRecordsEntityToHarvestRecordsDomain(records []HarvestRecord) []domain.HarvestRecord {
+	var res []domain.HarvestRecord
+	for _, record := range records {
+		res = append(res, MapHarvestRecordEntityToHarvestRecordDomain(record))
+	}
+	return res
 }
@@ -9,9 +9,86 @@ import (
 	"library-management-api/books-service/core/ports"
 	"library-management-api/books-service/init/database"
 	"library-management-api/util/errorhandler"
+	"library-management-api/util/listquery"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
 )
 
+// bookColumns selects a book row together with its aggregate copy counts, its authors, the
+// titles of its work and series, its tags and its cover. Every query using it must alias the books table
+// as "b".
+const bookColumns = "b.id, b.title, b.author, b.category, b.subject, b.genre, b.published_year, b.isbn_10, b.isbn_13, b.created_at, b.updated_at, " +
+	"(SELECT COUNT(*) FROM copies c WHERE c.book_id = b.id), " +
+	"(SELECT COUNT(*) FROM copies c WHERE c.book_id = b.id AND c.status = 'available'), " +
+	"(SELECT COALESCE(json_agg(json_build_object('id', a.id, 'name', a.name) ORDER BY ba.position), '[]') " +
+	"FROM book_authors ba JOIN authors a ON a.id = ba.author_id WHERE ba.book_id = b.id), " +
+	"b.work_id, (SELECT w.title FROM works w WHERE w.id = b.work_id), b.edition, b.language, b.format, " +
+	"b.series_id, (SELECT s.title FROM series s WHERE s.id = b.series_id), b.series_volume, " +
+	"(SELECT COALESCE(json_agg(json_build_object('id', t.id, 'name', t.name) ORDER BY lower(t.name)), '[]') " +
+	"FROM book_tags bt JOIN tags t ON t.id = bt.tag_id WHERE bt.book_id = b.id), " +
+	"b.cover_key, b.cover_thumbnail_key, b.cover_content_type, b.cover_updated_at"
+
+// scanner is implemented by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// bookDest returns the scan destinations of bookColumns.
+func bookDest(book *Book) []any {
+	return []any{&book.ID, &book.Title, &book.Author, &book.Category, &book.Subject, &book.Genre, &book.PublishedYear, &book.ISBN10, &book.ISBN13, &book.CreatedAt, &book.UpdatedAt, &book.TotalCopies, &book.AvailableCopies, &book.Authors,
+		&book.WorkID, &book.WorkTitle, &book.Edition, &book.Language, &book.Format, &book.SeriesID, &book.SeriesTitle, &book.SeriesVolume, &book.Tags,
+		&book.CoverKey, &book.CoverThumbnailKey, &book.CoverContentType, &book.CoverUpdatedAt}
+}
+
+func scanBook(row scanner) (Book, error) {
+	var book Book
+	err := row.Scan(bookDest(&book)...)
+	return book, err
+}
+
+// mapBookWriteError translates constraint violations on the books table into domain errors.
+func mapBookWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "books_isbn_13_key":
+			return errorhandler.ErrDuplicateISBN
+		case "books_work_id_fkey":
+			return errorhandler.ErrWorkNotFound
+		case "books_series_id_fkey":
+			return errorhandler.ErrSeriesNotFound
+		case "loans_book_id_fkey":
+			return errorhandler.ErrBookHasLoans
+		}
+	}
+	return err
+}
+
+// bookInsert inserts a book from the arguments returned by bookWriteArgs.
+const bookInsert = "INSERT INTO books (title, author, category, subject, genre, published_year, isbn_10, isbn_13, work_id, edition, language, format, series_id, series_volume) " +
+	"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)"
+
+// bookWriteArgs returns the column values written by bookInsert and UpdateBook, in order.
+func bookWriteArgs(book Book) []any {
+	return []any{book.Title, book.Author, book.Category, book.Subject, book.Genre, book.PublishedYear, book.ISBN10, book.ISBN13,
+		book.WorkID, book.Edition, book.Language, book.Format, book.SeriesID, book.SeriesVolume}
+}
+
+func scanBooks(rows *sql.Rows) ([]Book, error) {
+	var books []Book
+	for rows.Next() {
+		book, err := scanBook(rows)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
+
 type BookRepository struct {
 	db *sql.DB
 }
@@ -23,13 +100,16 @@ func NewBookRepository() ports.BookRepository {
 }
 
 // AddBook implements ports.BookRepository.
+// The book is inserted together with the credits of its authors.
 func (b *BookRepository) AddBook(ctx context.Context, book domain.Book) (domain.Book, error) {
-	var addedBook Book
-	mappedBook := MapBookDomainToBookEntity(book)
+	query := bookInsert + " RETURNING id"
 
-	query := "INSERT INTO books (title, author, category, subject, genre, published_year, available, borrower_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *"
-	row := b.db.QueryRow(query, mappedBook.Title, mappedBook.Author, mappedBook.Category, mappedBook.Subject, mappedBook.Genre, mappedBook.PublishedYear, mappedBook.Available, mappedBook.BorrowerID)
-	err := row.Scan(&addedBook.ID, &addedBook.Title, &addedBook.Author, &addedBook.Category, &addedBook.Subject, &addedBook.Genre, &addedBook.PublishedYear, &addedBook.Available, &addedBook.BorrowerID, &addedBook.CreatedAt)
+	var addedBook Book
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		var err error
+		addedBook, err = addBookTx(ctx, tx, query, book)
+		return err
+	})
 	if err != nil {
 		return domain.Book{}, err
 	}
@@ -37,35 +117,109 @@ func (b *BookRepository) AddBook(ctx context.Context, book domain.Book) (domain.
 	return res, nil
 }
 
-// GetBooks implements ports.BookRepository.
-func (b *BookRepository) GetBooks(ctx context.Context) ([]domain.Book, error) {
-	var books []Book
+// addBookTx inserts a book with the given bookInsert query, which must return the id of the new
+// row, and credits its authors. sql.ErrNoRows is returned when the query inserts nothing; a series
+// given by title has been created by then, so the caller rolls the transaction back to before
+// the call rather than leave the series without books.
+func addBookTx(ctx context.Context, tx *sql.Tx, query string, book domain.Book) (Book, error) {
+	mappedBook := MapBookDomainToBookEntity(book)
+	seriesID, err := seriesIDTx(ctx, tx, mappedBook)
+	if err != nil {
+		return Book{}, err
+	}
+	mappedBook.SeriesID = seriesID
 
-	rows, err := b.db.Query("SELECT * FROM books")
+	var bookID uint
+	err = tx.QueryRowContext(ctx, query, bookWriteArgs(mappedBook)...).Scan(&bookID)
 	if err != nil {
-		return []domain.Book{}, err
+		return Book{}, mapBookWriteError(err)
 	}
-	defer rows.Close()
+	if err := creditBookAuthorsTx(ctx, tx, bookID, book.Authors); err != nil {
+		return Book{}, err
+	}
+	return scanBook(tx.QueryRowContext(ctx, "SELECT "+bookColumns+" FROM books b WHERE b.id=$1", bookID))
+}
 
-	for rows.Next() {
-		var book Book
-		err := rows.Scan(&book.ID, &book.Title, &book.Author, &book.Category, &book.Subject, &book.Genre, &book.PublishedYear, &book.Available, &book.BorrowerID, &book.CreatedAt)
-		if err != nil {
-			return []domain.Book{}, err
+// seriesIDTx returns the series of a book. A series given only by its title is looked up by
+// title, ignoring case, and created when it is new.
+func seriesIDTx(ctx context.Context, tx *sql.Tx, book Book) (sql.NullInt32, error) {
+	if book.SeriesID.Valid || !book.SeriesTitle.Valid {
+		return book.SeriesID, nil
+	}
+	var seriesID sql.NullInt32
+	// The no-op update makes the statement return the existing series on a conflict
+	query := "INSERT INTO series (title) VALUES ($1) ON CONFLICT ((lower(title))) DO UPDATE SET title = series.title RETURNING id"
+	err := tx.QueryRowContext(ctx, query, book.SeriesTitle).Scan(&seriesID)
+	return seriesID, err
+}
+
+// creditBookAuthorsTx replaces the authors credited with a book, in the given order. Authors
+// without an ID are looked up by name and created when they are new. The author column of the
+// book is then rewritten from the credits.
+func creditBookAuthorsTx(ctx context.Context, tx *sql.Tx, bookID uint, authors []domain.Author) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM book_authors WHERE book_id=$1", bookID); err != nil {
+		return err
+	}
+	for i, author := range authors {
+		authorID := author.ID
+		if authorID == 0 {
+			// The no-op update makes the statement return the existing author on a conflict
+			query := "INSERT INTO authors (name) VALUES ($1) ON CONFLICT (name_key) DO UPDATE SET name = authors.name RETURNING id"
+			if err := tx.QueryRowContext(ctx, query, author.Name).Scan(&authorID); err != nil {
+				return mapAuthorWriteError(err)
+			}
+		}
+		query := "INSERT INTO book_authors (book_id, author_id, position) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING"
+		if _, err := tx.ExecContext(ctx, query, bookID, authorID, i+1); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.ConstraintName == "book_authors_author_id_fkey" {
+				return fmt.Errorf("%w: %d", errorhandler.ErrAuthorNotFound, authorID)
+			}
+			return err
 		}
-		books = append(books, book)
 	}
-	res := MapBooksEntityToBooksDomain(books)
-	return res, nil
+	return syncBookAuthorTx(ctx, tx, "ba.book_id = $1", bookID)
+}
+
+// syncBookAuthorTx rewrites the author column of the books selected by the condition on
+// book_authors ba to the names of their credited authors.
+func syncBookAuthorTx(ctx context.Context, tx *sql.Tx, condition string, args ...any) error {
+	query := "UPDATE books b SET author = credits.names FROM (" +
+		"SELECT ba.book_id, left(string_agg(a.name, '; ' ORDER BY ba.position), 255) AS names " +
+		"FROM book_authors ba JOIN authors a ON a.id = ba.author_id " +
+		"WHERE ba.book_id IN (SELECT ba.book_id FROM book_authors ba WHERE " + condition + ") GROUP BY ba.book_id" +
+		") credits WHERE credits.book_id = b.id AND b.author IS DISTINCT FROM credits.names"
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// GetBooks implements ports.BookRepository.
+func (b *BookRepository) GetBooks(ctx context.Context, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
+	var qb listquery.Builder
+	return b.listBooks(ctx, &qb, filter, query)
 }
 
 // GetBook implements ports.BookRepository.
 func (b *BookRepository) GetBook(ctx context.Context, book domain.Book) (domain.Book, error) {
-	var foundBook Book
+	query := "SELECT " + bookColumns + " FROM books b WHERE b.id=$1"
+	row := b.db.QueryRowContext(ctx, query, book.ID)
+	foundBook, err := scanBook(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Book{}, errorhandler.ErrBookNotFound
+		}
+		return domain.Book{}, err
+	}
+	res := MapBookEntityToBookDomain(foundBook)
+	return res, nil
+}
 
-	query := "SELECT * FROM books WHERE id=$1"
-	row := b.db.QueryRow(query, book.ID)
-	err := row.Scan(&foundBook.ID, &foundBook.Title, &foundBook.Author, &foundBook.Category, &foundBook.Subject, &foundBook.Genre, &foundBook.PublishedYear, &foundBook.Available, &foundBook.BorrowerID, &foundBook.CreatedAt)
+// GetBookByISBN implements ports.BookRepository.
+// The book is looked up by its normalized ISBN-13.
+func (b *BookRepository) GetBookByISBN(ctx context.Context, book domain.Book) (domain.Book, error) {
+	query := "SELECT " + bookColumns + " FROM books b WHERE b.isbn_13=$1"
+	row := b.db.QueryRowContext(ctx, query, book.ISBN13)
+	foundBook, err := scanBook(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Book{}, errorhandler.ErrBookNotFound
@@ -76,138 +230,1045 @@ func (b *BookRepository) GetBook(ctx context.Context, book domain.Book) (domain.
 	return res, nil
 }
 
+// GetBooksByISBN implements ports.BookRepository.
+// Books are matched by their normalized ISBN-13.
+func (b *BookRepository) GetBooksByISBN(ctx context.Context, isbns []string) ([]domain.Book, error) {
+	query := "SELECT " + bookColumns + " FROM books b WHERE b.isbn_13 = ANY($1)"
+	rows, err := b.db.QueryContext(ctx, query, isbns)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books, err := scanBooks(rows)
+	if err != nil {
+		return nil, err
+	}
+	return MapBooksEntityToBooksDomain(books), nil
+}
+
+// ImportBooks implements ports.BookRepository.
+// Each row is inserted under its own savepoint, so a row the database rejects is reported as
+// failed without aborting the rest of the batch. Rows whose ISBN is already catalogued are
+// skipped. Rows that are not inserted are rolled back to their savepoint, which also drops any
+// series created for them.
+func (b *BookRepository) ImportBooks(ctx context.Context, rows []domain.ImportRow) ([]domain.ImportRow, error) {
+	res := append([]domain.ImportRow(nil), rows...)
+	query := bookInsert + " ON CONFLICT (isbn_13) DO NOTHING RETURNING id"
+
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		for i := range res {
+			if res[i].Status != domain.ImportStatusPending {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, "SAVEPOINT import_row"); err != nil {
+				return err
+			}
+
+			addedBook, err := addBookTx(ctx, tx, query, res[i].Book)
+			switch {
+			case err == nil:
+				res[i].Book = MapBookEntityToBookDomain(addedBook)
+				res[i].Status = domain.ImportStatusCreated
+			case errors.Is(err, sql.ErrNoRows):
+				res[i].Status = domain.ImportStatusSkipped
+				res[i].Reason = errorhandler.ErrDuplicateISBN.Error()
+			default:
+				res[i].Status = domain.ImportStatusFailed
+				res[i].Reason = err.Error()
+			}
+
+			if res[i].Status != domain.ImportStatusCreated {
+				if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_row"); err != nil {
+					return err
+				}
+			}
+			if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT import_row"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// exportFetchSize is the number of books fetched from the export cursor at a time.
+const exportFetchSize = 500
+
+// ExportBooks implements ports.BookRepository.
+// The books are read through a server-side cursor in a read-only repeatable read transaction,
+// so the export sees a single snapshot of the catalogue and only one batch is held in memory.
+func (b *BookRepository) ExportBooks(ctx context.Context, fn func(book domain.Book) error) error {
+	tx, err := b.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	// Nothing is written, so the transaction always ends in a rollback
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, "DECLARE export_books NO SCROLL CURSOR FOR SELECT "+bookColumns+" FROM books b ORDER BY b.id")
+	if err != nil {
+		return err
+	}
+
+	fetch := "FETCH " + strconv.Itoa(exportFetchSize) + " FROM export_books"
+	for {
+		rows, err := tx.QueryContext(ctx, fetch)
+		if err != nil {
+			return err
+		}
+		books, err := scanBooks(rows)
+		rows.Close()
+		if err != nil {
+			return err
+		}
+		if len(books) == 0 {
+			return nil
+		}
+
+		for _, book := range books {
+			if err := fn(MapBookEntityToBookDomain(book)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// harvestWhere holds the placeholders of the harvest query's bounds. The arguments are
+// registered once and shared by the books and the tombstones.
+type harvestWhere struct {
+	from, until, afterDatestamp, afterID string
+}
+
+func newHarvestWhere(qb *listquery.Builder, query domain.HarvestQuery) harvestWhere {
+	var w harvestWhere
+	if !query.From.IsZero() {
+		w.from = qb.Arg(query.From)
+	}
+	if !query.Until.IsZero() {
+		w.until = qb.Arg(query.Until)
+	}
+	if !query.AfterDatestamp.IsZero() {
+		w.afterDatestamp, w.afterID = qb.Arg(query.AfterDatestamp), qb.Arg(query.AfterID)
+	}
+	return w
+}
+
+// clause returns the WHERE clause applying the bounds to a datestamp and an ID column.
+func (w harvestWhere) clause(datestamp string, id string) string {
+	var conds []string
+	if w.from != "" {
+		conds = append(conds, datestamp+" >= "+w.from)
+	}
+	if w.until != "" {
+		conds = append(conds, datestamp+" < "+w.until)
+	}
+	if w.afterDatestamp != "" {
+		conds = append(conds, fmt.Sprintf("(%s, %s) > (%s::timestamptz, %s::int)", datestamp, id, w.afterDatestamp, w.afterID))
+	}
+	if len(conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conds, " AND ")
+}
+
+// HarvestBooks implements ports.BookRepository.
+// Books and tombstones are merged by datestamp, each side limited on its own so that both can
+// be read off their datestamp indexes. The books of the page are then loaded within the same
+// snapshot.
+func (b *BookRepository) HarvestBooks(ctx context.Context, query domain.HarvestQuery) ([]domain.HarvestRecord, error) {
+	tx, err := b.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	// Nothing is written, so the transaction always ends in a rollback
+	defer func() { _ = tx.Rollback() }()
+
+	var qb listquery.Builder
+	where := newHarvestWhere(&qb, query)
+	limit := qb.Arg(query.Limit + 1)
+	listQuery := "(SELECT b.id, b.updated_at, false FROM books b" + where.clause("b.updated_at", "b.id") + " ORDER BY b.updated_at, b.id LIMIT " + limit + ") " +
+		"UNION ALL (SELECT d.book_id, d.deleted_at, true FROM book_deletions d" + where.clause("d.deleted_at", "d.book_id") + " ORDER BY d.deleted_at, d.book_id LIMIT " + limit + ") " +
+		"ORDER BY 2, 1 LIMIT " + limit
+	_, args := qb.Filter()
+	rows, err := tx.QueryContext(ctx, listQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	var records []HarvestRecord
+	var bookIDs []int64
+	for rows.Next() {
+		var record HarvestRecord
+		if err := rows.Scan(&record.BookID, &record.Datestamp, &record.Deleted); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if !record.Deleted {
+			bookIDs = append(bookIDs, int64(record.BookID))
+		}
+		records = append(records, record)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(bookIDs) > 0 {
+		rows, err := tx.QueryContext(ctx, "SELECT "+bookColumns+" FROM books b WHERE b.id = ANY($1)", bookIDs)
+		if err != nil {
+			return nil, err
+		}
+		books, err := scanBooks(rows)
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+		booksByID := make(map[uint]Book, len(books))
+		for _, book := range books {
+			booksByID[book.ID] = book
+		}
+		for i := range records {
+			if !records[i].Deleted {
+				records[i].Book = booksByID[records[i].BookID]
+			}
+		}
+	}
+	return MapHarvestRecordsEntityToHarvestRecordsDomain(records), nil
+}
+
+// GetHarvestRecord implements ports.BookRepository.
+// A book that no longer exists is looked up among the tombstones.
+func (b *BookRepository) GetHarvestRecord(ctx context.Context, book domain.Book) (domain.HarvestRecord, error) {
+	foundBook, err := scanBook(b.db.QueryRowContext(ctx, "SELECT "+bookColumns+" FROM books b WHERE b.id=$1", book.ID))
+	if err == nil {
+		return MapHarvestRecordEntityToHarvestRecordDomain(HarvestRecord{BookID: foundBook.ID, Datestamp: foundBook.UpdatedAt, Book: foundBook}), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return domain.HarvestRecord{}, err
+	}
+
+	record := HarvestRecord{BookID: book.ID, Deleted: true}
+	err = b.db.QueryRowContext(ctx, "SELECT deleted_at FROM book_deletions WHERE book_id=$1", book.ID).Scan(&record.Datestamp)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.HarvestRecord{}, errorhandler.ErrBookNotFound
+		}
+		return domain.HarvestRecord{}, err
+	}
+	return MapHarvestRecordEntityToHarvestRecordDomain(record), nil
+}
+
+// EarliestDatestamp implements ports.BookRepository.
+func (b *BookRepository) EarliestDatestamp(ctx context.Context) (time.Time, error) {
+	var earliest sql.NullTime
+	query := "SELECT LEAST((SELECT MIN(updated_at) FROM books), (SELECT MIN(deleted_at) FROM book_deletions))"
+	if err := b.db.QueryRowContext(ctx, query).Scan(&earliest); err != nil {
+		return time.Time{}, err
+	}
+	return earliest.Time, nil
+}
+
 // UpdateBook implements ports.BookRepository.
+// The credits of the book are replaced by the authors of the update.
 func (b *BookRepository) UpdateBook(ctx context.Context, book domain.Book) (domain.Book, error) {
+	mappedBook := MapBookDomainToBookEntity(book)
+	query := "UPDATE books SET title=$1, author=$2, category=$3, subject=$4, genre=$5, published_year=$6, isbn_10=$7, isbn_13=$8, " +
+		"work_id=$9, edition=$10, language=$11, format=$12, series_id=$13, series_volume=$14 WHERE id=$15 RETURNING id"
+
 	var updatedBook Book
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		seriesID, err := seriesIDTx(ctx, tx, mappedBook)
+		if err != nil {
+			return err
+		}
+		mappedBook.SeriesID = seriesID
 
-	mappedBook := MapBookDomainToBookEntity(book)
-	query := "UPDATE books SET title=$1, author=$2, category=$3, subject=$4, genre=$5, published_year=$6, available=$7, borrower_id=$8 WHERE id=$9 RETURNING *"
-	row := b.db.QueryRow(query, mappedBook.Title, mappedBook.Author, mappedBook.Category, mappedBook.Subject, mappedBook.Genre, mappedBook.PublishedYear, mappedBook.Available, mappedBook.BorrowerID, mappedBook.ID)
-	err := row.Scan(&updatedBook.ID, &updatedBook.Title, &updatedBook.Author, &updatedBook.Category, &updatedBook.Subject, &updatedBook.Genre, &updatedBook.PublishedYear, &updatedBook.Available, &updatedBook.BorrowerID, &updatedBook.CreatedAt)
+		var bookID uint
+		err = tx.QueryRowContext(ctx, query, append(bookWriteArgs(mappedBook), mappedBook.ID)...).Scan(&bookID)
+		if err != nil {
+			return err
+		}
+		if err := creditBookAuthorsTx(ctx, tx, bookID, book.Authors); err != nil {
+			return err
+		}
+		updatedBook, err = scanBook(tx.QueryRowContext(ctx, "SELECT "+bookColumns+" FROM books b WHERE b.id=$1", bookID))
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Book{}, errorhandler.ErrBookNotFound
 		}
-		return domain.Book{}, err
+		return domain.Book{}, mapBookWriteError(err)
 	}
 	res := MapBookEntityToBookDomain(updatedBook)
 	return res, nil
 }
 
 // DeleteBook implements ports.BookRepository.
+// Books that have been lent keep their loan history, so ErrBookHasLoans is returned for them.
 func (b *BookRepository) DeleteBook(ctx context.Context, book domain.Book) error {
 	query := "DELETE FROM books WHERE id=$1"
-	_, err := b.db.Exec(query, book.ID)
+	_, err := b.db.ExecContext(ctx, query, book.ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return errorhandler.ErrBookNotFound
 		}
-		return err
+		return mapBookWriteError(err)
 	}
 	return nil
 }
 
-// SearchBooks implements ports.BookRepository.
-func (b *BookRepository) SearchBooks(ctx context.Context, book domain.Book) ([]domain.Book, error) {
-	var books []Book
+// UpdateBookTags implements ports.BookRepository.
+// The tags of the book are replaced by the given ones. Tags are looked up by name, ignoring
+// case, and created when they are new.
+func (b *BookRepository) UpdateBookTags(ctx context.Context, book domain.Book) (domain.Book, error) {
+	var updatedBook Book
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		var bookID uint
+		err := tx.QueryRowContext(ctx, "SELECT id FROM books WHERE id=$1 FOR UPDATE", book.ID).Scan(&bookID)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM book_tags WHERE book_id=$1", bookID); err != nil {
+			return err
+		}
+		for _, tag := range book.Tags {
+			var tagID uint
+			// The no-op update makes the statement return the existing tag on a conflict
+			query := "INSERT INTO tags (name) VALUES ($1) ON CONFLICT ((lower(name))) DO UPDATE SET name = tags.name RETURNING id"
+			if err := tx.QueryRowContext(ctx, query, tag.Name).Scan(&tagID); err != nil {
+				return err
+			}
+			query = "INSERT INTO book_tags (book_id, tag_id) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+			if _, err := tx.ExecContext(ctx, query, bookID, tagID); err != nil {
+				return err
+			}
+		}
+		updatedBook, err = scanBook(tx.QueryRowContext(ctx, "SELECT "+bookColumns+" FROM books b WHERE b.id=$1", bookID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Book{}, errorhandler.ErrBookNotFound
+		}
+		return domain.Book{}, err
+	}
+	res := MapBookEntityToBookDomain(updatedBook)
+	return res, nil
+}
 
+// ReplaceBookCover implements ports.BookRepository.
+func (b *BookRepository) ReplaceBookCover(ctx context.Context, book domain.Book) (*domain.Cover, error) {
 	mappedBook := MapBookDomainToBookEntity(book)
 
-	// Build the SQL query dynamically based on which parameters are provided
-	query := "SELECT * FROM books WHERE TRUE"
-	var args []interface{}
-	argCounter := 1
+	var replacedBook Book
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		query := "SELECT cover_key, cover_thumbnail_key, cover_content_type, cover_updated_at FROM books WHERE id=$1 FOR UPDATE"
+		err := tx.QueryRowContext(ctx, query, book.ID).Scan(&replacedBook.CoverKey, &replacedBook.CoverThumbnailKey, &replacedBook.CoverContentType, &replacedBook.CoverUpdatedAt)
+		if err != nil {
+			return err
+		}
+		query = "UPDATE books SET cover_key=$1, cover_thumbnail_key=$2, cover_content_type=$3, cover_updated_at=$4 WHERE id=$5"
+		_, err = tx.ExecContext(ctx, query, mappedBook.CoverKey, mappedBook.CoverThumbnailKey, mappedBook.CoverContentType, mappedBook.CoverUpdatedAt, book.ID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorhandler.ErrBookNotFound
+		}
+		return nil, err
+	}
+	return MapBookEntityToBookDomain(replacedBook).Cover, nil
+}
 
-	// Add conditions based on provided search parameters
-	if mappedBook.Title.Valid {
-		query += " AND title ILIKE $" + strconv.Itoa(argCounter)
-		args = append(args, mappedBook.Title.String)
-		argCounter++
+// SearchBooks implements ports.BookRepository.
+// Free text is matched against the search_vector column, falling back to trigram word similarity
+// on the title and author so that misspelled words still find the book. The single-column
+// criteria match substrings or similar words. The score of a book sums the rank of each match.
+func (b *BookRepository) SearchBooks(ctx context.Context, search domain.BookSearch, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.BookMatch], error) {
+	var qb listquery.Builder
+	score, highlight := bookSearchSQL(&qb, search)
+
+	sorting := listquery.Sorting{
+		Columns: map[string]listquery.Column{"relevance": {Expr: score, Cast: "float8"}},
+		Default: "relevance",
+		ID:      bookSorting.ID,
 	}
-	if mappedBook.Author.Valid {
-		query += " AND author ILIKE $" + strconv.Itoa(argCounter)
-		args = append(args, mappedBook.Author.String)
-		argCounter++
+	for name, column := range bookSorting.Columns {
+		sorting.Columns[name] = column
 	}
-	if mappedBook.Category.Valid {
-		query += " AND category ILIKE $" + strconv.Itoa(argCounter)
-		args = append(args, mappedBook.Category.String)
-		argCounter++
+	if query.Sort == "" {
+		query.Sort = sorting.Default
 	}
+	applyBookFilter(&qb, filter)
 
-	rows, err := b.db.QueryContext(ctx, query, args...)
+	pageClause, pageArgs, err := qb.Page(query, sorting)
 	if err != nil {
-		return []domain.Book{}, err
+		return listquery.Page[domain.BookMatch]{}, err
+	}
+
+	total, err := b.countBooks(ctx, &qb)
+	if err != nil {
+		return listquery.Page[domain.BookMatch]{}, err
+	}
+
+	rows, err := b.db.QueryContext(ctx, "SELECT "+bookColumns+", "+score+", "+highlight+" FROM books b"+pageClause, pageArgs...)
+	if err != nil {
+		return listquery.Page[domain.BookMatch]{}, err
 	}
 	defer rows.Close()
 
-	// Iterate over the rows and scan data into a new instance of book for each row
+	var matches []BookMatch
 	for rows.Next() {
-		var book Book
-		err := rows.Scan(&book.ID, &book.Title, &book.Author, &book.Category, &book.Subject, &book.Genre, &book.PublishedYear, &book.Available, &book.BorrowerID, &book.CreatedAt)
+		var match BookMatch
+		err := rows.Scan(append(bookDest(&match.Book), &match.Score, &match.Highlight)...)
 		if err != nil {
-			return []domain.Book{}, err
+			return listquery.Page[domain.BookMatch]{}, err
 		}
-		books = append(books, book)
+		matches = append(matches, match)
 	}
-	res := MapBooksEntityToBooksDomain(books)
-	return res, nil
+	if err := rows.Err(); err != nil {
+		return listquery.Page[domain.BookMatch]{}, err
+	}
+	matches, more := listquery.Trim(matches, query)
+
+	page := listquery.Page[domain.BookMatch]{
+		Items: MapBookMatchesEntityToBookMatchesDomain(matches),
+		Total: total,
+	}
+	if more {
+		last := matches[len(matches)-1]
+		value := strconv.FormatFloat(last.Score, 'g', -1, 64)
+		if query.Sort != "relevance" {
+			value = bookSortValue(last.Book, query.Sort)
+		}
+		page.NextCursor = listquery.Cursor{Value: value, ID: last.Book.ID}.Encode()
+	}
+	return page, nil
 }
 
-// CategoryBooks implements ports.BookRepository.
-func (b *BookRepository) CategoryBooks(ctx context.Context, book domain.Book) ([]domain.Book, error) {
-	var books []Book
-	var categoryType, categoryValue string
+// bookSearchSQL adds the conditions of the search to qb. It returns the expressions scoring the
+// relevance of a book and highlighting the matched words in its text.
+func bookSearchSQL(qb *listquery.Builder, search domain.BookSearch) (string, string) {
+	var scores, terms []string
+	if search.Query != "" {
+		q := qb.Arg(search.Query) + "::text"
+		tsquery := "websearch_to_tsquery('english', " + q + ")"
+		qb.Where(fmt.Sprintf("(b.search_vector @@ %s OR %s <%% b.title OR %s <%% b.author)", tsquery, q, q))
+		scores = append(scores, fmt.Sprintf("ts_rank_cd(b.search_vector, %s) + greatest(word_similarity(%s, b.title), word_similarity(%s, b.author))", tsquery, q, q))
+		terms = append(terms, q)
+	}
+	for _, field := range []struct {
+		column string
+		value  string
+	}{
+		{"b.title", search.Title},
+		{"b.author", search.Author},
+		{"b.category", search.Category},
+	} {
+		if field.value == "" {
+			continue
+		}
+		v := qb.Arg(field.value) + "::text"
+		qb.Where(fmt.Sprintf("(%s ILIKE '%%' || %s || '%%' OR %s <%% %s)", field.column, v, v, field.column))
+		scores = append(scores, fmt.Sprintf("word_similarity(%s, %s)", v, field.column))
+		terms = append(terms, v)
+	}
+	score := "(" + strings.Join(scores, " + ") + ")::float8"
+	highlight := fmt.Sprintf("ts_headline('english', concat_ws(' | ', b.title, b.author, b.subject, b.genre), websearch_to_tsquery('english', concat_ws(' or ', %s)), '%s')",
+		strings.Join(terms, ", "), searchHeadlineOptions)
+	return score, highlight
+}
+
+// maxFacetValues caps the number of values returned per facet.
+const maxFacetValues = 20
+
+// FacetBooks implements ports.BookRepository.
+// The books matching the search and filter are counted by genre, subject, tag, decade and
+// availability in a single query.
+func (b *BookRepository) FacetBooks(ctx context.Context, search domain.BookSearch, filter domain.BookFilter) (domain.BookFacets, error) {
+	var qb listquery.Builder
+	bookSearchSQL(&qb, search)
+	applyBookFilter(&qb, filter)
+	filterClause, filterArgs := qb.Filter()
+
+	query := "WITH matched AS (" +
+		"SELECT b.id, b.genre, b.subject, b.published_year, " +
+		"EXISTS (SELECT 1 FROM copies c WHERE c.book_id = b.id AND c.status = 'available') AS available " +
+		"FROM books b" + filterClause +
+		") " +
+		"SELECT 'genre', m.genre, COUNT(*) FROM matched m GROUP BY m.genre " +
+		"UNION ALL " +
+		"SELECT 'subject', m.subject, COUNT(*) FROM matched m GROUP BY m.subject " +
+		"UNION ALL " +
+		"SELECT 'tag', min(t.name), COUNT(*) FROM matched m JOIN book_tags bt ON bt.book_id = m.id JOIN tags t ON t.id = bt.tag_id GROUP BY t.id " +
+		"UNION ALL " +
+		"SELECT 'decade', (m.published_year / 10 * 10)::text, COUNT(*) FROM matched m GROUP BY m.published_year / 10 " +
+		"UNION ALL " +
+		"SELECT 'availability', CASE WHEN m.available THEN 'available' ELSE 'unavailable' END, COUNT(*) FROM matched m GROUP BY m.available " +
+		"ORDER BY 1, 3 DESC, 2"
+	rows, err := b.db.QueryContext(ctx, query, filterArgs...)
+	if err != nil {
+		return domain.BookFacets{}, err
+	}
+	defer rows.Close()
+
+	var facets domain.BookFacets
+	for rows.Next() {
+		var field string
+		var facet domain.Facet
+		if err := rows.Scan(&field, &facet.Value, &facet.Count); err != nil {
+			return domain.BookFacets{}, err
+		}
+		var values *[]domain.Facet
+		switch field {
+		case "genre":
+			values = &facets.Genres
+		case "subject":
+			values = &facets.Subjects
+		case "tag":
+			values = &facets.Tags
+		case "decade":
+			values = &facets.Decades
+		case "availability":
+			values = &facets.Availability
+		}
+		if len(*values) < maxFacetValues {
+			*values = append(*values, facet)
+		}
+	}
+	return facets, rows.Err()
+}
+
+// searchHeadlineOptions configures ts_headline to mark every matched word in the whole text.
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
 
+// CategoryBooks implements ports.BookRepository.
+// A subject also matches the books filed under its narrower subjects, and a genre may be given
+// by one of its synonyms.
+func (b *BookRepository) CategoryBooks(ctx context.Context, book domain.Book, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
 	mappedBook := MapBookDomainToBookEntity(book)
 
-	// Dynamically create the query based on the category type
+	var qb listquery.Builder
 	if mappedBook.Subject.Valid {
-		categoryType = "subject"
-		categoryValue = mappedBook.Subject.String
+		subject := qb.Arg(mappedBook.Subject.String)
+		qb.Where("(b.subject=" + subject + " OR b.subject IN (" +
+			"WITH RECURSIVE tree AS (SELECT id, name FROM subjects WHERE lower(name)=lower(" + subject + ") " +
+			"UNION SELECT s.id, s.name FROM subjects s JOIN tree ON s.parent_id = tree.id) " +
+			"SELECT name FROM tree))")
 	} else if mappedBook.Genre.Valid {
-		categoryType = "genre"
-		categoryValue = mappedBook.Genre.String
+		genre := qb.Arg(mappedBook.Genre.String)
+		qb.Where("(b.genre=" + genre + " OR b.genre IN (" +
+			"SELECT g.name FROM genres g WHERE lower(g.name)=lower(" + genre + ") " +
+			"OR g.id = (SELECT gs.genre_id FROM genre_synonyms gs WHERE lower(gs.name)=lower(" + genre + "))))")
+	} else if len(book.Tags) > 0 {
+		filter.Tags = append(filter.Tags, book.Tags[0].Name)
 	}
+	return b.listBooks(ctx, &qb, filter, query)
+}
+
+// AvailableBooks implements ports.BookRepository.
+// A book is available when at least one of its copies is on the shelf.
+func (b *BookRepository) AvailableBooks(ctx context.Context, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
+	available := true
+	filter.Available = &available
 
-	query := fmt.Sprintf("SELECT * FROM books WHERE %s=$1", categoryType)
-	rows, err := b.db.Query(query, categoryValue)
+	var qb listquery.Builder
+	return b.listBooks(ctx, &qb, filter, query)
+}
+
+// AuthorBooks implements ports.BookRepository.
+func (b *BookRepository) AuthorBooks(ctx context.Context, author domain.Author, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
+	var qb listquery.Builder
+	qb.Where("EXISTS (SELECT 1 FROM book_authors ba WHERE ba.book_id = b.id AND ba.author_id = " + qb.Arg(author.ID) + ")")
+	return b.listBooks(ctx, &qb, filter, query)
+}
+
+// FindBooks implements ports.BookRepository.
+func (b *BookRepository) FindBooks(ctx context.Context, condition domain.BookCondition, offset int, limit int) (listquery.Page[domain.Book], error) {
+	var qb listquery.Builder
+	cond, err := bookConditionSQL(&qb, condition)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
+	}
+	qb.Where(cond)
+
+	total, err := b.countBooks(ctx, &qb)
 	if err != nil {
-		return []domain.Book{}, err
+		return listquery.Page[domain.Book]{}, err
+	}
+	page := listquery.Page[domain.Book]{Total: total}
+	if limit == 0 || offset >= total {
+		return page, nil
+	}
+
+	filterClause, _ := qb.Filter()
+	query := "SELECT " + bookColumns + " FROM books b" + filterClause + " ORDER BY b.id LIMIT " + qb.Arg(limit) + " OFFSET " + qb.Arg(offset)
+	_, args := qb.Filter()
+	rows, err := b.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
 	}
 	defer rows.Close()
 
-	for rows.Next() {
-		var book Book
-		err := rows.Scan(&book.ID, &book.Title, &book.Author, &book.Category, &book.Subject, &book.Genre, &book.PublishedYear, &book.Available, &book.BorrowerID, &book.CreatedAt)
+	books, err := scanBooks(rows)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
+	}
+	page.Items = MapBooksEntityToBooksDomain(books)
+	return page, nil
+}
+
+// bookFieldColumns lists the columns each field of a BookCondition is matched against. Like in
+// Dublin Core records, the genre and category count as subjects.
+var bookFieldColumns = map[domain.BookField][]string{
+	domain.BookFieldAny:     {"b.title", "b.author", "b.subject", "b.genre", "b.category"},
+	domain.BookFieldTitle:   {"b.title"},
+	domain.BookFieldAuthor:  {"b.author"},
+	domain.BookFieldSubject: {"b.subject", "b.genre", "b.category"},
+}
+
+// bookConditionSQL renders the condition as an SQL expression, registering its arguments in qb.
+func bookConditionSQL(qb *listquery.Builder, condition domain.BookCondition) (string, error) {
+	switch condition.Op {
+	case domain.BookConditionAll:
+		return "TRUE", nil
+	case domain.BookConditionAnd, domain.BookConditionOr, domain.BookConditionAndNot:
+		if condition.Left == nil || condition.Right == nil {
+			return "", fmt.Errorf("%w: %s needs two operands", errorhandler.ErrInvalidBookCondition, condition.Op)
+		}
+		left, err := bookConditionSQL(qb, *condition.Left)
 		if err != nil {
-			return []domain.Book{}, err
+			return "", err
 		}
-		books = append(books, book)
+		right, err := bookConditionSQL(qb, *condition.Right)
+		if err != nil {
+			return "", err
+		}
+		switch condition.Op {
+		case domain.BookConditionAnd:
+			return "(" + left + " AND " + right + ")", nil
+		case domain.BookConditionOr:
+			return "(" + left + " OR " + right + ")", nil
+		}
+		return "(" + left + " AND NOT " + right + ")", nil
+	case domain.BookConditionMatch:
+	default:
+		return "", fmt.Errorf("%w: unknown operator %q", errorhandler.ErrInvalidBookCondition, condition.Op)
 	}
-	res := MapBooksEntityToBooksDomain(books)
-	return res, nil
+
+	columns, ok := bookFieldColumns[condition.Field]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown field %q", errorhandler.ErrInvalidBookCondition, condition.Field)
+	}
+	var words []string
+	join := " AND "
+	switch condition.Mode {
+	case domain.BookMatchPhrase:
+		if phrase := strings.Join(strings.Fields(condition.Terms), " "); phrase != "" {
+			words = []string{phrase}
+		}
+	case domain.BookMatchAnyWord:
+		words, join = strings.Fields(condition.Terms), " OR "
+	case domain.BookMatchAllWords:
+		words = strings.Fields(condition.Terms)
+	default:
+		return "", fmt.Errorf("%w: unknown match mode %q", errorhandler.ErrInvalidBookCondition, condition.Mode)
+	}
+	if len(words) == 0 {
+		return "", fmt.Errorf("%w: no terms to match", errorhandler.ErrInvalidBookCondition)
+	}
+
+	matches := make([]string, len(words))
+	for i, word := range words {
+		pattern := qb.Arg("%" + maskToLike(word) + "%")
+		alternatives := make([]string, len(columns))
+		for j, column := range columns {
+			alternatives[j] = column + " ILIKE " + pattern
+		}
+		matches[i] = "(" + strings.Join(alternatives, " OR ") + ")"
+	}
+	return "(" + strings.Join(matches, join) + ")", nil
 }
 
-// AvailableBooks implements ports.BookRepository.
-func (b *BookRepository) AvailableBooks(ctx context.Context) ([]domain.Book, error) {
-	var books []Book
+// maskToLike turns a masked term, where * and ? are wildcards and a backslash quotes the next
+// character, into a LIKE pattern.
+func maskToLike(term string) string {
+	var b strings.Builder
+	escaped := false
+	for _, r := range term {
+		switch {
+		case escaped:
+			b.WriteString(likeEscaper.Replace(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '*':
+			b.WriteByte('%')
+		case r == '?':
+			b.WriteByte('_')
+		default:
+			b.WriteString(likeEscaper.Replace(string(r)))
+		}
+	}
+	return b.String()
+}
 
-	query := "SELECT * FROM books WHERE available=true"
-	rows, err := b.db.Query(query)
+// GetSuggestions implements ports.BookRepository.
+// Titles and authors starting with the prefix, ignoring case, are ranked by how many books carry
+// them. The lower(title) and lower(author) prefix indexes keep this cheap.
+func (b *BookRepository) GetSuggestions(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error) {
+	query := "SELECT field, value, count FROM (" +
+		"(SELECT 'title' AS field, b.title AS value, COUNT(*) AS count FROM books b WHERE lower(b.title) LIKE $1 GROUP BY b.title ORDER BY 3 DESC, 2 LIMIT $2) " +
+		"UNION ALL " +
+		"(SELECT 'author', b.author, COUNT(*) FROM books b WHERE lower(b.author) LIKE $1 GROUP BY b.author ORDER BY 3 DESC, 2 LIMIT $2)" +
+		") s ORDER BY count DESC, length(value), value LIMIT $2"
+	rows, err := b.db.QueryContext(ctx, query, likeEscaper.Replace(strings.ToLower(prefix))+"%", limit)
 	if err != nil {
-		return []domain.Book{}, err
+		return nil, err
 	}
 	defer rows.Close()
 
+	var suggestions []domain.Suggestion
 	for rows.Next() {
-		var book Book
-		err := rows.Scan(&book.ID, &book.Title, &book.Author, &book.Category, &book.Subject, &book.Genre, &book.PublishedYear, &book.Available, &book.BorrowerID, &book.CreatedAt)
+		var suggestion domain.Suggestion
+		if err := rows.Scan(&suggestion.Field, &suggestion.Value, &suggestion.Count); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, rows.Err()
+}
+
+// bookSorting lists the columns books can be sorted by.
+var bookSorting = listquery.Sorting{
+	Columns: map[string]listquery.Column{
+		"id":             {Expr: "b.id", Cast: "int"},
+		"title":          {Expr: "b.title", Cast: "text"},
+		"author":         {Expr: "b.author", Cast: "text"},
+		"published_year": {Expr: "b.published_year", Cast: "int"},
+		"created_at":     {Expr: "b.created_at", Cast: "timestamptz"},
+		"updated_at":     {Expr: "b.updated_at", Cast: "timestamptz"},
+	},
+	Default: "id",
+	ID:      "b.id",
+}
+
+// bookSortValue returns the value a book is sorted by, as stored in a cursor.
+func bookSortValue(book Book, sort string) string {
+	switch sort {
+	case "title":
+		return book.Title.String
+	case "author":
+		return book.Author.String
+	case "published_year":
+		return strconv.FormatUint(uint64(book.PublishedYear), 10)
+	case "created_at":
+		return book.CreatedAt.Time.Format(time.RFC3339Nano)
+	case "updated_at":
+		return book.UpdatedAt.Time.Format(time.RFC3339Nano)
+	}
+	return strconv.FormatUint(uint64(book.ID), 10)
+}
+
+// likeEscaper escapes the LIKE wildcards in user input so that it only matches literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// equalsIgnoringCase returns a condition matching the column against the value, ignoring case.
+// It is written as an ILIKE with the wildcards escaped so that the trigram indexes still apply.
+func equalsIgnoringCase(qb *listquery.Builder, column string, value string) string {
+	return column + " ILIKE " + qb.Arg(likeEscaper.Replace(value)) + ` ESCAPE '\'`
+}
+
+// applyBookFilter adds the criteria of the filter to the list query.
+func applyBookFilter(qb *listquery.Builder, filter domain.BookFilter) {
+	if filter.YearFrom > 0 {
+		qb.Where("b.published_year >= " + qb.Arg(filter.YearFrom))
+	}
+	if filter.YearTo > 0 {
+		qb.Where("b.published_year <= " + qb.Arg(filter.YearTo))
+	}
+	if filter.Available != nil {
+		exists := "EXISTS (SELECT 1 FROM copies c WHERE c.book_id = b.id AND c.status = 'available')"
+		if !*filter.Available {
+			exists = "NOT " + exists
+		}
+		qb.Where(exists)
+	}
+	if filter.Author != "" {
+		qb.Where(equalsIgnoringCase(qb, "b.author", filter.Author))
+	}
+	if filter.Category != "" {
+		qb.Where(equalsIgnoringCase(qb, "b.category", filter.Category))
+	}
+	if filter.Subject != "" {
+		qb.Where(equalsIgnoringCase(qb, "b.subject", filter.Subject))
+	}
+	if filter.Genre != "" {
+		qb.Where(equalsIgnoringCase(qb, "b.genre", filter.Genre))
+	}
+	for _, tag := range filter.Tags {
+		qb.Where("EXISTS (SELECT 1 FROM book_tags bt JOIN tags t ON t.id = bt.tag_id WHERE bt.book_id = b.id AND lower(t.name) = lower(" + qb.Arg(tag) + "::text))")
+	}
+}
+
+// listBooks applies the filter to the conditions already in qb and returns the requested page
+// of matching books along with their total count.
+func (b *BookRepository) listBooks(ctx context.Context, qb *listquery.Builder, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
+	if query.Sort == "" {
+		query.Sort = bookSorting.Default
+	}
+	applyBookFilter(qb, filter)
+
+	pageClause, pageArgs, err := qb.Page(query, bookSorting)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
+	}
+
+	total, err := b.countBooks(ctx, qb)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
+	}
+
+	rows, err := b.db.QueryContext(ctx, "SELECT "+bookColumns+" FROM books b"+pageClause, pageArgs...)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
+	}
+	defer rows.Close()
+
+	books, err := scanBooks(rows)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
+	}
+	books, more := listquery.Trim(books, query)
+
+	page := listquery.Page[domain.Book]{
+		Items: MapBooksEntityToBooksDomain(books),
+		Total: total,
+	}
+	if more {
+		last := books[len(books)-1]
+		page.NextCursor = listquery.Cursor{Value: bookSortValue(last, query.Sort), ID: last.ID}.Encode()
+	}
+	return page, nil
+}
+
+// countBooks counts the books satisfying the conditions in qb.
+func (b *BookRepository) countBooks(ctx context.Context, qb *listquery.Builder) (int, error) {
+	var total int
+	filterClause, filterArgs := qb.Filter()
+	err := b.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM books b"+filterClause, filterArgs...).Scan(&total)
+	return total, err
+}
+
+// withTx runs fn inside a transaction that is committed when fn succeeds and rolled back otherwise.
+func withTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// lockUserTx takes the transaction-scoped advisory lock of a user. It serialises the writes that
+// are checked against the user's loans and fine balance: borrows, payments and waivers.
+func lockUserTx(ctx context.Context, tx *sql.Tx, userID uint) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('loans'), $1)", userID)
+	return err
+}
+
+// shelveCopyTx hands a copy that is back in the library to the oldest waiting hold it can serve,
+// on its book or on any edition of its work, or puts it on the open shelf when nobody is
+// waiting. Holds locked by a concurrent transaction are skipped so that two copies never ready
+// the same hold.
+func shelveCopyTx(ctx context.Context, tx *sql.Tx, copyID uint, bookID uint, hold domain.Hold) (Copy, error) {
+	var holdID uint
+	query := "SELECT h.id FROM holds h WHERE " + holdServesBook("$1") + " AND h.status='waiting' ORDER BY h.created_at, h.id LIMIT 1 FOR UPDATE OF h SKIP LOCKED"
+	err := tx.QueryRowContext(ctx, query, bookID).Scan(&holdID)
+	if errors.Is(err, sql.ErrNoRows) {
+		query = "UPDATE copies SET status='available', borrower_id=NULL WHERE id=$1 RETURNING " + copyColumns
+		return scanCopy(tx.QueryRowContext(ctx, query, copyID))
+	}
+	if err != nil {
+		return Copy{}, err
+	}
+
+	query = "UPDATE copies SET status='on_hold', borrower_id=NULL WHERE id=$1 RETURNING " + copyColumns
+	shelvedCopy, err := scanCopy(tx.QueryRowContext(ctx, query, copyID))
+	if err != nil {
+		return Copy{}, err
+	}
+
+	mappedHold := MapHoldDomainToHoldEntity(hold)
+	query = "UPDATE holds SET status='ready', copy_id=$1, ready_at=$2, expires_at=$3 WHERE id=$4"
+	_, err = tx.ExecContext(ctx, query, copyID, mappedHold.ReadyAt, mappedHold.ExpiresAt, holdID)
+	if err != nil {
+		return Copy{}, err
+	}
+	return shelvedCopy, nil
+}
+
+// BorrowCopy implements ports.BookRepository.
+// It lends a copy of the loan's book to the loan's user: the copy reserved for the user on the
+// hold shelf if there is one, otherwise a copy on the open shelf. The copy row is locked while it
+// changes hands, so when several patrons race for the last copy exactly one of them gets it and
+// the others receive ErrBookAlreadyBorrowed. The waiting holds of the user the copy could serve
+// are fulfilled, as is the hold the copy was reserved for. A copy of another edition reserved
+// for the user stays on the hold shelf until its hold expires.
+//
+// The loan limit of the loan policy and the fine block of the fine policy are checked under the
+// user's advisory lock, see lockUserTx, so concurrent borrows by one patron cannot together go
+// past either. ErrLoanLimitReached and ErrOutstandingFines are returned when they would.
+func (b *BookRepository) BorrowCopy(ctx context.Context, loan domain.Loan, loanPolicy domain.LoanPolicy, finePolicy domain.FinePolicy) (domain.Loan, error) {
+	mappedLoan := MapLoanDomainToLoanEntity(loan)
+
+	var borrowedLoan Loan
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		err := lockUserTx(ctx, tx, mappedLoan.UserID)
 		if err != nil {
-			return []domain.Book{}, err
+			return err
 		}
-		books = append(books, book)
+
+		var activeLoans uint
+		query := "SELECT COUNT(*) FROM loans WHERE user_id=$1 AND returned_at IS NULL"
+		err = tx.QueryRowContext(ctx, query, mappedLoan.UserID).Scan(&activeLoans)
+		if err != nil {
+			return err
+		}
+		if !loanPolicy.AllowsBorrow(activeLoans) {
+			return errorhandler.ErrLoanLimitReached
+		}
+
+		balance := domain.FineBalance{UserID: loan.UserID}
+		err = tx.QueryRowContext(ctx, fineBalanceQuery, mappedLoan.UserID).Scan(&balance.ChargedCents, &balance.PaidCents, &balance.WaivedCents)
+		if err != nil {
+			return err
+		}
+		if !finePolicy.AllowsBorrow(balance.OutstandingCents()) {
+			return errorhandler.ErrOutstandingFines
+		}
+
+		var copyID uint
+		query = `SELECT c.id FROM copies c
+			WHERE c.book_id=$1 AND (c.status='available' OR (c.status='on_hold' AND EXISTS (
+				SELECT 1 FROM holds h WHERE h.copy_id=c.id AND h.user_id=$2 AND h.status='ready'
+			)))
+			ORDER BY c.status='on_hold' DESC, c.id
+			LIMIT 1 FOR UPDATE OF c SKIP LOCKED`
+		err = tx.QueryRowContext(ctx, query, mappedLoan.BookID, mappedLoan.UserID).Scan(&copyID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrBookAlreadyBorrowed
+			}
+			return err
+		}
+
+		query = "UPDATE copies SET status='borrowed', borrower_id=$1 WHERE id=$2"
+		_, err = tx.ExecContext(ctx, query, mappedLoan.UserID, copyID)
+		if err != nil {
+			return err
+		}
+
+		query = "INSERT INTO loans (copy_id, book_id, user_id, due_at, borrowed_by) VALUES ($1, $2, $3, $4, $5) RETURNING " + loanColumns
+		row := tx.QueryRowContext(ctx, query, copyID, mappedLoan.BookID, mappedLoan.UserID, mappedLoan.DueAt, mappedLoan.BorrowedBy)
+		borrowedLoan, err = scanLoan(row)
+		if err != nil {
+			return err
+		}
+
+		query = "UPDATE holds h SET status='fulfilled' WHERE " + holdServesBook("$1") + " AND h.user_id=$2 AND (h.status='waiting' OR (h.status='ready' AND h.copy_id=$3))"
+		_, err = tx.ExecContext(ctx, query, mappedLoan.BookID, mappedLoan.UserID, copyID)
+		return err
+	})
+	if err != nil {
+		return domain.Loan{}, err
+	}
+	res := MapLoanEntityToLoanDomain(borrowedLoan)
+	return res, nil
+}
+
+// ReturnCopy implements ports.BookRepository.
+// It closes the open loan of the copy of the loan's book lent to the loan's user, charges the
+// final late fee under the fine policy and sends the copy to the hold shelf or the open shelf,
+// all in one transaction, so a returned loan never misses its fee. The given hold carries the
+// pickup window granted to the next patron in the queue.
+func (b *BookRepository) ReturnCopy(ctx context.Context, loan domain.Loan, hold domain.Hold, finePolicy domain.FinePolicy) (domain.Loan, error) {
+	mappedLoan := MapLoanDomainToLoanEntity(loan)
+
+	var returnedLoan Loan
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		var copyID uint
+		query := "SELECT id FROM copies WHERE book_id=$1 AND borrower_id=$2 AND status='borrowed' ORDER BY id LIMIT 1 FOR UPDATE"
+		err := tx.QueryRowContext(ctx, query, mappedLoan.BookID, mappedLoan.UserID).Scan(&copyID)
+		if errors.Is(err, sql.ErrNoRows) {
+			var borrowed bool
+			query = "SELECT EXISTS (SELECT 1 FROM copies WHERE book_id=$1 AND status='borrowed')"
+			if err := tx.QueryRowContext(ctx, query, mappedLoan.BookID).Scan(&borrowed); err != nil {
+				return err
+			}
+			if borrowed {
+				return errorhandler.ErrBorrowerIDMismatch
+			}
+			return errorhandler.ErrBookAlreadyAvailable
+		}
+		if err != nil {
+			return err
+		}
+
+		query = "UPDATE loans SET returned_at=$1, returned_by=$2 WHERE copy_id=$3 AND returned_at IS NULL RETURNING " + loanColumns
+		row := tx.QueryRowContext(ctx, query, mappedLoan.ReturnedAt, mappedLoan.ReturnedBy, copyID)
+		returnedLoan, err = scanLoan(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrLoanNotFound
+			}
+			return err
+		}
+
+		_, err = chargeLoanTx(ctx, tx, returnedLoan.ID, finePolicy, loan.ReturnedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = shelveCopyTx(ctx, tx, copyID, mappedLoan.BookID, hold)
+		return err
+	})
+	if err != nil {
+		return domain.Loan{}, err
+	}
+	res := MapLoanEntityToLoanDomain(returnedLoan)
+	return res, nil
+}
+
+// ShelveCopy implements ports.BookRepository.
+// It moves a copy out of its given status to the hold shelf or the open shelf, see shelveCopyTx.
+// ErrCopyNotFound is returned when the copy has left that status in the meantime.
+func (b *BookRepository) ShelveCopy(ctx context.Context, bookCopy domain.Copy, hold domain.Hold) (domain.Copy, error) {
+	var shelvedCopy Copy
+	err := withTx(ctx, b.db, func(tx *sql.Tx) error {
+		var bookID uint
+		query := "SELECT book_id FROM copies WHERE id=$1 AND status=$2 FOR UPDATE"
+		err := tx.QueryRowContext(ctx, query, bookCopy.ID, string(bookCopy.Status)).Scan(&bookID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrCopyNotFound
+			}
+			return err
+		}
+
+		shelvedCopy, err = shelveCopyTx(ctx, tx, bookCopy.ID, bookID, hold)
+		return err
+	})
+	if err != nil {
+		return domain.Copy{}, err
 	}
-	res := MapBooksEntityToBooksDomain(books)
+	res := MapCopyEntityToCopyDomain(shelvedCopy)
 	return res, nil
 }
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/init/database"
+	"library-management-api/books-service/init/migrations"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/listquery"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// openTestDB connects to the Postgres database named by BOOKS_TEST_DSN and migrates it.
+// Tests that need a database are skipped when the variable is not set.
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("BOOKS_TEST_DSN")
+	if dsn == "" {
+		t.Skip("BOOKS_TEST_DSN is not set")
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Fatalf("ping database: %v", err)
+	}
+	database.MigrateFS(db, migrations.FS, ".")
+	return db
+}
+
+// addTestBook adds a book with the given number of copies on the open shelf and removes it,
+// together with its loans, when the test ends.
+func addTestBook(t *testing.T, db *sql.DB, copies int) uint {
+	t.Helper()
+	ctx := context.Background()
+
+	var bookID uint
+	query := "INSERT INTO books (title, author, category, subject, genre, published_year) VALUES ($1, 'Test Author', 'Test', 'Test', 'Test', 2000) RETURNING id"
+	err := db.QueryRowContext(ctx, query, t.Name()).Scan(&bookID)
+	if err != nil {
+		t.Fatalf("add book: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = db.Exec("DELETE FROM loans WHERE book_id=$1", bookID)
+		_, _ = db.Exec("DELETE FROM books WHERE id=$1", bookID)
+	})
+
+	copyRepository := &CopyRepository{db: db}
+	for i := 0; i < copies; i++ {
+		_, err := copyRepository.AddCopy(ctx, domain.Copy{
+			BookID:  bookID,
+			Barcode: fmt.Sprintf("TEST-%d-%d", bookID, i),
+			Status:  domain.CopyStatusAvailable,
+		})
+		if err != nil {
+			t.Fatalf("add copy: %v", err)
+		}
+	}
+	return bookID
+}
+
+func TestBorrowCopyConcurrent(t *testing.T) {
+	db := openTestDB(t)
+	bookRepository := &BookRepository{db: db}
+	bookID := addTestBook(t, db, 1)
+
+	const borrowers = 20
+	loanPolicy := domain.LoanPolicy{Period: 14 * 24 * time.Hour, MaxActiveLoans: 5}
+
+	var wg sync.WaitGroup
+	errs := make([]error, borrowers)
+	start := make(chan struct{})
+	for i := 0; i < borrowers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			userID := uint(1_000_000 + i)
+			_, errs[i] = bookRepository.BorrowCopy(context.Background(), domain.Loan{
+				BookID:     bookID,
+				UserID:     userID,
+				DueAt:      loanPolicy.DueDate(time.Now()),
+				BorrowedBy: userID,
+			}, loanPolicy, domain.FinePolicy{})
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	borrowed := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			borrowed++
+		case errors.Is(err, errorhandler.ErrBookAlreadyBorrowed):
+		default:
+			t.Errorf("borrower %d: unexpected error %v", i, err)
+		}
+	}
+	if borrowed != 1 {
+		t.Errorf("got %d successful borrows, want 1", borrowed)
+	}
+
+	var loans int
+	err := db.QueryRow("SELECT COUNT(*) FROM loans WHERE book_id=$1", bookID).Scan(&loans)
+	if err != nil {
+		t.Fatalf("count loans: %v", err)
+	}
+	if loans != 1 {
+		t.Errorf("got %d loans, want 1", loans)
+	}
+}
+
+func TestApplyBookFilterMatchesLiterally(t *testing.T) {
+	var qb listquery.Builder
+	applyBookFilter(&qb, domain.BookFilter{
+		Author:   "100% Smith",
+		Category: "sci_fi",
+		Subject:  `back\slash`,
+		Genre:    "Drama",
+	})
+
+	clause, args := qb.Filter()
+	wantClause := ` WHERE b.author ILIKE $1 ESCAPE '\' AND b.category ILIKE $2 ESCAPE '\' AND b.subject ILIKE $3 ESCAPE '\' AND b.genre ILIKE $4 ESCAPE '\'`
+	if clause != wantClause {
+		t.Errorf("clause = %q, want %q", clause, wantClause)
+	}
+	wantArgs := []interface{}{`100\% Smith`, `sci\_fi`, `back\\slash`, "Drama"}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args = %q, want %q", args, wantArgs)
+	}
+}
+
+func TestImportBooksSkippedRowLeavesNoSeries(t *testing.T) {
+	db := openTestDB(t)
+	bookRepository := &BookRepository{db: db}
+	ctx := context.Background()
+
+	book := domain.Book{
+		Title:         t.Name(),
+		Author:        "Test Author",
+		Category:      "Test",
+		Subject:       "Test",
+		Genre:         "Test",
+		PublishedYear: 2000,
+		ISBN13:        "9799999999990",
+	}
+	addedBook, err := bookRepository.AddBook(ctx, book)
+	if err != nil {
+		t.Fatalf("add book: %v", err)
+	}
+	seriesTitle := t.Name() + " series"
+	t.Cleanup(func() {
+		_, _ = db.Exec("DELETE FROM books WHERE id=$1", addedBook.ID)
+		_, _ = db.Exec("DELETE FROM series WHERE title=$1", seriesTitle)
+	})
+
+	book.Series = &domain.Series{Title: seriesTitle}
+	book.SeriesVolume = 1
+	rows, err := bookRepository.ImportBooks(ctx, []domain.ImportRow{{Line: 1, Book: book, Status: domain.ImportStatusPending}})
+	if err != nil {
+		t.Fatalf("import books: %v", err)
+	}
+	if rows[0].Status != domain.ImportStatusSkipped {
+		t.Fatalf("got status %q, want %q", rows[0].Status, domain.ImportStatusSkipped)
+	}
+
+	var series int
+	err = db.QueryRow("SELECT COUNT(*) FROM series WHERE title=$1", seriesTitle).Scan(&series)
+	if err != nil {
+		t.Fatalf("count series: %v", err)
+	}
+	if series != 0 {
+		t.Errorf("got %d series left behind by the skipped row, want 0", series)
+	}
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Copy struct {
+	ID            uint
+	BookID        uint
+	Barcode       sql.NullString
+	ShelfLocation sql.NullString
+	Status        sql.NullString
+	BorrowerID    sql.NullInt32
+	CreatedAt     sql.NullTime
+}
+
+func MapCopyEntityToCopyDomain(bookCopy Copy) domain.Copy {
+	return domain.Copy{
+		ID:            bookCopy.ID,
+		BookID:        bookCopy.BookID,
+		Barcode:       bookCopy.Barcode.String,
+		ShelfLocation: bookCopy.ShelfLocation.String,
+		Status:        domain.CopyStatus(bookCopy.Status.String),
+		BorrowerID:    uint(bookCopy.BorrowerID.Int32),
+		CreatedAt:     bookCopy.CreatedAt.Time,
+	}
+}
+
+func MapCopiesEntityToCopiesDomain(copies []Copy) []domain.Copy {
+	var res []domain.Copy
+	for _, bookCopy := range copies {
+		res = append(res, MapCopyEntityToCopyDomain(bookCopy))
+	}
+	return res
+}
+
+func MapCopyDomainToCopyEntity(bookCopy domain.Copy) Copy {
+	return Copy{
+		ID:            bookCopy.ID,
+		BookID:        bookCopy.BookID,
+		Barcode:       sql.NullString{String: bookCopy.Barcode, Valid: bookCopy.Barcode != ""},
+		ShelfLocation: sql.NullString{String: bookCopy.ShelfLocation, Valid: true},
+		Status:        sql.NullString{String: string(bookCopy.Status), Valid: bookCopy.Status != ""},
+		BorrowerID:    sql.NullInt32{Int32: int32(bookCopy.BorrowerID), Valid: bookCopy.BorrowerID > 0},
+		CreatedAt:     sql.NullTime{Time: bookCopy.CreatedAt, Valid: true},
+	}
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+
+	"github.com/jackc/pgconn"
+)
+
+const copyColumns = "id, book_id, barcode, shelf_location, status, borrower_id, created_at"
+
+func scanCopy(row scanner) (Copy, error) {
+	var bookCopy Copy
+	err := row.Scan(&bookCopy.ID, &bookCopy.BookID, &bookCopy.Barcode, &bookCopy.ShelfLocation, &bookCopy.Status, &bookCopy.BorrowerID, &bookCopy.CreatedAt)
+	return bookCopy, err
+}
+
+// mapCopyWriteError translates constraint violations on the copies table into domain errors.
+func mapCopyWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "copies_barcode_key":
+			return errorhandler.ErrDuplicateBarcode
+		case "copies_book_id_fkey":
+			return errorhandler.ErrBookNotFound
+		}
+	}
+	return err
+}
+
+type CopyRepository struct {
+	db *sql.DB
+}
+
+func NewCopyRepository() ports.CopyRepository {
+	return &CopyRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddCopy implements ports.CopyRepository.
+func (r *CopyRepository) AddCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error) {
+	mappedCopy := MapCopyDomainToCopyEntity(bookCopy)
+
+	query := "INSERT INTO copies (book_id, barcode, shelf_location, status) VALUES ($1, $2, $3, $4) RETURNING " + copyColumns
+	row := r.db.QueryRowContext(ctx, query, mappedCopy.BookID, mappedCopy.Barcode, mappedCopy.ShelfLocation, mappedCopy.Status)
+	addedCopy, err := scanCopy(row)
+	if err != nil {
+		return domain.Copy{}, mapCopyWriteError(err)
+	}
+	res := MapCopyEntityToCopyDomain(addedCopy)
+	return res, nil
+}
+
+// GetCopies implements ports.CopyRepository.
+func (r *CopyRepository) GetCopies(ctx context.Context, bookCopy domain.Copy) ([]domain.Copy, error) {
+	var copies []Copy
+
+	query := "SELECT " + copyColumns + " FROM copies WHERE book_id=$1 ORDER BY id"
+	rows, err := r.db.QueryContext(ctx, query, bookCopy.BookID)
+	if err != nil {
+		return []domain.Copy{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		foundCopy, err := scanCopy(rows)
+		if err != nil {
+			return []domain.Copy{}, err
+		}
+		copies = append(copies, foundCopy)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Copy{}, err
+	}
+	res := MapCopiesEntityToCopiesDomain(copies)
+	return res, nil
+}
+
+// GetCopy implements ports.CopyRepository.
+func (r *CopyRepository) GetCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error) {
+	query := "SELECT " + copyColumns + " FROM copies WHERE id=$1"
+	row := r.db.QueryRowContext(ctx, query, bookCopy.ID)
+	foundCopy, err := scanCopy(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Copy{}, errorhandler.ErrCopyNotFound
+		}
+		return domain.Copy{}, err
+	}
+	res := MapCopyEntityToCopyDomain(foundCopy)
+	return res, nil
+}
+
+// UpdateCopy implements ports.CopyRepository.
+func (r *CopyRepository) UpdateCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error) {
+	mappedCopy := MapCopyDomainToCopyEntity(bookCopy)
+
+	query := "UPDATE copies SET barcode=$1, shelf_location=$2, status=$3, borrower_id=$4 WHERE id=$5 RETURNING " + copyColumns
+	row := r.db.QueryRowContext(ctx, query, mappedCopy.Barcode, mappedCopy.ShelfLocation, mappedCopy.Status, mappedCopy.BorrowerID, mappedCopy.ID)
+	updatedCopy, err := scanCopy(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Copy{}, errorhandler.ErrCopyNotFound
+		}
+		return domain.Copy{}, mapCopyWriteError(err)
+	}
+	res := MapCopyEntityToCopyDomain(updatedCopy)
+	return res, nil
+}
+
+// DeleteCopy implements ports.CopyRepository.
+func (r *CopyRepository) DeleteCopy(ctx context.Context, bookCopy domain.Copy) error {
+	query := "DELETE FROM copies WHERE id=$1"
+	res, err := r.db.ExecContext(ctx, query, bookCopy.ID)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errorhandler.ErrCopyNotFound
+	}
+	return nil
+}
+
+// GetHoldableCopies implements ports.CopyRepository.
+// It returns copies sitting on the shelf although patrons are waiting for their book, or for any
+// edition of its work.
+func (r *CopyRepository) GetHoldableCopies(ctx context.Context) ([]domain.Copy, error) {
+	var copies []Copy
+
+	query := "SELECT " + copyColumns + " FROM copies c WHERE c.status='available' AND EXISTS (SELECT 1 FROM holds h WHERE h.status='waiting' AND " + holdServesBook("c.book_id") + ") ORDER BY c.id"
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return []domain.Copy{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		foundCopy, err := scanCopy(rows)
+		if err != nil {
+			return []domain.Copy{}, err
+		}
+		copies = append(copies, foundCopy)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Copy{}, err
+	}
+	res := MapCopiesEntityToCopiesDomain(copies)
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Fine struct {
+	ID          uint
+	UserID      uint
+	LoanID      sql.NullInt32
+	Kind        sql.NullString
+	AmountCents int64
+	Note        sql.NullString
+	CreatedBy   sql.NullInt32
+	CreatedAt   sql.NullTime
+}
+
+func MapFineEntityToFineDomain(fine Fine) domain.Fine {
+	return domain.Fine{
+		ID:          fine.ID,
+		UserID:      fine.UserID,
+		LoanID:      uint(fine.LoanID.Int32),
+		Kind:        domain.FineKind(fine.Kind.String),
+		AmountCents: fine.AmountCents,
+		Note:        fine.Note.String,
+		CreatedBy:   uint(fine.CreatedBy.Int32),
+		CreatedAt:   fine.CreatedAt.Time,
+	}
+}
+
+func MapFinesEntityToFinesDomain(fines []Fine) []domain.Fine {
+	var res []domain.Fine
+	for _, fine := range fines {
+		res = append(res, MapFineEntityToFineDomain(fine))
+	}
+	return res
+}
+
+func MapFineDomainToFineEntity(fine domain.Fine) Fine {
+	return Fine{
+		ID:          fine.ID,
+		UserID:      fine.UserID,
+		LoanID:      sql.NullInt32{Int32: int32(fine.LoanID), Valid: fine.LoanID > 0},
+		Kind:        sql.NullString{String: string(fine.Kind), Valid: fine.Kind != ""},
+		AmountCents: fine.AmountCents,
+		Note:        sql.NullString{String: fine.Note, Valid: true},
+		CreatedBy:   sql.NullInt32{Int32: int32(fine.CreatedBy), Valid: fine.CreatedBy > 0},
+		CreatedAt:   sql.NullTime{Time: fine.CreatedAt, Valid: !fine.CreatedAt.IsZero()},
+	}
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+	"time"
+
+	"github.com/jackc/pgconn"
+)
+
+const fineColumns = "id, user_id, loan_id, kind, amount_cents, note, created_by, created_at"
+
+// fineBalanceQuery sums the ledger of the user given as $1 by kind of entry.
+const fineBalanceQuery = `SELECT
+	COALESCE(SUM(amount_cents) FILTER (WHERE kind = 'charge'), 0),
+	COALESCE(SUM(amount_cents) FILTER (WHERE kind = 'payment'), 0),
+	COALESCE(SUM(amount_cents) FILTER (WHERE kind = 'waiver'), 0)
+	FROM fines WHERE user_id=$1`
+
+func scanFine(row scanner) (Fine, error) {
+	var fine Fine
+	err := row.Scan(&fine.ID, &fine.UserID, &fine.LoanID, &fine.Kind, &fine.AmountCents, &fine.Note, &fine.CreatedBy, &fine.CreatedAt)
+	return fine, err
+}
+
+// mapFineWriteError translates constraint violations on the fines table into domain errors.
+func mapFineWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "fines_amount_cents_check":
+			return errorhandler.ErrInvalidFineAmount
+		case "fines_loan_id_fkey":
+			return errorhandler.ErrLoanNotFound
+		}
+	}
+	return err
+}
+
+type FineRepository struct {
+	db *sql.DB
+}
+
+func NewFineRepository() ports.FineRepository {
+	return &FineRepository{
+		db: database.P().DB,
+	}
+}
+
+// SettleFine implements ports.FineRepository.
+// It records a payment or waiver against the balance of the fine's user, unless it is more than
+// the user owes, in which case ErrFineExceedsBalance is returned. The balance is checked and the
+// entry added under the user's advisory lock, see lockUserTx, so concurrent settlements cannot
+// together take the balance below zero.
+func (r *FineRepository) SettleFine(ctx context.Context, fine domain.Fine) (domain.Fine, error) {
+	mappedFine := MapFineDomainToFineEntity(fine)
+
+	var addedFine Fine
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := lockUserTx(ctx, tx, mappedFine.UserID); err != nil {
+			return err
+		}
+
+		balance := domain.FineBalance{UserID: fine.UserID}
+		err := tx.QueryRowContext(ctx, fineBalanceQuery, mappedFine.UserID).Scan(&balance.ChargedCents, &balance.PaidCents, &balance.WaivedCents)
+		if err != nil {
+			return err
+		}
+		if mappedFine.AmountCents > balance.OutstandingCents() {
+			return errorhandler.ErrFineExceedsBalance
+		}
+
+		query := "INSERT INTO fines (user_id, loan_id, kind, amount_cents, note, created_by) VALUES ($1, $2, $3, $4, $5, $6) RETURNING " + fineColumns
+		row := tx.QueryRowContext(ctx, query, mappedFine.UserID, mappedFine.LoanID, mappedFine.Kind, mappedFine.AmountCents, mappedFine.Note, mappedFine.CreatedBy)
+		addedFine, err = scanFine(row)
+		if err != nil {
+			return mapFineWriteError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Fine{}, err
+	}
+	res := MapFineEntityToFineDomain(addedFine)
+	return res, nil
+}
+
+// GetFines implements ports.FineRepository.
+// It returns the ledger of the user of the given fine, newest entry first.
+func (r *FineRepository) GetFines(ctx context.Context, fine domain.Fine) ([]domain.Fine, error) {
+	var fines []Fine
+
+	query := "SELECT " + fineColumns + " FROM fines WHERE user_id=$1 ORDER BY created_at DESC, id DESC"
+	rows, err := r.db.QueryContext(ctx, query, fine.UserID)
+	if err != nil {
+		return []domain.Fine{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		foundFine, err := scanFine(rows)
+		if err != nil {
+			return []domain.Fine{}, err
+		}
+		fines = append(fines, foundFine)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Fine{}, err
+	}
+	res := MapFinesEntityToFinesDomain(fines)
+	return res, nil
+}
+
+// GetBalance implements ports.FineRepository.
+func (r *FineRepository) GetBalance(ctx context.Context, fine domain.Fine) (domain.FineBalance, error) {
+	balance := domain.FineBalance{UserID: fine.UserID}
+
+	err := r.db.QueryRowContext(ctx, fineBalanceQuery, fine.UserID).Scan(&balance.ChargedCents, &balance.PaidCents, &balance.WaivedCents)
+	if err != nil {
+		return domain.FineBalance{}, err
+	}
+	return balance, nil
+}
+
+// ChargeLoan implements ports.FineRepository.
+// It charges whatever the loan owes under the policy as of now beyond what was already charged
+// for it, see chargeLoanTx. The zero Fine is returned when nothing more is owed.
+func (r *FineRepository) ChargeLoan(ctx context.Context, loan domain.Loan, policy domain.FinePolicy, now time.Time) (domain.Fine, error) {
+	var charge Fine
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		charge, err = chargeLoanTx(ctx, tx, loan.ID, policy, now)
+		return err
+	})
+	if err != nil {
+		return domain.Fine{}, err
+	}
+	res := MapFineEntityToFineDomain(charge)
+	return res, nil
+}
+
+// chargeLoanTx brings the charges of a loan up to what it owes under the policy as of now. The
+// loan row is locked first and the amount owed is worked out from the locked row, so a return or
+// another accrual of the same loan either finished before or waits until the charge is in: each
+// loan is charged at most its due, however often and concurrently it is accrued.
+func chargeLoanTx(ctx context.Context, tx *sql.Tx, loanID uint, policy domain.FinePolicy, now time.Time) (Fine, error) {
+	query := "SELECT " + loanColumns + " FROM loans WHERE id=$1 FOR UPDATE"
+	lockedLoan, err := scanLoan(tx.QueryRowContext(ctx, query, loanID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Fine{}, errorhandler.ErrLoanNotFound
+		}
+		return Fine{}, err
+	}
+	loan := MapLoanEntityToLoanDomain(lockedLoan)
+
+	owed := policy.Accrued(loan, now)
+	if owed == 0 {
+		return Fine{}, nil
+	}
+
+	var charged int64
+	query = "SELECT COALESCE(SUM(amount_cents), 0) FROM fines WHERE loan_id=$1 AND kind='charge'"
+	err = tx.QueryRowContext(ctx, query, loan.ID).Scan(&charged)
+	if err != nil {
+		return Fine{}, err
+	}
+	if owed <= charged {
+		return Fine{}, nil
+	}
+
+	query = "INSERT INTO fines (user_id, loan_id, kind, amount_cents, note) VALUES ($1, $2, $3, $4, $5) RETURNING " + fineColumns
+	row := tx.QueryRowContext(ctx, query, loan.UserID, loan.ID, string(domain.FineKindCharge), owed-charged, "overdue")
+	charge, err := scanFine(row)
+	if err != nil {
+		return Fine{}, mapFineWriteError(err)
+	}
+	return charge, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/util/errorhandler"
+	"sync"
+	"testing"
+)
+
+func TestSettleFineConcurrent(t *testing.T) {
+	db := openTestDB(t)
+	fineRepository := &FineRepository{db: db}
+
+	const userID = 2_000_000
+	t.Cleanup(func() {
+		_, _ = db.Exec("DELETE FROM fines WHERE user_id=$1", userID)
+	})
+	_, err := db.Exec("INSERT INTO fines (user_id, kind, amount_cents, note) VALUES ($1, 'charge', 100, 'test')", userID)
+	if err != nil {
+		t.Fatalf("add charge: %v", err)
+	}
+
+	// Every payment alone would clear the balance, so only one of them may be recorded.
+	const payers = 10
+	var wg sync.WaitGroup
+	errs := make([]error, payers)
+	start := make(chan struct{})
+	for i := 0; i < payers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = fineRepository.SettleFine(context.Background(), domain.Fine{
+				UserID:      userID,
+				Kind:        domain.FineKindPayment,
+				AmountCents: 100,
+			})
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	paid := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			paid++
+		case errors.Is(err, errorhandler.ErrFineExceedsBalance):
+		default:
+			t.Errorf("payer %d: unexpected error %v", i, err)
+		}
+	}
+	if paid != 1 {
+		t.Errorf("got %d recorded payments, want 1", paid)
+	}
+
+	balance, err := fineRepository.GetBalance(context.Background(), domain.Fine{UserID: userID})
+	if err != nil {
+		t.Fatalf("get balance: %v", err)
+	}
+	if balance.OutstandingCents() != 0 {
+		t.Errorf("got an outstanding balance of %d cents, want 0", balance.OutstandingCents())
+	}
+}
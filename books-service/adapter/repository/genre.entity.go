@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"library-management-api/books-service/core/domain"
+)
+
+type Genre struct {
+	ID   uint
+	Name sql.NullString
+	// Synonyms is a JSON array of the synonyms of the genre, as aggregated by genreColumns.
+	Synonyms  []byte
+	BookCount uint
+	CreatedAt sql.NullTime
+}
+
+func MapGenreEntityToGenreDomain(genre Genre) domain.Genre {
+	var synonyms []string
+	_ = json.Unmarshal(genre.Synonyms, &synonyms)
+	return domain.Genre{
+		ID:        genre.ID,
+		Name:      genre.Name.String,
+		Synonyms:  synonyms,
+		BookCount: genre.BookCount,
+		CreatedAt: genre.CreatedAt.Time,
+	}
+}
+
+func MapGenresEntityToGenresDomain(genres []Genre) []domain.Genre {
+	var res []domain.Genre
+	for _, genre := range genres {
+		res = append(res, MapGenreEntityToGenreDomain(genre))
+	}
+	return res
+}
+
+func MapGenreDomainToGenreEntity(genre domain.Genre) Genre {
+	return Genre{
+		ID:        genre.ID,
+		Name:      sql.NullString{String: genre.Name, Valid: genre.Name != ""},
+		CreatedAt: sql.NullTime{Time: genre.CreatedAt, Valid: true},
+	}
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+	"strings"
+
+	"github.com/jackc/pgconn"
+)
+
+// genreColumns selects a genre row together with its synonyms and the number of books of the
+// genre. Every query using it must alias the genres table as "g".
+const genreColumns = "g.id, g.name, " +
+	"(SELECT COALESCE(json_agg(gs.name ORDER BY lower(gs.name)), '[]') FROM genre_synonyms gs WHERE gs.genre_id = g.id), " +
+	"(SELECT COUNT(*) FROM books b WHERE b.genre = g.name), g.created_at"
+
+func scanGenre(row scanner) (Genre, error) {
+	var genre Genre
+	err := row.Scan(&genre.ID, &genre.Name, &genre.Synonyms, &genre.BookCount, &genre.CreatedAt)
+	return genre, err
+}
+
+// mapGenreWriteError translates constraint violations on the genre tables into domain errors.
+func mapGenreWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "genres_name_key", "genre_synonyms_name_key":
+			return errorhandler.ErrDuplicateGenre
+		}
+	}
+	return err
+}
+
+// writeGenreTx stores the name and synonyms of a genre. As the names of genres and synonyms
+// live in separate tables, a name already taken by a genre other than this one is rejected here.
+func writeGenreTx(ctx context.Context, tx *sql.Tx, genreID uint, genre domain.Genre) error {
+	// Serializes writers so that two genres cannot claim the same name concurrently
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE genres, genre_synonyms IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return err
+	}
+	var taken bool
+	query := "SELECT EXISTS (SELECT 1 FROM genres WHERE lower(name) = ANY ($1) AND id <> $2) " +
+		"OR EXISTS (SELECT 1 FROM genre_synonyms WHERE lower(name) = ANY ($1) AND genre_id <> $2)"
+	names := []string{strings.ToLower(genre.Name)}
+	for _, synonym := range genre.Synonyms {
+		names = append(names, strings.ToLower(synonym))
+	}
+	if err := tx.QueryRowContext(ctx, query, names, genreID).Scan(&taken); err != nil {
+		return err
+	}
+	if taken {
+		return errorhandler.ErrDuplicateGenre
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE genres SET name=$1 WHERE id=$2", genre.Name, genreID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM genre_synonyms WHERE genre_id=$1", genreID); err != nil {
+		return err
+	}
+	for _, synonym := range genre.Synonyms {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO genre_synonyms (genre_id, name) VALUES ($1, $2)", genreID, synonym); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type GenreRepository struct {
+	db *sql.DB
+}
+
+func NewGenreRepository() ports.GenreRepository {
+	return &GenreRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddGenre implements ports.GenreRepository.
+func (r *GenreRepository) AddGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error) {
+	mappedGenre := MapGenreDomainToGenreEntity(genre)
+
+	var addedGenre Genre
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var genreID uint
+		err := tx.QueryRowContext(ctx, "INSERT INTO genres (name) VALUES ($1) RETURNING id", mappedGenre.Name).Scan(&genreID)
+		if err != nil {
+			return err
+		}
+		if err := writeGenreTx(ctx, tx, genreID, genre); err != nil {
+			return err
+		}
+		addedGenre, err = scanGenre(tx.QueryRowContext(ctx, "SELECT "+genreColumns+" FROM genres g WHERE g.id=$1", genreID))
+		return err
+	})
+	if err != nil {
+		return domain.Genre{}, mapGenreWriteError(err)
+	}
+	res := MapGenreEntityToGenreDomain(addedGenre)
+	return res, nil
+}
+
+// GetGenres implements ports.GenreRepository.
+func (r *GenreRepository) GetGenres(ctx context.Context) ([]domain.Genre, error) {
+	query := "SELECT " + genreColumns + " FROM genres g ORDER BY lower(g.name), g.id"
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return []domain.Genre{}, err
+	}
+	defer rows.Close()
+
+	var genres []Genre
+	for rows.Next() {
+		genre, err := scanGenre(rows)
+		if err != nil {
+			return []domain.Genre{}, err
+		}
+		genres = append(genres, genre)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Genre{}, err
+	}
+	res := MapGenresEntityToGenresDomain(genres)
+	return res, nil
+}
+
+// GetGenre implements ports.GenreRepository.
+func (r *GenreRepository) GetGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error) {
+	query := "SELECT " + genreColumns + " FROM genres g WHERE g.id=$1"
+	foundGenre, err := scanGenre(r.db.QueryRowContext(ctx, query, genre.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Genre{}, errorhandler.ErrGenreNotFound
+		}
+		return domain.Genre{}, err
+	}
+	res := MapGenreEntityToGenreDomain(foundGenre)
+	return res, nil
+}
+
+// GetGenreByName implements ports.GenreRepository.
+func (r *GenreRepository) GetGenreByName(ctx context.Context, name string) (domain.Genre, error) {
+	query := "SELECT " + genreColumns + " FROM genres g WHERE lower(g.name)=lower($1) " +
+		"OR g.id = (SELECT gs.genre_id FROM genre_synonyms gs WHERE lower(gs.name)=lower($1))"
+	foundGenre, err := scanGenre(r.db.QueryRowContext(ctx, query, name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Genre{}, errorhandler.ErrGenreNotFound
+		}
+		return domain.Genre{}, err
+	}
+	res := MapGenreEntityToGenreDomain(foundGenre)
+	return res, nil
+}
+
+// UpdateGenre implements ports.GenreRepository.
+// The genre column of the books of the genre is rewritten in the same transaction.
+func (r *GenreRepository) UpdateGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error) {
+	var updatedGenre Genre
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var oldName string
+		err := tx.QueryRowContext(ctx, "SELECT name FROM genres WHERE id=$1 FOR UPDATE", genre.ID).Scan(&oldName)
+		if err != nil {
+			return err
+		}
+		if err := writeGenreTx(ctx, tx, genre.ID, genre); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE books SET genre=$1 WHERE genre=$2", genre.Name, oldName); err != nil {
+			return err
+		}
+		updatedGenre, err = scanGenre(tx.QueryRowContext(ctx, "SELECT "+genreColumns+" FROM genres g WHERE g.id=$1", genre.ID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Genre{}, errorhandler.ErrGenreNotFound
+		}
+		return domain.Genre{}, mapGenreWriteError(err)
+	}
+	res := MapGenreEntityToGenreDomain(updatedGenre)
+	return res, nil
+}
+
+// DeleteGenre implements ports.GenreRepository.
+// Genres still used by books are kept.
+func (r *GenreRepository) DeleteGenre(ctx context.Context, genre domain.Genre) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var inUse bool
+		query := "SELECT EXISTS (SELECT 1 FROM books b WHERE b.genre = g.name) FROM genres g WHERE g.id=$1 FOR UPDATE"
+		err := tx.QueryRowContext(ctx, query, genre.ID).Scan(&inUse)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrGenreNotFound
+			}
+			return err
+		}
+		if inUse {
+			return errorhandler.ErrGenreInUse
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM genres WHERE id=$1", genre.ID)
+		return err
+	})
+}
+
+// MergeGenre implements ports.GenreRepository.
+func (r *GenreRepository) MergeGenre(ctx context.Context, source domain.Genre, target domain.Genre) (domain.Genre, error) {
+	var mergedGenre Genre
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var sourceName, targetName string
+		err := tx.QueryRowContext(ctx, "SELECT name FROM genres WHERE id=$1 FOR UPDATE", source.ID).Scan(&sourceName)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, "SELECT name FROM genres WHERE id=$1 FOR UPDATE", target.ID).Scan(&targetName)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE books SET genre=$1 WHERE genre=$2", targetName, sourceName); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE genre_synonyms SET genre_id=$1 WHERE genre_id=$2", target.ID, source.ID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM genres WHERE id=$1", source.ID); err != nil {
+			return err
+		}
+		// The name of the merged genre keeps resolving, now to the target
+		if _, err := tx.ExecContext(ctx, "INSERT INTO genre_synonyms (genre_id, name) VALUES ($1, $2)", target.ID, sourceName); err != nil {
+			return err
+		}
+		mergedGenre, err = scanGenre(tx.QueryRowContext(ctx, "SELECT "+genreColumns+" FROM genres g WHERE g.id=$1", target.ID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Genre{}, errorhandler.ErrGenreNotFound
+		}
+		return domain.Genre{}, mapGenreWriteError(err)
+	}
+	res := MapGenreEntityToGenreDomain(mergedGenre)
+	return res, nil
+}
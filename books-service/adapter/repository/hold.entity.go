@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Hold struct {
+	ID         uint
+	BookID     uint
+	UserID     uint
+	CopyID     sql.NullInt32
+	AnyEdition bool
+	Status     sql.NullString
+	Position   uint
+	CreatedAt  sql.NullTime
+	ReadyAt    sql.NullTime
+	ExpiresAt  sql.NullTime
+}
+
+func MapHoldEntityToHoldDomain(hold Hold) domain.Hold {
+	return domain.Hold{
+		ID:         hold.ID,
+		BookID:     hold.BookID,
+		UserID:     hold.UserID,
+		CopyID:     uint(hold.CopyID.Int32),
+		AnyEdition: hold.AnyEdition,
+		Status:     domain.HoldStatus(hold.Status.String),
+		Position:   hold.Position,
+		CreatedAt:  hold.CreatedAt.Time,
+		ReadyAt:    hold.ReadyAt.Time,
+		ExpiresAt:  hold.ExpiresAt.Time,
+	}
+}
+
+func MapHoldsEntityToHoldsDomain(holds []Hold) []domain.Hold {
+	var res []domain.Hold
+	for _, hold := range holds {
+		res = append(res, MapHoldEntityToHoldDomain(hold))
+	}
+	return res
+}
+
+func MapHoldDomainToHoldEntity(hold domain.Hold) Hold {
+	return Hold{
+		ID:         hold.ID,
+		BookID:     hold.BookID,
+		UserID:     hold.UserID,
+		CopyID:     sql.NullInt32{Int32: int32(hold.CopyID), Valid: hold.CopyID > 0},
+		AnyEdition: hold.AnyEdition,
+		Status:     sql.NullString{String: string(hold.Status), Valid: hold.Status != ""},
+		Position:   hold.Position,
+		CreatedAt:  sql.NullTime{Time: hold.CreatedAt, Valid: !hold.CreatedAt.IsZero()},
+		ReadyAt:    sql.NullTime{Time: hold.ReadyAt, Valid: !hold.ReadyAt.IsZero()},
+		ExpiresAt:  sql.NullTime{Time: hold.ExpiresAt, Valid: !hold.ExpiresAt.IsZero()},
+	}
+}
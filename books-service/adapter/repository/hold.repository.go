@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgconn"
+)
+
+// holdColumns selects a hold aliased as h. The queue position is only meaningful
+// while the hold is waiting and is reported as zero otherwise. It counts the earlier holds
+// competing for the same copies: those on the book and, when either hold takes any edition,
+// those on the other editions of its work.
+const holdColumns = `h.id, h.book_id, h.user_id, h.copy_id, h.any_edition, h.status,
+	CASE WHEN h.status = 'waiting' THEN (
+		SELECT COUNT(*) FROM holds q
+		WHERE q.status = 'waiting' AND (q.created_at, q.id) <= (h.created_at, h.id) AND (q.book_id = h.book_id OR (
+			(q.any_edition OR h.any_edition) AND EXISTS (
+				SELECT 1 FROM books qb, books hb WHERE qb.id = q.book_id AND hb.id = h.book_id AND qb.work_id = hb.work_id
+			)
+		))
+	) ELSE 0 END,
+	h.created_at, h.ready_at, h.expires_at`
+
+// holdServesBook returns the condition under which a copy of the book given by the SQL
+// expression bookID can serve the hold aliased as h.
+func holdServesBook(bookID string) string {
+	return "(h.book_id = " + bookID + " OR (h.any_edition AND EXISTS (" +
+		"SELECT 1 FROM books hb, books cb WHERE hb.id = h.book_id AND cb.id = " + bookID + " AND hb.work_id = cb.work_id)))"
+}
+
+// waitingHoldsQuery counts the waiting holds a copy of the book $1 could serve, including holds
+// on any edition of its work. Holds placed by the user $2 are not counted.
+var waitingHoldsQuery = "SELECT COUNT(*) FROM holds h WHERE " + holdServesBook("$1") + " AND h.user_id<>$2 AND h.status='waiting'"
+
+func scanHold(row scanner) (Hold, error) {
+	var hold Hold
+	err := row.Scan(&hold.ID, &hold.BookID, &hold.UserID, &hold.CopyID, &hold.AnyEdition, &hold.Status, &hold.Position, &hold.CreatedAt, &hold.ReadyAt, &hold.ExpiresAt)
+	return hold, err
+}
+
+func scanHolds(rows *sql.Rows) ([]domain.Hold, error) {
+	defer rows.Close()
+
+	var holds []Hold
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return []domain.Hold{}, err
+		}
+		holds = append(holds, hold)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Hold{}, err
+	}
+	return MapHoldsEntityToHoldsDomain(holds), nil
+}
+
+// mapHoldWriteError translates constraint violations on the holds table into domain errors.
+func mapHoldWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.ConstraintName {
+		case "holds_open_book_user_idx":
+			return errorhandler.ErrDuplicateHold
+		case "holds_book_id_fkey":
+			return errorhandler.ErrBookNotFound
+		}
+	}
+	return err
+}
+
+type HoldRepository struct {
+	db *sql.DB
+}
+
+func NewHoldRepository() ports.HoldRepository {
+	return &HoldRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddHold implements ports.HoldRepository.
+// A hold on any edition is refused as a duplicate when the user already has one open on
+// another edition of the same work.
+func (r *HoldRepository) AddHold(ctx context.Context, hold domain.Hold) (domain.Hold, error) {
+	mappedHold := MapHoldDomainToHoldEntity(hold)
+
+	var id uint
+	query := "INSERT INTO holds (book_id, user_id, status, any_edition) SELECT $1, $2, $3, $4 " +
+		"WHERE NOT ($4 AND EXISTS (SELECT 1 FROM holds h WHERE h.user_id = $2 AND h.any_edition AND h.status IN ('waiting', 'ready') AND " + holdServesBook("$1") + ")) " +
+		"RETURNING id"
+	err := r.db.QueryRowContext(ctx, query, mappedHold.BookID, mappedHold.UserID, mappedHold.Status, mappedHold.AnyEdition).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Hold{}, errorhandler.ErrDuplicateHold
+		}
+		return domain.Hold{}, mapHoldWriteError(err)
+	}
+	// Re-read the hold so the queue position accounts for the new row.
+	return r.GetHold(ctx, domain.Hold{ID: id})
+}
+
+// GetHolds implements ports.HoldRepository.
+// The user, book and status of the given hold narrow the result when they are set.
+func (r *HoldRepository) GetHolds(ctx context.Context, hold domain.Hold) ([]domain.Hold, error) {
+	query := "SELECT " + holdColumns + " FROM holds h WHERE TRUE"
+	var args []interface{}
+	argCounter := 1
+
+	if hold.UserID > 0 {
+		query += " AND h.user_id=$" + strconv.Itoa(argCounter)
+		args = append(args, hold.UserID)
+		argCounter++
+	}
+	if hold.BookID > 0 {
+		query += " AND h.book_id=$" + strconv.Itoa(argCounter)
+		args = append(args, hold.BookID)
+		argCounter++
+	}
+	if hold.Status != "" {
+		query += " AND h.status=$" + strconv.Itoa(argCounter)
+		args = append(args, string(hold.Status))
+		argCounter++
+	}
+	query += " ORDER BY h.created_at, h.id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.Hold{}, err
+	}
+	return scanHolds(rows)
+}
+
+// GetHold implements ports.HoldRepository.
+func (r *HoldRepository) GetHold(ctx context.Context, hold domain.Hold) (domain.Hold, error) {
+	query := "SELECT " + holdColumns + " FROM holds h WHERE h.id=$1"
+	row := r.db.QueryRowContext(ctx, query, hold.ID)
+	foundHold, err := scanHold(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Hold{}, errorhandler.ErrHoldNotFound
+		}
+		return domain.Hold{}, err
+	}
+	res := MapHoldEntityToHoldDomain(foundHold)
+	return res, nil
+}
+
+// CloseHold implements ports.HoldRepository.
+// It moves a hold that is still waiting or ready to the status of the given hold and
+// returns ErrHoldNotOpen when the hold was closed in the meantime. A copy set aside for the hold
+// is passed on in the same transaction, see shelveCopyTx, with next carrying the pickup window of
+// the hold it readies, so a closed hold never keeps its copy on the hold shelf.
+func (r *HoldRepository) CloseHold(ctx context.Context, hold domain.Hold, next domain.Hold) (domain.Hold, error) {
+	var closedHold Hold
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// Copies are locked before their holds, as everywhere else, so that a concurrent borrow
+		// of the copy set aside cannot deadlock with the close.
+		var heldCopyID uint
+		query := "SELECT c.id FROM holds h JOIN copies c ON c.id = h.copy_id WHERE h.id=$1 AND c.status='on_hold' FOR UPDATE OF c"
+		err := tx.QueryRowContext(ctx, query, hold.ID).Scan(&heldCopyID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		query = "UPDATE holds AS h SET status=$1 WHERE h.id=$2 AND h.status IN ('waiting', 'ready') RETURNING " + holdColumns
+		closedHold, err = scanHold(tx.QueryRowContext(ctx, query, string(hold.Status), hold.ID))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrHoldNotOpen
+			}
+			return err
+		}
+		if !closedHold.CopyID.Valid {
+			return nil
+		}
+
+		var bookID uint
+		query = "SELECT book_id FROM copies WHERE id=$1 AND status='on_hold' FOR UPDATE"
+		err = tx.QueryRowContext(ctx, query, closedHold.CopyID.Int32).Scan(&bookID)
+		if err != nil {
+			// The copy has already left the hold shelf.
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		_, err = shelveCopyTx(ctx, tx, uint(closedHold.CopyID.Int32), bookID, next)
+		return err
+	})
+	if err != nil {
+		return domain.Hold{}, err
+	}
+	res := MapHoldEntityToHoldDomain(closedHold)
+	return res, nil
+}
+
+// GetExpiredHolds implements ports.HoldRepository.
+// It returns ready holds whose pickup window closed before now.
+func (r *HoldRepository) GetExpiredHolds(ctx context.Context, now time.Time) ([]domain.Hold, error) {
+	query := "SELECT " + holdColumns + " FROM holds h WHERE h.status='ready' AND h.expires_at < $1 ORDER BY h.expires_at, h.id"
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return []domain.Hold{}, err
+	}
+	return scanHolds(rows)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"library-management-api/books-service/core/domain"
+	"testing"
+	"time"
+)
+
+func TestCloseHoldPassesCopyOn(t *testing.T) {
+	db := openTestDB(t)
+	holdRepository := &HoldRepository{db: db}
+	ctx := context.Background()
+	bookID := addTestBook(t, db, 1)
+
+	var copyID uint
+	err := db.QueryRow("UPDATE copies SET status='on_hold' WHERE book_id=$1 RETURNING id", bookID).Scan(&copyID)
+	if err != nil {
+		t.Fatalf("set copy aside: %v", err)
+	}
+	var readyID, waitingID uint
+	query := "INSERT INTO holds (book_id, user_id, status, copy_id, ready_at, expires_at) VALUES ($1, $2, 'ready', $3, NOW(), NOW()) RETURNING id"
+	if err := db.QueryRow(query, bookID, 3_000_000, copyID).Scan(&readyID); err != nil {
+		t.Fatalf("add ready hold: %v", err)
+	}
+	query = "INSERT INTO holds (book_id, user_id, status) VALUES ($1, $2, 'waiting') RETURNING id"
+	if err := db.QueryRow(query, bookID, 3_000_001).Scan(&waitingID); err != nil {
+		t.Fatalf("add waiting hold: %v", err)
+	}
+
+	// The copy of the cancelled hold goes to the patron waiting next.
+	now := time.Now()
+	next := domain.Hold{ReadyAt: now, ExpiresAt: now.Add(time.Hour)}
+	cancelledHold, err := holdRepository.CloseHold(ctx, domain.Hold{ID: readyID, Status: domain.HoldStatusCancelled}, next)
+	if err != nil {
+		t.Fatalf("CloseHold() error = %v", err)
+	}
+	if cancelledHold.Status != domain.HoldStatusCancelled {
+		t.Errorf("CloseHold() status = %q, want %q", cancelledHold.Status, domain.HoldStatusCancelled)
+	}
+	waitingHold, err := holdRepository.GetHold(ctx, domain.Hold{ID: waitingID})
+	if err != nil {
+		t.Fatalf("GetHold() error = %v", err)
+	}
+	if waitingHold.Status != domain.HoldStatusReady || waitingHold.CopyID != copyID {
+		t.Errorf("next hold = %q with copy %d, want %q with copy %d", waitingHold.Status, waitingHold.CopyID, domain.HoldStatusReady, copyID)
+	}
+
+	// With nobody left waiting, the copy of the expired hold goes back on the open shelf.
+	_, err = holdRepository.CloseHold(ctx, domain.Hold{ID: waitingID, Status: domain.HoldStatusExpired}, next)
+	if err != nil {
+		t.Fatalf("CloseHold() error = %v", err)
+	}
+	var status string
+	if err := db.QueryRow("SELECT status FROM copies WHERE id=$1", copyID).Scan(&status); err != nil {
+		t.Fatalf("get copy: %v", err)
+	}
+	if status != string(domain.CopyStatusAvailable) {
+		t.Errorf("copy status = %q, want %q", status, domain.CopyStatusAvailable)
+	}
+}
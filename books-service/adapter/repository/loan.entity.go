@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Loan struct {
+	ID         uint
+	CopyID     sql.NullInt32
+	BookID     uint
+	UserID     uint
+	BorrowedAt sql.NullTime
+	DueAt      sql.NullTime
+	ReturnedAt sql.NullTime
+	BorrowedBy uint
+	ReturnedBy sql.NullInt32
+	Renewals   uint
+}
+
+func MapLoanEntityToLoanDomain(loan Loan) domain.Loan {
+	return domain.Loan{
+		ID:         loan.ID,
+		CopyID:     uint(loan.CopyID.Int32),
+		BookID:     loan.BookID,
+		UserID:     loan.UserID,
+		BorrowedAt: loan.BorrowedAt.Time,
+		DueAt:      loan.DueAt.Time,
+		ReturnedAt: loan.ReturnedAt.Time,
+		BorrowedBy: loan.BorrowedBy,
+		ReturnedBy: uint(loan.ReturnedBy.Int32),
+		Renewals:   loan.Renewals,
+	}
+}
+
+func MapLoansEntityToLoansDomain(loans []Loan) []domain.Loan {
+	var res []domain.Loan
+	for _, loan := range loans {
+		res = append(res, MapLoanEntityToLoanDomain(loan))
+	}
+	return res
+}
+
+func MapLoanDomainToLoanEntity(loan domain.Loan) Loan {
+	return Loan{
+		ID:         loan.ID,
+		CopyID:     sql.NullInt32{Int32: int32(loan.CopyID), Valid: loan.CopyID > 0},
+		BookID:     loan.BookID,
+		UserID:     loan.UserID,
+		BorrowedAt: sql.NullTime{Time: loan.BorrowedAt, Valid: !loan.BorrowedAt.IsZero()},
+		DueAt:      sql.NullTime{Time: loan.DueAt, Valid: !loan.DueAt.IsZero()},
+		ReturnedAt: sql.NullTime{Time: loan.ReturnedAt, Valid: !loan.ReturnedAt.IsZero()},
+		BorrowedBy: loan.BorrowedBy,
+		ReturnedBy: sql.NullInt32{Int32: int32(loan.ReturnedBy), Valid: loan.ReturnedBy > 0},
+		Renewals:   loan.Renewals,
+	}
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+	"strconv"
+	"time"
+)
+
+const loanColumns = "id, copy_id, book_id, user_id, borrowed_at, due_at, returned_at, borrowed_by, returned_by, renewals"
+
+func scanLoan(row scanner) (Loan, error) {
+	var loan Loan
+	err := row.Scan(&loan.ID, &loan.CopyID, &loan.BookID, &loan.UserID, &loan.BorrowedAt, &loan.DueAt, &loan.ReturnedAt, &loan.BorrowedBy, &loan.ReturnedBy, &loan.Renewals)
+	return loan, err
+}
+
+type LoanRepository struct {
+	db *sql.DB
+}
+
+func NewLoanRepository() ports.LoanRepository {
+	return &LoanRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddLoan implements ports.LoanRepository.
+func (l *LoanRepository) AddLoan(ctx context.Context, loan domain.Loan) (domain.Loan, error) {
+	mappedLoan := MapLoanDomainToLoanEntity(loan)
+
+	query := "INSERT INTO loans (copy_id, book_id, user_id, due_at, borrowed_by) VALUES ($1, $2, $3, $4, $5) RETURNING " + loanColumns
+	row := l.db.QueryRowContext(ctx, query, mappedLoan.CopyID, mappedLoan.BookID, mappedLoan.UserID, mappedLoan.DueAt, mappedLoan.BorrowedBy)
+	addedLoan, err := scanLoan(row)
+	if err != nil {
+		return domain.Loan{}, err
+	}
+	res := MapLoanEntityToLoanDomain(addedLoan)
+	return res, nil
+}
+
+// GetLoans implements ports.LoanRepository.
+// The user and book of the given loan narrow the result when they are set.
+func (l *LoanRepository) GetLoans(ctx context.Context, loan domain.Loan) ([]domain.Loan, error) {
+	var loans []Loan
+
+	query := "SELECT " + loanColumns + " FROM loans WHERE TRUE"
+	var args []interface{}
+	argCounter := 1
+
+	if loan.UserID > 0 {
+		query += " AND user_id=$" + strconv.Itoa(argCounter)
+		args = append(args, loan.UserID)
+		argCounter++
+	}
+	if loan.BookID > 0 {
+		query += " AND book_id=$" + strconv.Itoa(argCounter)
+		args = append(args, loan.BookID)
+		argCounter++
+	}
+	query += " ORDER BY borrowed_at DESC, id DESC"
+
+	rows, err := l.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.Loan{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		foundLoan, err := scanLoan(rows)
+		if err != nil {
+			return []domain.Loan{}, err
+		}
+		loans = append(loans, foundLoan)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Loan{}, err
+	}
+	res := MapLoansEntityToLoansDomain(loans)
+	return res, nil
+}
+
+// GetActiveLoan implements ports.LoanRepository.
+// The open loan is looked up by copy when the copy is known, otherwise by book and borrower.
+func (l *LoanRepository) GetActiveLoan(ctx context.Context, loan domain.Loan) (domain.Loan, error) {
+	var row *sql.Row
+	if loan.CopyID > 0 {
+		query := "SELECT " + loanColumns + " FROM loans WHERE copy_id=$1 AND returned_at IS NULL"
+		row = l.db.QueryRowContext(ctx, query, loan.CopyID)
+	} else {
+		query := "SELECT " + loanColumns + " FROM loans WHERE book_id=$1 AND user_id=$2 AND returned_at IS NULL ORDER BY borrowed_at LIMIT 1"
+		row = l.db.QueryRowContext(ctx, query, loan.BookID, loan.UserID)
+	}
+	foundLoan, err := scanLoan(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Loan{}, errorhandler.ErrLoanNotFound
+		}
+		return domain.Loan{}, err
+	}
+	res := MapLoanEntityToLoanDomain(foundLoan)
+	return res, nil
+}
+
+// RenewLoan implements ports.LoanRepository.
+// It extends the open loan of the loan's book by the loan's user by one period of the policy.
+// The loan row is locked while the renewal limit and the hold queue of the book are checked, so
+// concurrent renewals cannot together go past the limit. ErrRenewalLimitReached is returned when
+// the loan has no renewals left and ErrBookOnHold when another patron is waiting for the book.
+func (l *LoanRepository) RenewLoan(ctx context.Context, loan domain.Loan, policy domain.LoanPolicy, now time.Time) (domain.Loan, error) {
+	mappedLoan := MapLoanDomainToLoanEntity(loan)
+
+	var renewedLoan Loan
+	err := withTx(ctx, l.db, func(tx *sql.Tx) error {
+		query := "SELECT " + loanColumns + " FROM loans WHERE book_id=$1 AND user_id=$2 AND returned_at IS NULL ORDER BY borrowed_at LIMIT 1 FOR UPDATE"
+		lockedLoan, err := scanLoan(tx.QueryRowContext(ctx, query, mappedLoan.BookID, mappedLoan.UserID))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrLoanNotFound
+			}
+			return err
+		}
+		foundLoan := MapLoanEntityToLoanDomain(lockedLoan)
+		if !policy.AllowsRenewal(foundLoan) {
+			return errorhandler.ErrRenewalLimitReached
+		}
+
+		var waitingHolds uint
+		err = tx.QueryRowContext(ctx, waitingHoldsQuery, mappedLoan.BookID, mappedLoan.UserID).Scan(&waitingHolds)
+		if err != nil {
+			return err
+		}
+		if waitingHolds > 0 {
+			return errorhandler.ErrBookOnHold
+		}
+
+		query = "UPDATE loans SET due_at=$1, renewals=renewals+1 WHERE id=$2 AND returned_at IS NULL AND renewals < $3 RETURNING " + loanColumns
+		row := tx.QueryRowContext(ctx, query, policy.RenewedDueDate(foundLoan, now), foundLoan.ID, policy.MaxRenewals)
+		renewedLoan, err = scanLoan(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrRenewalLimitReached
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.Loan{}, err
+	}
+	res := MapLoanEntityToLoanDomain(renewedLoan)
+	return res, nil
+}
+
+// GetOverdueLoans implements ports.LoanRepository.
+// It returns open loans that were due before the given time.
+func (l *LoanRepository) GetOverdueLoans(ctx context.Context, dueBefore time.Time) ([]domain.Loan, error) {
+	var loans []Loan
+
+	query := "SELECT " + loanColumns + " FROM loans WHERE returned_at IS NULL AND due_at < $1 ORDER BY due_at, id"
+	rows, err := l.db.QueryContext(ctx, query, dueBefore)
+	if err != nil {
+		return []domain.Loan{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		foundLoan, err := scanLoan(rows)
+		if err != nil {
+			return []domain.Loan{}, err
+		}
+		loans = append(loans, foundLoan)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Loan{}, err
+	}
+	res := MapLoansEntityToLoansDomain(loans)
+	return res, nil
+}
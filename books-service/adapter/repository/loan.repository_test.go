@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/util/errorhandler"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRenewLoanConcurrent(t *testing.T) {
+	db := openTestDB(t)
+	loanRepository := &LoanRepository{db: db}
+	bookID := addTestBook(t, db, 0)
+
+	const userID = 4_000_000
+	loanPolicy := domain.LoanPolicy{Period: 14 * 24 * time.Hour, MaxRenewals: 1, MaxActiveLoans: 5}
+	_, err := loanRepository.AddLoan(context.Background(), domain.Loan{
+		BookID:     bookID,
+		UserID:     userID,
+		DueAt:      loanPolicy.DueDate(time.Now()),
+		BorrowedBy: userID,
+	})
+	if err != nil {
+		t.Fatalf("add loan: %v", err)
+	}
+
+	const renewals = 10
+	var wg sync.WaitGroup
+	errs := make([]error, renewals)
+	start := make(chan struct{})
+	for i := 0; i < renewals; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = loanRepository.RenewLoan(context.Background(), domain.Loan{BookID: bookID, UserID: userID}, loanPolicy, time.Now())
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	renewed := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			renewed++
+		case errors.Is(err, errorhandler.ErrRenewalLimitReached):
+		default:
+			t.Errorf("renewal %d: unexpected error %v", i, err)
+		}
+	}
+	if renewed != 1 {
+		t.Errorf("got %d successful renewals, want 1", renewed)
+	}
+
+	loan, err := loanRepository.GetActiveLoan(context.Background(), domain.Loan{BookID: bookID, UserID: userID})
+	if err != nil {
+		t.Fatalf("get loan: %v", err)
+	}
+	if loan.Renewals != 1 {
+		t.Errorf("got %d renewals on the loan, want 1", loan.Renewals)
+	}
+}
+
+func TestRenewLoanWithWaitingHold(t *testing.T) {
+	db := openTestDB(t)
+	loanRepository := &LoanRepository{db: db}
+	holdRepository := &HoldRepository{db: db}
+	bookID := addTestBook(t, db, 0)
+
+	const userID = 4_000_001
+	loanPolicy := domain.LoanPolicy{Period: 14 * 24 * time.Hour, MaxRenewals: 3, MaxActiveLoans: 5}
+	_, err := loanRepository.AddLoan(context.Background(), domain.Loan{
+		BookID:     bookID,
+		UserID:     userID,
+		DueAt:      loanPolicy.DueDate(time.Now()),
+		BorrowedBy: userID,
+	})
+	if err != nil {
+		t.Fatalf("add loan: %v", err)
+	}
+	_, err = holdRepository.AddHold(context.Background(), domain.Hold{BookID: bookID, UserID: userID + 1, Status: domain.HoldStatusWaiting})
+	if err != nil {
+		t.Fatalf("add hold: %v", err)
+	}
+
+	_, err = loanRepository.RenewLoan(context.Background(), domain.Loan{BookID: bookID, UserID: userID}, loanPolicy, time.Now())
+	if !errors.Is(err, errorhandler.ErrBookOnHold) {
+		t.Errorf("RenewLoan() error = %v, want ErrBookOnHold", err)
+	}
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Subject struct {
+	ID        uint
+	Name      sql.NullString
+	ParentID  sql.NullInt32
+	BookCount uint
+	CreatedAt sql.NullTime
+}
+
+func MapSubjectEntityToSubjectDomain(subject Subject) domain.Subject {
+	return domain.Subject{
+		ID:        subject.ID,
+		Name:      subject.Name.String,
+		ParentID:  uint(subject.ParentID.Int32),
+		BookCount: subject.BookCount,
+		CreatedAt: subject.CreatedAt.Time,
+	}
+}
+
+func MapSubjectsEntityToSubjectsDomain(subjects []Subject) []domain.Subject {
+	var res []domain.Subject
+	for _, subject := range subjects {
+		res = append(res, MapSubjectEntityToSubjectDomain(subject))
+	}
+	return res
+}
+
+func MapSubjectDomainToSubjectEntity(subject domain.Subject) Subject {
+	return Subject{
+		ID:        subject.ID,
+		Name:      sql.NullString{String: subject.Name, Valid: subject.Name != ""},
+		ParentID:  sql.NullInt32{Int32: int32(subject.ParentID), Valid: subject.ParentID > 0},
+		CreatedAt: sql.NullTime{Time: subject.CreatedAt, Valid: true},
+	}
+}
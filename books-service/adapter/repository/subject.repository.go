@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+
+	"github.com/jackc/pgconn"
+)
+
+// subjectColumns selects a subject row together with the number of books filed under it.
+// Every query using it must alias the subjects table as "s".
+const subjectColumns = "s.id, s.name, s.parent_id, (SELECT COUNT(*) FROM books b WHERE b.subject = s.name), s.created_at"
+
+// subjectTree is a common table expression selecting the names of the subject with the id $1
+// and of every subject narrower than it.
+const subjectTree = "WITH RECURSIVE tree AS (" +
+	"SELECT s.id, s.name FROM subjects s WHERE s.id = $1 " +
+	"UNION ALL SELECT s.id, s.name FROM subjects s JOIN tree t ON s.parent_id = t.id) "
+
+func scanSubject(row scanner) (Subject, error) {
+	var subject Subject
+	err := row.Scan(&subject.ID, &subject.Name, &subject.ParentID, &subject.BookCount, &subject.CreatedAt)
+	return subject, err
+}
+
+// mapSubjectWriteError translates constraint violations on the subjects table into domain errors.
+func mapSubjectWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "subjects_name_key" {
+		return errorhandler.ErrDuplicateSubject
+	}
+	return err
+}
+
+// checkSubjectParentTx makes sure that the parent of a subject exists and is not the subject
+// itself or narrower than it, which would make the hierarchy circular.
+func checkSubjectParentTx(ctx context.Context, tx *sql.Tx, subject Subject) error {
+	if !subject.ParentID.Valid {
+		return nil
+	}
+	var exists bool
+	err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM subjects WHERE id=$1 FOR SHARE)", subject.ParentID).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errorhandler.ErrParentNotFound
+	}
+	if subject.ID == 0 {
+		return nil
+	}
+	var circular bool
+	err = tx.QueryRowContext(ctx, subjectTree+"SELECT EXISTS (SELECT 1 FROM tree WHERE id=$2)", subject.ID, subject.ParentID).Scan(&circular)
+	if err != nil {
+		return err
+	}
+	if circular {
+		return errorhandler.ErrSubjectCycle
+	}
+	return nil
+}
+
+type SubjectRepository struct {
+	db *sql.DB
+}
+
+func NewSubjectRepository() ports.SubjectRepository {
+	return &SubjectRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddSubject implements ports.SubjectRepository.
+func (r *SubjectRepository) AddSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error) {
+	mappedSubject := MapSubjectDomainToSubjectEntity(subject)
+
+	var addedSubject Subject
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if err := checkSubjectParentTx(ctx, tx, mappedSubject); err != nil {
+			return err
+		}
+		var err error
+		query := "INSERT INTO subjects AS s (name, parent_id) VALUES ($1, $2) RETURNING " + subjectColumns
+		addedSubject, err = scanSubject(tx.QueryRowContext(ctx, query, mappedSubject.Name, mappedSubject.ParentID))
+		return err
+	})
+	if err != nil {
+		return domain.Subject{}, mapSubjectWriteError(err)
+	}
+	res := MapSubjectEntityToSubjectDomain(addedSubject)
+	return res, nil
+}
+
+// GetSubjects implements ports.SubjectRepository.
+func (r *SubjectRepository) GetSubjects(ctx context.Context) ([]domain.Subject, error) {
+	query := "SELECT " + subjectColumns + " FROM subjects s ORDER BY lower(s.name), s.id"
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return []domain.Subject{}, err
+	}
+	defer rows.Close()
+
+	var subjects []Subject
+	for rows.Next() {
+		subject, err := scanSubject(rows)
+		if err != nil {
+			return []domain.Subject{}, err
+		}
+		subjects = append(subjects, subject)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Subject{}, err
+	}
+	res := MapSubjectsEntityToSubjectsDomain(subjects)
+	return res, nil
+}
+
+// GetSubject implements ports.SubjectRepository.
+func (r *SubjectRepository) GetSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error) {
+	query := "SELECT " + subjectColumns + " FROM subjects s WHERE s.id=$1"
+	foundSubject, err := scanSubject(r.db.QueryRowContext(ctx, query, subject.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Subject{}, errorhandler.ErrSubjectNotFound
+		}
+		return domain.Subject{}, err
+	}
+	res := MapSubjectEntityToSubjectDomain(foundSubject)
+	return res, nil
+}
+
+// GetSubjectByName implements ports.SubjectRepository.
+func (r *SubjectRepository) GetSubjectByName(ctx context.Context, name string) (domain.Subject, error) {
+	query := "SELECT " + subjectColumns + " FROM subjects s WHERE lower(s.name)=lower($1)"
+	foundSubject, err := scanSubject(r.db.QueryRowContext(ctx, query, name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Subject{}, errorhandler.ErrSubjectNotFound
+		}
+		return domain.Subject{}, err
+	}
+	res := MapSubjectEntityToSubjectDomain(foundSubject)
+	return res, nil
+}
+
+// UpdateSubject implements ports.SubjectRepository.
+// The subject column of the books filed under the subject is rewritten in the same transaction.
+func (r *SubjectRepository) UpdateSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error) {
+	mappedSubject := MapSubjectDomainToSubjectEntity(subject)
+
+	var updatedSubject Subject
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var oldName string
+		err := tx.QueryRowContext(ctx, "SELECT name FROM subjects WHERE id=$1 FOR UPDATE", mappedSubject.ID).Scan(&oldName)
+		if err != nil {
+			return err
+		}
+		if err := checkSubjectParentTx(ctx, tx, mappedSubject); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE subjects SET name=$1, parent_id=$2 WHERE id=$3", mappedSubject.Name, mappedSubject.ParentID, mappedSubject.ID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE books SET subject=$1 WHERE subject=$2", mappedSubject.Name, oldName); err != nil {
+			return err
+		}
+		updatedSubject, err = scanSubject(tx.QueryRowContext(ctx, "SELECT "+subjectColumns+" FROM subjects s WHERE s.id=$1", mappedSubject.ID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Subject{}, errorhandler.ErrSubjectNotFound
+		}
+		return domain.Subject{}, mapSubjectWriteError(err)
+	}
+	res := MapSubjectEntityToSubjectDomain(updatedSubject)
+	return res, nil
+}
+
+// DeleteSubject implements ports.SubjectRepository.
+// Subjects still used by books or with narrower subjects are kept.
+func (r *SubjectRepository) DeleteSubject(ctx context.Context, subject domain.Subject) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var inUse bool
+		query := "SELECT EXISTS (SELECT 1 FROM books b WHERE b.subject = s.name) OR EXISTS (SELECT 1 FROM subjects c WHERE c.parent_id = s.id) " +
+			"FROM subjects s WHERE s.id=$1 FOR UPDATE"
+		err := tx.QueryRowContext(ctx, query, subject.ID).Scan(&inUse)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errorhandler.ErrSubjectNotFound
+			}
+			return err
+		}
+		if inUse {
+			return errorhandler.ErrSubjectInUse
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM subjects WHERE id=$1", subject.ID)
+		return err
+	})
+}
+
+// MergeSubject implements ports.SubjectRepository.
+// A subject cannot be merged into one of its narrower subjects, as that subject would end up
+// under itself.
+func (r *SubjectRepository) MergeSubject(ctx context.Context, source domain.Subject, target domain.Subject) (domain.Subject, error) {
+	var mergedSubject Subject
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var sourceName, targetName string
+		err := tx.QueryRowContext(ctx, "SELECT name FROM subjects WHERE id=$1 FOR UPDATE", source.ID).Scan(&sourceName)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, "SELECT name FROM subjects WHERE id=$1 FOR UPDATE", target.ID).Scan(&targetName)
+		if err != nil {
+			return err
+		}
+
+		var circular bool
+		err = tx.QueryRowContext(ctx, subjectTree+"SELECT EXISTS (SELECT 1 FROM tree WHERE id=$2)", source.ID, target.ID).Scan(&circular)
+		if err != nil {
+			return err
+		}
+		if circular {
+			return errorhandler.ErrSubjectCycle
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE books SET subject=$1 WHERE subject=$2", targetName, sourceName); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE subjects SET parent_id=$1 WHERE parent_id=$2", target.ID, source.ID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM subjects WHERE id=$1", source.ID); err != nil {
+			return err
+		}
+		mergedSubject, err = scanSubject(tx.QueryRowContext(ctx, "SELECT "+subjectColumns+" FROM subjects s WHERE s.id=$1", target.ID))
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Subject{}, errorhandler.ErrSubjectNotFound
+		}
+		return domain.Subject{}, err
+	}
+	res := MapSubjectEntityToSubjectDomain(mergedSubject)
+	return res, nil
+}
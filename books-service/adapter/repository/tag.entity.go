@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Tag struct {
+	ID        uint
+	Name      sql.NullString
+	BookCount uint
+	CreatedAt sql.NullTime
+}
+
+func MapTagEntityToTagDomain(tag Tag) domain.Tag {
+	return domain.Tag{
+		ID:        tag.ID,
+		Name:      tag.Name.String,
+		BookCount: tag.BookCount,
+		CreatedAt: tag.CreatedAt.Time,
+	}
+}
+
+func MapTagsEntityToTagsDomain(tags []Tag) []domain.Tag {
+	var res []domain.Tag
+	for _, tag := range tags {
+		res = append(res, MapTagEntityToTagDomain(tag))
+	}
+	return res
+}
+
+func MapTagDomainToTagEntity(tag domain.Tag) Tag {
+	return Tag{
+		ID:        tag.ID,
+		Name:      sql.NullString{String: tag.Name, Valid: tag.Name != ""},
+		CreatedAt: sql.NullTime{Time: tag.CreatedAt, Valid: true},
+	}
+}
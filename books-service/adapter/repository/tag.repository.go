@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+
+	"github.com/jackc/pgconn"
+)
+
+// tagColumns selects a tag row together with the number of books carrying the tag.
+// Every query using it must alias the tags table as "t".
+const tagColumns = "t.id, t.name, (SELECT COUNT(*) FROM book_tags bt WHERE bt.tag_id = t.id), t.created_at"
+
+func scanTag(row scanner) (Tag, error) {
+	var tag Tag
+	err := row.Scan(&tag.ID, &tag.Name, &tag.BookCount, &tag.CreatedAt)
+	return tag, err
+}
+
+// mapTagWriteError translates constraint violations on the tags table into domain errors.
+func mapTagWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == "tags_name_key" {
+		return errorhandler.ErrDuplicateTag
+	}
+	return err
+}
+
+type TagRepository struct {
+	db *sql.DB
+}
+
+func NewTagRepository() ports.TagRepository {
+	return &TagRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddTag implements ports.TagRepository.
+func (r *TagRepository) AddTag(ctx context.Context, tag domain.Tag) (domain.Tag, error) {
+	mappedTag := MapTagDomainToTagEntity(tag)
+
+	query := "INSERT INTO tags AS t (name) VALUES ($1) RETURNING " + tagColumns
+	addedTag, err := scanTag(r.db.QueryRowContext(ctx, query, mappedTag.Name))
+	if err != nil {
+		return domain.Tag{}, mapTagWriteError(err)
+	}
+	res := MapTagEntityToTagDomain(addedTag)
+	return res, nil
+}
+
+// GetTags implements ports.TagRepository.
+func (r *TagRepository) GetTags(ctx context.Context) ([]domain.Tag, error) {
+	query := "SELECT " + tagColumns + " FROM tags t ORDER BY lower(t.name), t.id"
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return []domain.Tag{}, err
+	}
+	defer rows.Close()
+
+	var tags []Tag
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return []domain.Tag{}, err
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return []domain.Tag{}, err
+	}
+	res := MapTagsEntityToTagsDomain(tags)
+	return res, nil
+}
+
+// UpdateTag implements ports.TagRepository.
+func (r *TagRepository) UpdateTag(ctx context.Context, tag domain.Tag) (domain.Tag, error) {
+	mappedTag := MapTagDomainToTagEntity(tag)
+
+	query := "UPDATE tags AS t SET name=$1 WHERE t.id=$2 RETURNING " + tagColumns
+	updatedTag, err := scanTag(r.db.QueryRowContext(ctx, query, mappedTag.Name, mappedTag.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Tag{}, errorhandler.ErrTagNotFound
+		}
+		return domain.Tag{}, mapTagWriteError(err)
+	}
+	res := MapTagEntityToTagDomain(updatedTag)
+	return res, nil
+}
+
+// DeleteTag implements ports.TagRepository.
+func (r *TagRepository) DeleteTag(ctx context.Context, tag domain.Tag) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM tags WHERE id=$1", tag.ID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errorhandler.ErrTagNotFound
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"database/sql"
+	"library-management-api/books-service/core/domain"
+)
+
+type Work struct {
+	ID        uint
+	Title     sql.NullString
+	CreatedAt sql.NullTime
+}
+
+func MapWorkEntityToWorkDomain(work Work, editions []Book) domain.Work {
+	return domain.Work{
+		ID:        work.ID,
+		Title:     work.Title.String,
+		Editions:  MapBooksEntityToBooksDomain(editions),
+		CreatedAt: work.CreatedAt.Time,
+	}
+}
+
+func MapWorkDomainToWorkEntity(work domain.Work) Work {
+	return Work{
+		ID:        work.ID,
+		Title:     sql.NullString{String: work.Title, Valid: work.Title != ""},
+		CreatedAt: sql.NullTime{Time: work.CreatedAt, Valid: true},
+	}
+}
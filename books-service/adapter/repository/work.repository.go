@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/init/database"
+	"library-management-api/util/errorhandler"
+)
+
+type WorkRepository struct {
+	db *sql.DB
+}
+
+func NewWorkRepository() ports.WorkRepository {
+	return &WorkRepository{
+		db: database.P().DB,
+	}
+}
+
+// AddWork implements ports.WorkRepository.
+func (r *WorkRepository) AddWork(ctx context.Context, work domain.Work) (domain.Work, error) {
+	mappedWork := MapWorkDomainToWorkEntity(work)
+
+	var addedWork Work
+	query := "INSERT INTO works (title) VALUES ($1) RETURNING id, title, created_at"
+	err := r.db.QueryRowContext(ctx, query, mappedWork.Title).Scan(&addedWork.ID, &addedWork.Title, &addedWork.CreatedAt)
+	if err != nil {
+		return domain.Work{}, err
+	}
+	res := MapWorkEntityToWorkDomain(addedWork, nil)
+	return res, nil
+}
+
+// GetWork implements ports.WorkRepository.
+// The editions are ordered by publication year, oldest first.
+func (r *WorkRepository) GetWork(ctx context.Context, work domain.Work) (domain.Work, error) {
+	var foundWork Work
+	query := "SELECT id, title, created_at FROM works WHERE id=$1"
+	err := r.db.QueryRowContext(ctx, query, work.ID).Scan(&foundWork.ID, &foundWork.Title, &foundWork.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Work{}, errorhandler.ErrWorkNotFound
+		}
+		return domain.Work{}, err
+	}
+
+	query = "SELECT " + bookColumns + " FROM books b WHERE b.work_id=$1 ORDER BY b.published_year, b.id"
+	rows, err := r.db.QueryContext(ctx, query, work.ID)
+	if err != nil {
+		return domain.Work{}, err
+	}
+	defer rows.Close()
+
+	editions, err := scanBooks(rows)
+	if err != nil {
+		return domain.Work{}, err
+	}
+	res := MapWorkEntityToWorkDomain(foundWork, editions)
+	return res, nil
+}
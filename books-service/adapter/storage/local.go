@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage keeps files on the local filesystem, below dir.
+type LocalStorage struct {
+	dir string
+}
+
+func NewLocalStorage(dir string) ports.FileStorage {
+	return &LocalStorage{
+		dir: dir,
+	}
+}
+
+// path maps a key to a file below dir. Keys that would escape dir are rejected.
+func (s *LocalStorage) path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || strings.Contains(key, `\`) {
+		return "", errorhandler.ErrFileNotFound
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(cleaned)), nil
+}
+
+// Put implements ports.FileStorage.
+// The file is written next to its destination and renamed into place, so that readers never
+// see a partial file.
+func (s *LocalStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+// Get implements ports.FileStorage.
+func (s *LocalStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, errorhandler.ErrFileNotFound
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// Delete implements ports.FileStorage.
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return nil
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// Package storage keeps uploaded files. The driver is picked by configuration, so that files can
+// move from the local disk to an object store without touching the use cases.
+package storage
+
+import (
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/ports"
+
+	"github.com/rs/zerolog/log"
+)
+
+// NewFileStorage returns the file storage selected by the storage configuration.
+func NewFileStorage() ports.FileStorage {
+	storageConfig := configs.C().Storage
+
+	switch storageConfig.Driver {
+	case "local":
+		return NewLocalStorage(storageConfig.LocalDir)
+	default:
+		log.Error().Msgf("unsupported storage driver %q", storageConfig.Driver)
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AuthorController struct {
+	books.UnimplementedAuthorsServiceServer
+	authorUseCase *usecase.AuthorUseCase
+	bookUseCase   *usecase.BookUseCase
+}
+
+func NewAuthorController() *AuthorController {
+	return &AuthorController{
+		authorUseCase: usecase.NewAuthorUseCase(),
+		bookUseCase:   usecase.NewBookUseCase(),
+	}
+}
+
+func (c *AuthorController) AddAuthor(ctx context.Context, req *books.AddAuthorReq) (*books.Author, error) {
+	res, err := c.authorUseCase.AddAuthor(ctx, MapProtoAddAuthorReqToDomainAuthor(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainAuthorToProtoAuthor(res), nil
+}
+
+func (c *AuthorController) ListAuthors(ctx context.Context, req *books.ListAuthorsReq) (*books.AuthorPage, error) {
+	res, err := c.authorUseCase.GetAuthors(ctx, MapProtoListAuthorsReqToDomainAuthorFilter(req), MapProtoListQueryToListQuery(req.GetList()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainAuthorPageToProtoAuthorPage(res), nil
+}
+
+func (c *AuthorController) GetAuthor(ctx context.Context, req *books.GetAuthorReq) (*books.Author, error) {
+	res, err := c.authorUseCase.GetAuthor(ctx, MapProtoGetAuthorReqToDomainAuthor(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainAuthorToProtoAuthor(res), nil
+}
+
+func (c *AuthorController) UpdateAuthor(ctx context.Context, req *books.UpdateAuthorReq) (*books.Author, error) {
+	res, err := c.authorUseCase.UpdateAuthor(ctx, MapProtoUpdateAuthorReqToDomainAuthor(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainAuthorToProtoAuthor(res), nil
+}
+
+func (c *AuthorController) DeleteAuthor(ctx context.Context, req *books.DeleteAuthorReq) (*emptypb.Empty, error) {
+	if err := c.authorUseCase.DeleteAuthor(ctx, MapProtoDeleteAuthorReqToDomainAuthor(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AuthorBooks lists the books an author is credited with.
+func (c *AuthorController) AuthorBooks(ctx context.Context, req *books.AuthorBooksReq) (*books.BookPage, error) {
+	res, err := c.bookUseCase.AuthorBooks(ctx, MapProtoAuthorBooksReqToDomainAuthor(req), MapProtoBookFilterToDomainBookFilter(req.GetFilter()), MapProtoListQueryToListQuery(req.GetList()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookPageToProtoBookPage(res), nil
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/listquery"
+)
+
+func MapDomainAuthorToProtoAuthor(author domain.Author) *books.Author {
+	return &books.Author{
+		Id:        int32(author.ID),
+		Name:      author.Name,
+		BookCount: int32(author.BookCount),
+		CreatedAt: mapTime(author.CreatedAt),
+	}
+}
+
+func MapDomainAuthorPageToProtoAuthorPage(page listquery.Page[domain.Author]) *books.AuthorPage {
+	res := &books.AuthorPage{
+		Total:      int32(page.Total),
+		NextCursor: page.NextCursor,
+	}
+	for _, author := range page.Items {
+		res.Authors = append(res.Authors, MapDomainAuthorToProtoAuthor(author))
+	}
+	return res
+}
+
+func MapProtoAddAuthorReqToDomainAuthor(req *books.AddAuthorReq) domain.Author {
+	return domain.Author{
+		Name: req.GetName(),
+	}
+}
+
+func MapProtoListAuthorsReqToDomainAuthorFilter(req *books.ListAuthorsReq) domain.AuthorFilter {
+	return domain.AuthorFilter{
+		Name: req.GetName(),
+	}
+}
+
+func MapProtoGetAuthorReqToDomainAuthor(req *books.GetAuthorReq) domain.Author {
+	return domain.Author{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoUpdateAuthorReqToDomainAuthor(req *books.UpdateAuthorReq) domain.Author {
+	return domain.Author{
+		ID:   uint(req.GetId()),
+		Name: req.GetName(),
+	}
+}
+
+func MapProtoDeleteAuthorReqToDomainAuthor(req *books.DeleteAuthorReq) domain.Author {
+	return domain.Author{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoAuthorBooksReqToDomainAuthor(req *books.AuthorBooksReq) domain.Author {
+	return domain.Author{
+		ID: uint(req.GetId()),
+	}
+}
@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/errorhandler"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type BookController struct {
+	books.UnimplementedBooksServiceServer
+	bookUseCase *usecase.BookUseCase
+}
+
+func NewBookController() *BookController {
+	return &BookController{
+		bookUseCase: usecase.NewBookUseCase(),
+	}
+}
+
+func (c *BookController) AddBook(ctx context.Context, req *books.AddBookReq) (*books.Book, error) {
+	res, err := c.bookUseCase.AddBook(ctx, MapProtoAddBookReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookToProtoBook(res), nil
+}
+
+func (c *BookController) GetBook(ctx context.Context, req *books.GetBookReq) (*books.Book, error) {
+	res, err := c.bookUseCase.GetBook(ctx, MapProtoGetBookReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookToProtoBook(res), nil
+}
+
+// GetBookByISBN looks a book up by its ISBN-10 or ISBN-13, with or without hyphens.
+func (c *BookController) GetBookByISBN(ctx context.Context, req *books.GetBookByISBNReq) (*books.Book, error) {
+	res, err := c.bookUseCase.GetBookByISBN(ctx, req.GetIsbn())
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookToProtoBook(res), nil
+}
+
+func (c *BookController) ListBooks(ctx context.Context, req *books.ListBooksReq) (*books.BookPage, error) {
+	res, err := c.bookUseCase.GetBooks(ctx, MapProtoBookFilterToDomainBookFilter(req.GetFilter()), MapProtoListQueryToListQuery(req.GetList()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookPageToProtoBookPage(res), nil
+}
+
+func (c *BookController) UpdateBook(ctx context.Context, req *books.UpdateBookReq) (*books.Book, error) {
+	res, err := c.bookUseCase.UpdateBook(ctx, MapProtoUpdateBookReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookToProtoBook(res), nil
+}
+
+// UpdateBookTags replaces the tags of a book. Tags named for the first time are created.
+func (c *BookController) UpdateBookTags(ctx context.Context, req *books.UpdateBookTagsReq) (*books.Book, error) {
+	res, err := c.bookUseCase.UpdateBookTags(ctx, MapProtoUpdateBookTagsReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookToProtoBook(res), nil
+}
+
+func (c *BookController) DeleteBook(ctx context.Context, req *books.DeleteBookReq) (*emptypb.Empty, error) {
+	if err := c.bookUseCase.DeleteBook(ctx, MapProtoDeleteBookReqToDomainBook(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *BookController) SearchBooks(ctx context.Context, req *books.SearchBooksReq) (*books.SearchBooksRes, error) {
+	res, facets, err := c.bookUseCase.SearchBooks(ctx, MapProtoBookSearchToDomainBookSearch(req.GetSearch()), MapProtoBookFilterToDomainBookFilter(req.GetFilter()), MapProtoListQueryToListQuery(req.GetList()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookMatchPageToProtoSearchBooksRes(res, facets), nil
+}
+
+// CategoryBooks lists the books of a subject, genre or tag.
+func (c *BookController) CategoryBooks(ctx context.Context, req *books.CategoryBooksReq) (*books.BookPage, error) {
+	if req.GetType() != "subject" && req.GetType() != "genre" && req.GetType() != "tag" {
+		return nil, errorhandler.ErrInvalidCategoryType
+	}
+	if req.GetValue() == "" {
+		return nil, errorhandler.ErrEmptyCategoryValue
+	}
+
+	res, err := c.bookUseCase.CategoryBooks(ctx, MapProtoCategoryBooksReqToDomainBook(req), MapProtoBookFilterToDomainBookFilter(req.GetFilter()), MapProtoListQueryToListQuery(req.GetList()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookPageToProtoBookPage(res), nil
+}
+
+func (c *BookController) AvailableBooks(ctx context.Context, req *books.ListBooksReq) (*books.BookPage, error) {
+	res, err := c.bookUseCase.AvailableBooks(ctx, MapProtoBookFilterToDomainBookFilter(req.GetFilter()), MapProtoListQueryToListQuery(req.GetList()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookPageToProtoBookPage(res), nil
+}
+
+// SuggestBooks completes a typed prefix with titles and authors.
+func (c *BookController) SuggestBooks(ctx context.Context, req *books.SuggestBooksReq) (*books.SuggestBooksRes, error) {
+	res, err := c.bookUseCase.SuggestBooks(ctx, req.GetPrefix(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainSuggestionsToProtoSuggestBooksRes(res), nil
+}
+
+func (c *BookController) BorrowBook(ctx context.Context, req *books.BorrowBookReq) (*books.Loan, error) {
+	res, err := c.bookUseCase.BorrowBook(ctx, MapProtoBorrowBookReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainLoanToProtoLoan(res), nil
+}
+
+func (c *BookController) ReturnBook(ctx context.Context, req *books.ReturnBookReq) (*books.Loan, error) {
+	res, err := c.bookUseCase.ReturnBook(ctx, MapProtoReturnBookReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainLoanToProtoLoan(res), nil
+}
+
+func (c *BookController) RenewBook(ctx context.Context, req *books.RenewBookReq) (*books.Loan, error) {
+	res, err := c.bookUseCase.RenewBook(ctx, MapProtoRenewBookReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainLoanToProtoLoan(res), nil
+}
+
+// GetAvailability reports whether a book has a copy on the shelf.
+func (c *BookController) GetAvailability(ctx context.Context, req *books.GetAvailabilityReq) (*books.Availability, error) {
+	res, err := c.bookUseCase.GetBook(ctx, MapProtoGetAvailabilityReqToDomainBook(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainBookToProtoAvailability(res), nil
+}
@@ -0,0 +1,275 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/listquery"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mapTime leaves the timestamp unset for the zero time, such as the return time of an active loan.
+func mapTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func MapProtoGetBookReqToDomainBook(req *books.GetBookReq) domain.Book {
+	return domain.Book{
+		ID: uint(req.GetId()),
+	}
+}
+
+// MapProtoBookInputToDomainBook maps the fields of a book set when adding or updating it. A zero
+// work ID leaves the book without a work.
+func MapProtoBookInputToDomainBook(in *books.BookInput) domain.Book {
+	book := domain.Book{
+		Title:         in.GetTitle(),
+		Author:        in.GetAuthor(),
+		Category:      in.GetCategory(),
+		Subject:       in.GetSubject(),
+		Genre:         in.GetGenre(),
+		PublishedYear: uint(in.GetPublishedYear()),
+		ISBN10:        in.GetIsbn_10(),
+		ISBN13:        in.GetIsbn_13(),
+		Edition:       in.GetEdition(),
+		Language:      in.GetLanguage(),
+		Format:        domain.BookFormat(in.GetFormat()),
+	}
+	for _, id := range in.GetAuthorIds() {
+		book.Authors = append(book.Authors, domain.Author{ID: uint(id)})
+	}
+	if in.GetWorkId() != 0 {
+		book.Work = &domain.Work{ID: uint(in.GetWorkId())}
+	}
+	if in.GetSeries() != nil {
+		book.Series = &domain.Series{ID: uint(in.GetSeries().GetId()), Title: in.GetSeries().GetTitle()}
+		book.SeriesVolume = uint(in.GetSeries().GetVolume())
+	}
+	return book
+}
+
+func MapProtoAddBookReqToDomainBook(req *books.AddBookReq) domain.Book {
+	return MapProtoBookInputToDomainBook(req.GetBook())
+}
+
+func MapProtoUpdateBookReqToDomainBook(req *books.UpdateBookReq) domain.Book {
+	book := MapProtoBookInputToDomainBook(req.GetBook())
+	book.ID = uint(req.GetId())
+	return book
+}
+
+func MapProtoUpdateBookTagsReqToDomainBook(req *books.UpdateBookTagsReq) domain.Book {
+	var tags []domain.Tag
+	for _, name := range req.GetTags() {
+		tags = append(tags, domain.Tag{Name: name})
+	}
+	return domain.Book{
+		ID:   uint(req.GetId()),
+		Tags: tags,
+	}
+}
+
+func MapProtoDeleteBookReqToDomainBook(req *books.DeleteBookReq) domain.Book {
+	return domain.Book{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoBorrowBookReqToDomainBook(req *books.BorrowBookReq) domain.Book {
+	return domain.Book{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoReturnBookReqToDomainBook(req *books.ReturnBookReq) domain.Book {
+	return domain.Book{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoRenewBookReqToDomainBook(req *books.RenewBookReq) domain.Book {
+	return domain.Book{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoCategoryBooksReqToDomainBook(req *books.CategoryBooksReq) domain.Book {
+	if req.GetType() == "subject" {
+		return domain.Book{
+			Subject: req.GetValue(),
+		}
+	}
+	if req.GetType() == "tag" {
+		return domain.Book{
+			Tags: []domain.Tag{{Name: req.GetValue()}},
+		}
+	}
+	return domain.Book{
+		Genre: req.GetValue(),
+	}
+}
+
+func MapProtoGetAvailabilityReqToDomainBook(req *books.GetAvailabilityReq) domain.Book {
+	return domain.Book{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoBookFilterToDomainBookFilter(filter *books.BookFilter) domain.BookFilter {
+	res := domain.BookFilter{
+		YearFrom: uint(filter.GetYearFrom()),
+		YearTo:   uint(filter.GetYearTo()),
+		Author:   filter.GetAuthor(),
+		Category: filter.GetCategory(),
+		Subject:  filter.GetSubject(),
+		Genre:    filter.GetGenre(),
+		Tags:     filter.GetTags(),
+	}
+	if filter.GetAvailable() != nil {
+		available := filter.GetAvailable().GetValue()
+		res.Available = &available
+	}
+	return res
+}
+
+func MapProtoListQueryToListQuery(query *books.ListQuery) listquery.Query {
+	return listquery.Query{
+		Limit:  int(query.GetLimit()),
+		Page:   int(query.GetPage()),
+		Cursor: query.GetCursor(),
+		Sort:   query.GetSort(),
+		Order:  listquery.Order(query.GetOrder()),
+	}
+}
+
+func MapProtoBookSearchToDomainBookSearch(search *books.BookSearch) domain.BookSearch {
+	return domain.BookSearch{
+		Query:    search.GetQuery(),
+		Title:    search.GetTitle(),
+		Author:   search.GetAuthor(),
+		Category: search.GetCategory(),
+	}
+}
+
+func MapDomainBookToProtoBook(book domain.Book) *books.Book {
+	res := &books.Book{
+		Id:              int32(book.ID),
+		Title:           book.Title,
+		Author:          book.Author,
+		Category:        book.Category,
+		Subject:         book.Subject,
+		Genre:           book.Genre,
+		PublishedYear:   int32(book.PublishedYear),
+		Isbn_10:         book.ISBN10,
+		Isbn_13:         book.ISBN13,
+		Edition:         book.Edition,
+		Language:        book.Language,
+		Format:          string(book.Format),
+		TotalCopies:     int32(book.TotalCopies),
+		AvailableCopies: int32(book.AvailableCopies),
+		CreatedAt:       mapTime(book.CreatedAt),
+		UpdatedAt:       mapTime(book.UpdatedAt),
+	}
+	for _, author := range book.Authors {
+		res.Authors = append(res.Authors, &books.BookAuthor{Id: int32(author.ID), Name: author.Name})
+	}
+	for _, tag := range book.Tags {
+		res.Tags = append(res.Tags, &books.BookTag{Id: int32(tag.ID), Name: tag.Name})
+	}
+	if book.Work != nil {
+		res.Work = &books.BookWork{Id: int32(book.Work.ID), Title: book.Work.Title}
+	}
+	if book.Series != nil {
+		res.Series = &books.BookSeries{Id: int32(book.Series.ID), Title: book.Series.Title, Volume: int32(book.SeriesVolume)}
+	}
+	if book.Cover != nil {
+		res.Cover = &books.BookCover{
+			Key:          book.Cover.Key,
+			ThumbnailKey: book.Cover.ThumbnailKey,
+			ContentType:  book.Cover.ContentType,
+			UpdatedAt:    mapTime(book.Cover.UpdatedAt),
+		}
+	}
+	return res
+}
+
+func MapDomainBookPageToProtoBookPage(page listquery.Page[domain.Book]) *books.BookPage {
+	res := &books.BookPage{
+		Total:      int32(page.Total),
+		NextCursor: page.NextCursor,
+	}
+	for _, book := range page.Items {
+		res.Books = append(res.Books, MapDomainBookToProtoBook(book))
+	}
+	return res
+}
+
+func MapDomainFacetsToProtoFacets(facets []domain.Facet) []*books.Facet {
+	var res []*books.Facet
+	for _, facet := range facets {
+		res = append(res, &books.Facet{Value: facet.Value, Count: int32(facet.Count)})
+	}
+	return res
+}
+
+func MapDomainBookMatchPageToProtoSearchBooksRes(page listquery.Page[domain.BookMatch], facets domain.BookFacets) *books.SearchBooksRes {
+	res := &books.SearchBooksRes{
+		Total:      int32(page.Total),
+		NextCursor: page.NextCursor,
+		Facets: &books.BookFacets{
+			Genres:       MapDomainFacetsToProtoFacets(facets.Genres),
+			Subjects:     MapDomainFacetsToProtoFacets(facets.Subjects),
+			Tags:         MapDomainFacetsToProtoFacets(facets.Tags),
+			Decades:      MapDomainFacetsToProtoFacets(facets.Decades),
+			Availability: MapDomainFacetsToProtoFacets(facets.Availability),
+		},
+	}
+	for _, match := range page.Items {
+		res.Matches = append(res.Matches, &books.BookMatch{
+			Book:      MapDomainBookToProtoBook(match.Book),
+			Score:     match.Score,
+			Highlight: match.Highlight,
+		})
+	}
+	return res
+}
+
+func MapDomainLoanToProtoLoan(loan domain.Loan) *books.Loan {
+	return &books.Loan{
+		Id:         int32(loan.ID),
+		CopyId:     int32(loan.CopyID),
+		BookId:     int32(loan.BookID),
+		UserId:     int32(loan.UserID),
+		BorrowedAt: mapTime(loan.BorrowedAt),
+		DueAt:      mapTime(loan.DueAt),
+		ReturnedAt: mapTime(loan.ReturnedAt),
+		BorrowedBy: int32(loan.BorrowedBy),
+		ReturnedBy: int32(loan.ReturnedBy),
+		Renewals:   int32(loan.Renewals),
+	}
+}
+
+func MapDomainBookToProtoAvailability(book domain.Book) *books.Availability {
+	return &books.Availability{
+		BookId:          int32(book.ID),
+		TotalCopies:     int32(book.TotalCopies),
+		AvailableCopies: int32(book.AvailableCopies),
+		Available:       book.AvailableCopies > 0,
+	}
+}
+
+func MapDomainSuggestionsToProtoSuggestBooksRes(suggestions []domain.Suggestion) *books.SuggestBooksRes {
+	res := &books.SuggestBooksRes{}
+	for _, suggestion := range suggestions {
+		res.Suggestions = append(res.Suggestions, &books.Suggestion{
+			Field: string(suggestion.Field),
+			Value: suggestion.Value,
+			Count: int32(suggestion.Count),
+		})
+	}
+	return res
+}
@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"io"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/books-service/pkg/marc"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"strings"
+)
+
+type CatalogueController struct {
+	books.UnimplementedCatalogueServiceServer
+	importUseCase *usecase.ImportUseCase
+	exportUseCase *usecase.ExportUseCase
+}
+
+func NewCatalogueController() *CatalogueController {
+	return &CatalogueController{
+		importUseCase: usecase.NewImportUseCase(),
+		exportUseCase: usecase.NewExportUseCase(),
+	}
+}
+
+// importFile reads the head of an import upload and returns it with the file that follows,
+// cut off at the size limit of an import.
+func (c *CatalogueController) importFile(stream books.CatalogueService_ImportBooksServer) (*books.ImportHead, io.Reader, error) {
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, nil, err
+	}
+	head := first.GetHead()
+	if head == nil {
+		return nil, nil, errorhandler.ErrInvalidImportFile
+	}
+	file := &chunkReader{next: func() ([]byte, error) {
+		req, err := stream.Recv()
+		return req.GetData(), err
+	}}
+	return head, http.MaxBytesReader(nil, io.NopCloser(file), c.importUseCase.Limits().MaxBytes), nil
+}
+
+// ImportBooks adds a CSV or JSON Lines file of books to the catalogue
+func (c *CatalogueController) ImportBooks(stream books.CatalogueService_ImportBooksServer) error {
+	head, file, err := c.importFile(stream)
+	if err != nil {
+		return err
+	}
+
+	maxRows := c.importUseCase.Limits().MaxRows
+	var rows []domain.ImportRow
+	switch strings.ToLower(head.GetFormat()) {
+	case "csv":
+		rows, err = MapCsvToDomainImportRows(file, maxRows)
+	case "ndjson":
+		rows, err = MapNdjsonToDomainImportRows(file, maxRows)
+	default:
+		err = errorhandler.ErrInvalidImportFormat
+	}
+	if err != nil {
+		return err
+	}
+
+	report, err := c.importUseCase.ImportBooks(stream.Context(), rows, head.GetDryRun())
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(MapDomainImportReportToProtoImportReport(report))
+}
+
+// ImportMarc adds a file of MARC records to the catalogue
+func (c *CatalogueController) ImportMarc(stream books.CatalogueService_ImportMarcServer) error {
+	head, file, err := c.importFile(stream)
+	if err != nil {
+		return err
+	}
+	format, err := marc.ParseFormat(head.GetFormat())
+	if err != nil {
+		return err
+	}
+
+	decoder := marc.NewMARCXMLDecoder(file)
+	if format == marc.FormatISO2709 {
+		decoder = marc.NewISO2709Decoder(file)
+	}
+	rows, err := MapMarcToDomainImportRows(decoder, c.importUseCase.Limits().MaxRows)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.importUseCase.ImportBooks(stream.Context(), rows, head.GetDryRun())
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(MapDomainImportReportToProtoImportReport(report))
+}
+
+// export streams the catalogue in the given format. Nothing is sent before the first book is
+// encoded, so that failures before that, such as an invalid session, end the call with a status
+// of their own.
+func (c *CatalogueController) export(stream books.CatalogueService_ExportBooksServer, format exportFormat) error {
+	file := &fileWriter{stream: stream, contentType: format.contentType, filename: format.filename}
+	encoder := format.newEncoder(file)
+	err := c.exportUseCase.ExportBooks(stream.Context(), encoder.Encode)
+	if err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	return file.Flush()
+}
+
+// ExportBooks streams the whole catalogue as CSV, JSON Lines or Dublin Core XML, in JSON Lines
+// unless another format is asked for
+func (c *CatalogueController) ExportBooks(req *books.ExportReq, stream books.CatalogueService_ExportBooksServer) error {
+	name := req.GetFormat()
+	if name == "" {
+		name = "ndjson"
+	}
+	format, err := parseExportFormat(name)
+	if err != nil {
+		return err
+	}
+	return c.export(stream, format)
+}
+
+// ExportMarc streams the whole catalogue as MARC records, in MARCXML unless binary ISO 2709 is
+// asked for
+func (c *CatalogueController) ExportMarc(req *books.ExportReq, stream books.CatalogueService_ExportMarcServer) error {
+	name := req.GetFormat()
+	if name == "" {
+		name = string(marc.FormatMARCXML)
+	}
+	format, err := marc.ParseFormat(name)
+	if err != nil {
+		return err
+	}
+	return c.export(stream, marcExportFormats[format])
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CopyController struct {
+	books.UnimplementedCopiesServiceServer
+	copyUseCase *usecase.CopyUseCase
+}
+
+func NewCopyController() *CopyController {
+	return &CopyController{
+		copyUseCase: usecase.NewCopyUseCase(),
+	}
+}
+
+func (c *CopyController) AddCopy(ctx context.Context, req *books.AddCopyReq) (*books.Copy, error) {
+	res, err := c.copyUseCase.AddCopy(ctx, MapProtoAddCopyReqToDomainCopy(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainCopyToProtoCopy(res), nil
+}
+
+// ListCopies lists the copies of a book.
+func (c *CopyController) ListCopies(ctx context.Context, req *books.ListCopiesReq) (*books.CopyList, error) {
+	res, err := c.copyUseCase.GetCopies(ctx, MapProtoListCopiesReqToDomainCopy(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainCopiesToProtoCopyList(res), nil
+}
+
+func (c *CopyController) UpdateCopy(ctx context.Context, req *books.UpdateCopyReq) (*books.Copy, error) {
+	res, err := c.copyUseCase.UpdateCopy(ctx, MapProtoUpdateCopyReqToDomainCopy(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainCopyToProtoCopy(res), nil
+}
+
+func (c *CopyController) DeleteCopy(ctx context.Context, req *books.DeleteCopyReq) (*emptypb.Empty, error) {
+	if err := c.copyUseCase.DeleteCopy(ctx, MapProtoDeleteCopyReqToDomainCopy(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
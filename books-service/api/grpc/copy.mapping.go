@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainCopyToProtoCopy(bookCopy domain.Copy) *books.Copy {
+	return &books.Copy{
+		Id:            int32(bookCopy.ID),
+		BookId:        int32(bookCopy.BookID),
+		Barcode:       bookCopy.Barcode,
+		ShelfLocation: bookCopy.ShelfLocation,
+		Status:        string(bookCopy.Status),
+		BorrowerId:    int32(bookCopy.BorrowerID),
+		CreatedAt:     mapTime(bookCopy.CreatedAt),
+	}
+}
+
+func MapDomainCopiesToProtoCopyList(copies []domain.Copy) *books.CopyList {
+	res := &books.CopyList{}
+	for _, bookCopy := range copies {
+		res.Copies = append(res.Copies, MapDomainCopyToProtoCopy(bookCopy))
+	}
+	return res
+}
+
+func MapProtoAddCopyReqToDomainCopy(req *books.AddCopyReq) domain.Copy {
+	return domain.Copy{
+		BookID:        uint(req.GetBookId()),
+		Barcode:       req.GetBarcode(),
+		ShelfLocation: req.GetShelfLocation(),
+		Status:        domain.CopyStatus(req.GetStatus()),
+	}
+}
+
+func MapProtoListCopiesReqToDomainCopy(req *books.ListCopiesReq) domain.Copy {
+	return domain.Copy{
+		BookID: uint(req.GetBookId()),
+	}
+}
+
+func MapProtoUpdateCopyReqToDomainCopy(req *books.UpdateCopyReq) domain.Copy {
+	return domain.Copy{
+		ID:            uint(req.GetId()),
+		Barcode:       req.GetBarcode(),
+		ShelfLocation: req.GetShelfLocation(),
+		Status:        domain.CopyStatus(req.GetStatus()),
+	}
+}
+
+func MapProtoDeleteCopyReqToDomainCopy(req *books.DeleteCopyReq) domain.Copy {
+	return domain.Copy{
+		ID: uint(req.GetId()),
+	}
+}
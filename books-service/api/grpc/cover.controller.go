@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/errorhandler"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CoverController struct {
+	books.UnimplementedCoversServiceServer
+	coverUseCase *usecase.CoverUseCase
+}
+
+func NewCoverController() *CoverController {
+	return &CoverController{
+		coverUseCase: usecase.NewCoverUseCase(),
+	}
+}
+
+// UpdateBookCover stores the cover image streamed after the ID of the book. The image type is
+// taken from its content.
+func (c *CoverController) UpdateBookCover(stream books.CoversService_UpdateBookCoverServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	id, ok := first.GetPart().(*books.UpdateBookCoverReq_Id)
+	if !ok {
+		return errorhandler.ErrInvalidCover
+	}
+
+	res, err := c.coverUseCase.UpdateBookCover(stream.Context(), MapProtoUpdateBookCoverReqToDomainBook(id), &chunkReader{next: func() ([]byte, error) {
+		req, err := stream.Recv()
+		return req.GetData(), err
+	}})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(MapDomainBookToProtoBook(res))
+}
+
+func (c *CoverController) DeleteBookCover(ctx context.Context, req *books.DeleteBookCoverReq) (*emptypb.Empty, error) {
+	if err := c.coverUseCase.DeleteBookCover(ctx, MapProtoDeleteBookCoverReqToDomainBook(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetBookCover streams the cover image of a book, or its thumbnail with size thumbnail. The
+// first chunk describes the image.
+func (c *CoverController) GetBookCover(req *books.GetBookCoverReq, stream books.CoversService_GetBookCoverServer) error {
+	book, size := MapProtoGetBookCoverReqToDomainBook(req)
+	coverImage, err := c.coverUseCase.GetBookCover(stream.Context(), book, size)
+	if err != nil {
+		return err
+	}
+
+	chunk := MapDomainCoverImageToProtoCoverChunk(coverImage)
+	data := coverImage.Data
+	for {
+		n := min(len(data), streamChunkSize)
+		chunk.Data = data[:n]
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			return nil
+		}
+		chunk = &books.CoverChunk{}
+	}
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapProtoUpdateBookCoverReqToDomainBook(req *books.UpdateBookCoverReq_Id) domain.Book {
+	return domain.Book{
+		ID: uint(req.Id),
+	}
+}
+
+func MapProtoDeleteBookCoverReqToDomainBook(req *books.DeleteBookCoverReq) domain.Book {
+	return domain.Book{
+		ID: uint(req.GetId()),
+	}
+}
+
+func MapProtoGetBookCoverReqToDomainBook(req *books.GetBookCoverReq) (domain.Book, domain.CoverSize) {
+	size := domain.CoverSizeOriginal
+	if req.GetSize() != "" {
+		size = domain.CoverSize(req.GetSize())
+	}
+	return domain.Book{ID: uint(req.GetId())}, size
+}
+
+// MapDomainCoverImageToProtoCoverChunk describes the image in the first chunk of a download.
+func MapDomainCoverImageToProtoCoverChunk(image domain.CoverImage) *books.CoverChunk {
+	return &books.CoverChunk{
+		Key:         image.Key,
+		ContentType: image.ContentType,
+		UpdatedAt:   mapTime(image.UpdatedAt),
+	}
+}
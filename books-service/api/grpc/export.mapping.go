@@ -0,0 +1,190 @@
+package grpc
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/dublincore"
+	"library-management-api/books-service/pkg/marc"
+	"library-management-api/pkg/dto"
+	"library-management-api/util/errorhandler"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MapDomainBookToDtoBookRes maps a book to the JSON object a JSON Lines export holds, the one the
+// gateway answers book requests with.
+func MapDomainBookToDtoBookRes(book domain.Book) dto.BookRes {
+	res := dto.BookRes{
+		ID:              book.ID,
+		Title:           book.Title,
+		Author:          book.Author,
+		Authors:         []dto.BookAuthorRes{},
+		Tags:            []dto.BookTagRes{},
+		Category:        book.Category,
+		Subject:         book.Subject,
+		Genre:           book.Genre,
+		PublishedYear:   book.PublishedYear,
+		ISBN10:          book.ISBN10,
+		ISBN13:          book.ISBN13,
+		Edition:         book.Edition,
+		Language:        book.Language,
+		Format:          string(book.Format),
+		TotalCopies:     book.TotalCopies,
+		AvailableCopies: book.AvailableCopies,
+		CreatedAt:       book.CreatedAt,
+		UpdatedAt:       book.UpdatedAt,
+	}
+	for _, author := range book.Authors {
+		res.Authors = append(res.Authors, dto.BookAuthorRes{ID: author.ID, Name: author.Name})
+	}
+	for _, tag := range book.Tags {
+		res.Tags = append(res.Tags, dto.BookTagRes{ID: tag.ID, Name: tag.Name})
+	}
+	if book.Work != nil {
+		res.Work = &dto.BookWorkRes{ID: book.Work.ID, Title: book.Work.Title}
+	}
+	if book.Series != nil {
+		res.Series = &dto.BookSeriesRes{ID: book.Series.ID, Title: book.Series.Title, Volume: book.SeriesVolume}
+	}
+	if book.Cover != nil {
+		res.CoverURL = dto.CoverURL(book.ID, book.Cover.UpdatedAt, string(domain.CoverSizeOriginal))
+		res.CoverThumbURL = dto.CoverURL(book.ID, book.Cover.UpdatedAt, string(domain.CoverSizeThumbnail))
+	}
+	return res
+}
+
+// bookCsvHeader names the columns of a CSV export. The import accepts the same header and
+// ignores the read-only columns.
+var bookCsvHeader = []string{"id", "title", "author", "category", "subject", "genre", "published_year", "isbn_10", "isbn_13", "total_copies", "available_copies", "created_at", "updated_at"}
+
+func MapDomainBookToCsvRecord(book domain.Book) []string {
+	return []string{
+		strconv.FormatUint(uint64(book.ID), 10),
+		book.Title,
+		book.Author,
+		book.Category,
+		book.Subject,
+		book.Genre,
+		strconv.FormatUint(uint64(book.PublishedYear), 10),
+		book.ISBN10,
+		book.ISBN13,
+		strconv.FormatUint(uint64(book.TotalCopies), 10),
+		strconv.FormatUint(uint64(book.AvailableCopies), 10),
+		book.CreatedAt.Format(time.RFC3339),
+		book.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// bookEncoder writes books in one of the export formats. Close completes the output and must
+// be called once every book is written.
+type bookEncoder interface {
+	Encode(book domain.Book) error
+	Close() error
+}
+
+// exportFormat describes how an export is encoded and served.
+type exportFormat struct {
+	contentType string
+	filename    string
+	newEncoder  func(w io.Writer) bookEncoder
+}
+
+var exportFormats = map[string]exportFormat{
+	"csv": {"text/csv; charset=utf-8", "catalogue.csv", func(w io.Writer) bookEncoder {
+		return &csvBookEncoder{w: csv.NewWriter(w)}
+	}},
+	"ndjson": {"application/x-ndjson", "catalogue.ndjson", func(w io.Writer) bookEncoder {
+		buffered := bufio.NewWriter(w)
+		return &ndjsonBookEncoder{w: buffered, e: json.NewEncoder(buffered)}
+	}},
+	"dc": {"application/xml; charset=utf-8", "catalogue.dc.xml", func(w io.Writer) bookEncoder {
+		return &dcBookEncoder{e: dublincore.NewEncoder(w)}
+	}},
+}
+
+// marcExportFormats are served by the MARC export.
+var marcExportFormats = map[marc.Format]exportFormat{
+	marc.FormatMARCXML: {"application/marcxml+xml", "catalogue.xml", func(w io.Writer) bookEncoder {
+		return &marcBookEncoder{e: marc.NewMARCXMLEncoder(w)}
+	}},
+	marc.FormatISO2709: {"application/marc", "catalogue.mrc", func(w io.Writer) bookEncoder {
+		return &marcBookEncoder{e: marc.NewISO2709Encoder(w)}
+	}},
+}
+
+func parseExportFormat(name string) (exportFormat, error) {
+	format, ok := exportFormats[strings.ToLower(name)]
+	if !ok {
+		return exportFormat{}, errorhandler.ErrInvalidExportFormat
+	}
+	return format, nil
+}
+
+type csvBookEncoder struct {
+	w       *csv.Writer
+	started bool
+}
+
+func (e *csvBookEncoder) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+	return e.w.Write(bookCsvHeader)
+}
+
+func (e *csvBookEncoder) Encode(book domain.Book) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	return e.w.Write(MapDomainBookToCsvRecord(book))
+}
+
+func (e *csvBookEncoder) Close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonBookEncoder struct {
+	w *bufio.Writer
+	e *json.Encoder
+}
+
+func (e *ndjsonBookEncoder) Encode(book domain.Book) error {
+	return e.e.Encode(MapDomainBookToDtoBookRes(book))
+}
+
+func (e *ndjsonBookEncoder) Close() error {
+	return e.w.Flush()
+}
+
+type dcBookEncoder struct {
+	e *dublincore.Encoder
+}
+
+func (e *dcBookEncoder) Encode(book domain.Book) error {
+	return e.e.Encode(dublincore.FromBook(book))
+}
+
+func (e *dcBookEncoder) Close() error {
+	return e.e.Close()
+}
+
+type marcBookEncoder struct {
+	e marc.Encoder
+}
+
+func (e *marcBookEncoder) Encode(book domain.Book) error {
+	return e.e.Encode(marc.FromBook(book))
+}
+
+func (e *marcBookEncoder) Close() error {
+	return e.e.Close()
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+)
+
+type FineController struct {
+	books.UnimplementedFinesServiceServer
+	fineUseCase *usecase.FineUseCase
+}
+
+func NewFineController() *FineController {
+	return &FineController{
+		fineUseCase: usecase.NewFineUseCase(),
+	}
+}
+
+// GetMyFines returns the fine balance of the caller.
+func (c *FineController) GetMyFines(ctx context.Context, req *books.GetMyFinesReq) (*books.FineBalance, error) {
+	res, err := c.fineUseCase.GetMyFines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainFineBalanceToProtoFineBalance(res), nil
+}
+
+func (c *FineController) GetUserFines(ctx context.Context, req *books.GetUserFinesReq) (*books.FineBalance, error) {
+	res, err := c.fineUseCase.GetUserFines(ctx, MapProtoGetUserFinesReqToDomainFine(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainFineBalanceToProtoFineBalance(res), nil
+}
+
+// RecordPayment records a payment made by a patron.
+func (c *FineController) RecordPayment(ctx context.Context, req *books.SettleFineReq) (*books.Fine, error) {
+	res, err := c.fineUseCase.RecordPayment(ctx, MapProtoSettleFineReqToDomainFine(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainFineToProtoFine(res), nil
+}
+
+// WaiveFine forgives part or all of the balance of a patron.
+func (c *FineController) WaiveFine(ctx context.Context, req *books.SettleFineReq) (*books.Fine, error) {
+	res, err := c.fineUseCase.WaiveFine(ctx, MapProtoSettleFineReqToDomainFine(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainFineToProtoFine(res), nil
+}
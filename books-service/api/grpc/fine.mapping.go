@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainFineToProtoFine(fine domain.Fine) *books.Fine {
+	return &books.Fine{
+		Id:          int32(fine.ID),
+		UserId:      int32(fine.UserID),
+		LoanId:      int32(fine.LoanID),
+		Kind:        string(fine.Kind),
+		AmountCents: fine.AmountCents,
+		Note:        fine.Note,
+		CreatedBy:   int32(fine.CreatedBy),
+		CreatedAt:   mapTime(fine.CreatedAt),
+	}
+}
+
+func MapDomainFineBalanceToProtoFineBalance(balance domain.FineBalance) *books.FineBalance {
+	res := &books.FineBalance{
+		UserId:           int32(balance.UserID),
+		ChargedCents:     balance.ChargedCents,
+		PaidCents:        balance.PaidCents,
+		WaivedCents:      balance.WaivedCents,
+		OutstandingCents: balance.OutstandingCents(),
+	}
+	for _, fine := range balance.Entries {
+		res.Entries = append(res.Entries, MapDomainFineToProtoFine(fine))
+	}
+	return res
+}
+
+func MapProtoGetUserFinesReqToDomainFine(req *books.GetUserFinesReq) domain.Fine {
+	return domain.Fine{
+		UserID: uint(req.GetUserId()),
+	}
+}
+
+func MapProtoSettleFineReqToDomainFine(req *books.SettleFineReq) domain.Fine {
+	return domain.Fine{
+		UserID:      uint(req.GetUserId()),
+		AmountCents: req.GetAmountCents(),
+		Note:        req.GetNote(),
+	}
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type GenreController struct {
+	books.UnimplementedGenresServiceServer
+	genreUseCase *usecase.GenreUseCase
+}
+
+func NewGenreController() *GenreController {
+	return &GenreController{
+		genreUseCase: usecase.NewGenreUseCase(),
+	}
+}
+
+func (c *GenreController) AddGenre(ctx context.Context, req *books.AddGenreReq) (*books.Genre, error) {
+	res, err := c.genreUseCase.AddGenre(ctx, MapProtoAddGenreReqToDomainGenre(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainGenreToProtoGenre(res), nil
+}
+
+func (c *GenreController) ListGenres(ctx context.Context, req *books.ListGenresReq) (*books.GenreList, error) {
+	res, err := c.genreUseCase.GetGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainGenresToProtoGenreList(res), nil
+}
+
+// UpdateGenre renames a genre and replaces its synonyms.
+func (c *GenreController) UpdateGenre(ctx context.Context, req *books.UpdateGenreReq) (*books.Genre, error) {
+	res, err := c.genreUseCase.UpdateGenre(ctx, MapProtoUpdateGenreReqToDomainGenre(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainGenreToProtoGenre(res), nil
+}
+
+func (c *GenreController) DeleteGenre(ctx context.Context, req *books.DeleteGenreReq) (*emptypb.Empty, error) {
+	if err := c.genreUseCase.DeleteGenre(ctx, MapProtoDeleteGenreReqToDomainGenre(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// MergeGenre folds a genre into another, which takes its books and its names as synonyms.
+func (c *GenreController) MergeGenre(ctx context.Context, req *books.MergeGenreReq) (*books.Genre, error) {
+	source, target := MapProtoMergeGenreReqToDomainGenres(req)
+	res, err := c.genreUseCase.MergeGenre(ctx, source, target)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainGenreToProtoGenre(res), nil
+}
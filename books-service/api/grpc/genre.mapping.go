@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainGenreToProtoGenre(genre domain.Genre) *books.Genre {
+	return &books.Genre{
+		Id:        int32(genre.ID),
+		Name:      genre.Name,
+		Synonyms:  genre.Synonyms,
+		BookCount: int32(genre.BookCount),
+		CreatedAt: mapTime(genre.CreatedAt),
+	}
+}
+
+func MapDomainGenresToProtoGenreList(genres []domain.Genre) *books.GenreList {
+	res := &books.GenreList{}
+	for _, genre := range genres {
+		res.Genres = append(res.Genres, MapDomainGenreToProtoGenre(genre))
+	}
+	return res
+}
+
+func MapProtoAddGenreReqToDomainGenre(req *books.AddGenreReq) domain.Genre {
+	return domain.Genre{
+		Name:     req.GetName(),
+		Synonyms: req.GetSynonyms(),
+	}
+}
+
+func MapProtoUpdateGenreReqToDomainGenre(req *books.UpdateGenreReq) domain.Genre {
+	return domain.Genre{
+		ID:       uint(req.GetId()),
+		Name:     req.GetName(),
+		Synonyms: req.GetSynonyms(),
+	}
+}
+
+func MapProtoDeleteGenreReqToDomainGenre(req *books.DeleteGenreReq) domain.Genre {
+	return domain.Genre{
+		ID: uint(req.GetId()),
+	}
+}
+
+// MapProtoMergeGenreReqToDomainGenres returns the source and the target of a merge.
+func MapProtoMergeGenreReqToDomainGenres(req *books.MergeGenreReq) (domain.Genre, domain.Genre) {
+	return domain.Genre{ID: uint(req.GetId())}, domain.Genre{ID: uint(req.GetIntoId())}
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+)
+
+type HoldController struct {
+	books.UnimplementedHoldsServiceServer
+	holdUseCase *usecase.HoldUseCase
+}
+
+func NewHoldController() *HoldController {
+	return &HoldController{
+		holdUseCase: usecase.NewHoldUseCase(),
+	}
+}
+
+// PlaceHold queues the caller for the next copy of a book that has none on the shelf.
+func (c *HoldController) PlaceHold(ctx context.Context, req *books.PlaceHoldReq) (*books.Hold, error) {
+	res, err := c.holdUseCase.PlaceHold(ctx, MapProtoPlaceHoldReqToDomainHold(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainHoldToProtoHold(res), nil
+}
+
+func (c *HoldController) ListMyHolds(ctx context.Context, req *books.ListMyHoldsReq) (*books.HoldList, error) {
+	res, err := c.holdUseCase.GetMyHolds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainHoldsToProtoHoldList(res), nil
+}
+
+// ListBookHolds lists the hold queue of a book in FIFO order.
+func (c *HoldController) ListBookHolds(ctx context.Context, req *books.ListBookHoldsReq) (*books.HoldList, error) {
+	res, err := c.holdUseCase.GetBookHolds(ctx, MapProtoListBookHoldsReqToDomainHold(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainHoldsToProtoHoldList(res), nil
+}
+
+func (c *HoldController) CancelHold(ctx context.Context, req *books.CancelHoldReq) (*books.Hold, error) {
+	res, err := c.holdUseCase.CancelHold(ctx, MapProtoCancelHoldReqToDomainHold(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainHoldToProtoHold(res), nil
+}
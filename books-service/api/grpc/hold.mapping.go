@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainHoldToProtoHold(hold domain.Hold) *books.Hold {
+	return &books.Hold{
+		Id:         int32(hold.ID),
+		BookId:     int32(hold.BookID),
+		UserId:     int32(hold.UserID),
+		CopyId:     int32(hold.CopyID),
+		AnyEdition: hold.AnyEdition,
+		Status:     string(hold.Status),
+		Position:   int32(hold.Position),
+		CreatedAt:  mapTime(hold.CreatedAt),
+		ReadyAt:    mapTime(hold.ReadyAt),
+		ExpiresAt:  mapTime(hold.ExpiresAt),
+	}
+}
+
+func MapDomainHoldsToProtoHoldList(holds []domain.Hold) *books.HoldList {
+	res := &books.HoldList{}
+	for _, hold := range holds {
+		res.Holds = append(res.Holds, MapDomainHoldToProtoHold(hold))
+	}
+	return res
+}
+
+func MapProtoPlaceHoldReqToDomainHold(req *books.PlaceHoldReq) domain.Hold {
+	return domain.Hold{
+		BookID:     uint(req.GetBookId()),
+		AnyEdition: req.GetAnyEdition(),
+	}
+}
+
+func MapProtoListBookHoldsReqToDomainHold(req *books.ListBookHoldsReq) domain.Hold {
+	return domain.Hold{
+		BookID: uint(req.GetBookId()),
+	}
+}
+
+func MapProtoCancelHoldReqToDomainHold(req *books.CancelHoldReq) domain.Hold {
+	return domain.Hold{
+		ID: uint(req.GetId()),
+	}
+}
@@ -0,0 +1,278 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/marc"
+	"library-management-api/pkg/dto"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+// maxImportLineSize bounds a single NDJSON record.
+const maxImportLineSize = 1 << 20
+
+func MapDomainImportRowToProtoImportRow(row domain.ImportRow) *books.ImportRow {
+	return &books.ImportRow{
+		Line:    int32(row.Line),
+		Status:  string(row.Status),
+		BookId:  int32(row.Book.ID),
+		Title:   row.Book.Title,
+		Isbn_13: row.Book.ISBN13,
+		Reason:  row.Reason,
+	}
+}
+
+func MapDomainImportReportToProtoImportReport(report domain.ImportReport) *books.ImportReport {
+	res := &books.ImportReport{
+		DryRun:  report.DryRun,
+		Created: int32(report.Created),
+		Skipped: int32(report.Skipped),
+		Failed:  int32(report.Failed),
+	}
+	for _, row := range report.Rows {
+		res.Rows = append(res.Rows, MapDomainImportRowToProtoImportRow(row))
+	}
+	return res
+}
+
+// MapDtoAddBookReqToDomainBook maps a decoded import record to the book it adds.
+func MapDtoAddBookReqToDomainBook(req dto.AddBookReq) domain.Book {
+	series, volume := mapDtoBookSeriesReqToDomainSeries(req.Series)
+	book := domain.Book{
+		Title:         req.Title,
+		Author:        req.Author,
+		Category:      req.Category,
+		Subject:       req.Subject,
+		Genre:         req.Genre,
+		PublishedYear: req.PublishedYear,
+		ISBN10:        req.ISBN10,
+		ISBN13:        req.ISBN13,
+		Edition:       req.Edition,
+		Language:      req.Language,
+		Format:        domain.BookFormat(req.Format),
+		Series:        series,
+		SeriesVolume:  volume,
+	}
+	for _, id := range req.AuthorIDs {
+		book.Authors = append(book.Authors, domain.Author{ID: id})
+	}
+	if req.WorkID != 0 {
+		book.Work = &domain.Work{ID: req.WorkID}
+	}
+	return book
+}
+
+func mapDtoBookSeriesReqToDomainSeries(req *dto.BookSeriesReq) (*domain.Series, uint) {
+	if req == nil {
+		return nil, 0
+	}
+	return &domain.Series{ID: req.ID, Title: req.Title}, req.Volume
+}
+
+// mapAddBookReqToDomainImportRow validates a decoded record with the rules of AddBookReq.
+func mapAddBookReqToDomainImportRow(line uint, req dto.AddBookReq) domain.ImportRow {
+	row := domain.ImportRow{
+		Line:   line,
+		Book:   MapDtoAddBookReqToDomainBook(req),
+		Status: domain.ImportStatusPending,
+	}
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		row.Status = domain.ImportStatusFailed
+		row.Reason = err.Error()
+	}
+	return row
+}
+
+// importReadError tells a file cut off by the size limit of the request body from a file that
+// cannot be read.
+func importReadError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return fmt.Errorf("%w: at most %d bytes can be imported at once", errorhandler.ErrImportTooLarge, maxBytesErr.Limit)
+	}
+	return err
+}
+
+func failedImportRow(line uint, err error) domain.ImportRow {
+	return domain.ImportRow{
+		Line:   line,
+		Status: domain.ImportStatusFailed,
+		Reason: err.Error(),
+	}
+}
+
+// MapCsvToDomainImportRows decodes a CSV file whose header names the AddBookReq fields, in any
+// order. Records that cannot be decoded are returned as failed rows. Decoding stops after
+// maxRows+1 rows, which is enough for the file to be refused as too large.
+func MapCsvToDomainImportRows(r io.Reader, maxRows int) ([]domain.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	var parseErr *csv.ParseError
+	if errors.Is(err, io.EOF) || errors.As(err, &parseErr) {
+		return nil, fmt.Errorf("%w: missing CSV header: %v", errorhandler.ErrInvalidImportFile, err)
+	}
+	if err != nil {
+		return nil, importReadError(err)
+	}
+	for n, name := range header {
+		header[n] = strings.ToLower(strings.TrimSpace(name))
+		switch header[n] {
+		case "title", "author", "category", "subject", "genre", "published_year", "isbn_10", "isbn_13":
+		case "id", "total_copies", "available_copies", "created_at", "updated_at":
+			// Read-only columns of a CSV export, ignored so that exports can be imported again
+		default:
+			return nil, fmt.Errorf("%w: unknown CSV column %q", errorhandler.ErrInvalidImportFile, name)
+		}
+	}
+
+	var rows []domain.ImportRow
+	for len(rows) <= maxRows {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.As(err, &parseErr) {
+			rows = append(rows, failedImportRow(uint(parseErr.StartLine), err))
+			continue
+		}
+		if err != nil {
+			return nil, importReadError(err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		var req dto.AddBookReq
+		var yearErr error
+		for n, value := range record {
+			switch header[n] {
+			case "title":
+				req.Title = value
+			case "author":
+				req.Author = value
+			case "category":
+				req.Category = value
+			case "subject":
+				req.Subject = value
+			case "genre":
+				req.Genre = value
+			case "published_year":
+				if value == "" {
+					continue
+				}
+				year, err := strconv.ParseUint(value, 10, 32)
+				if err != nil {
+					yearErr = fmt.Errorf("published_year %q is not a year", value)
+				}
+				req.PublishedYear = uint(year)
+			case "isbn_10":
+				req.ISBN10 = value
+			case "isbn_13":
+				req.ISBN13 = value
+			}
+		}
+		if yearErr != nil {
+			rows = append(rows, failedImportRow(uint(line), yearErr))
+			continue
+		}
+		rows = append(rows, mapAddBookReqToDomainImportRow(uint(line), req))
+	}
+	return rows, nil
+}
+
+// importBookRecord is a JSON Lines import record. Besides the AddBookReq fields it accepts, and
+// ignores, the read-only fields of BookRes, so that exports can be imported again.
+type importBookRecord struct {
+	dto.AddBookReq
+	ID              uint                `json:"id"`
+	Authors         []dto.BookAuthorRes `json:"authors"`
+	Tags            []dto.BookTagRes    `json:"tags"`
+	Work            *dto.BookWorkRes    `json:"work"`
+	TotalCopies     uint                `json:"total_copies"`
+	AvailableCopies uint                `json:"available_copies"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// MapNdjsonToDomainImportRows decodes a JSON Lines file holding one AddBookReq object per line.
+// Blank lines are ignored and lines that cannot be decoded are returned as failed rows.
+// Decoding stops after maxRows+1 rows, which is enough for the file to be refused as too large.
+func MapNdjsonToDomainImportRows(r io.Reader, maxRows int) ([]domain.ImportRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	var rows []domain.ImportRow
+	var line uint
+	for len(rows) <= maxRows && scanner.Scan() {
+		line++
+		record := bytes.TrimSpace(scanner.Bytes())
+		if len(record) == 0 {
+			continue
+		}
+
+		var req importBookRecord
+		decoder := json.NewDecoder(bytes.NewReader(record))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&req); err != nil {
+			rows = append(rows, failedImportRow(line, err))
+			continue
+		}
+		rows = append(rows, mapAddBookReqToDomainImportRow(line, req.AddBookReq))
+	}
+	if err := scanner.Err(); errors.Is(err, bufio.ErrTooLong) {
+		return nil, fmt.Errorf("%w: line %d: %v", errorhandler.ErrInvalidImportFile, line+1, err)
+	} else if err != nil {
+		return nil, importReadError(err)
+	}
+	return rows, nil
+}
+
+// MapMarcToDomainImportRows decodes MARC records into import rows numbered by their position in
+// the file. Records that cannot be parsed are returned as failed rows. Decoding stops after
+// maxRows+1 records, which is enough for the file to be refused as too large.
+func MapMarcToDomainImportRows(decoder marc.Decoder, maxRows int) ([]domain.ImportRow, error) {
+	var rows []domain.ImportRow
+	for n := uint(1); len(rows) <= maxRows; n++ {
+		record, err := decoder.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if marc.IsRecordError(err) {
+			rows = append(rows, failedImportRow(n, err))
+			continue
+		}
+		if err := importReadError(err); errors.Is(err, errorhandler.ErrImportTooLarge) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: record %d: %v", errorhandler.ErrInvalidImportFile, n, err)
+		}
+
+		book := marc.ToBook(record)
+		req := dto.AddBookReq{
+			Title:         book.Title,
+			Author:        book.Author,
+			Category:      book.Category,
+			Subject:       book.Subject,
+			Genre:         book.Genre,
+			PublishedYear: book.PublishedYear,
+			ISBN10:        book.ISBN10,
+			ISBN13:        book.ISBN13,
+		}
+		rows = append(rows, mapAddBookReqToDomainImportRow(n, req))
+	}
+	return rows, nil
+}
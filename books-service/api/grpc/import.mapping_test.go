@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/marc"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// importFiles returns an import file of n books in every supported format.
+func importFiles(t *testing.T, n int) map[string][]byte {
+	t.Helper()
+	var csvFile, ndjsonFile, isoFile, xmlFile bytes.Buffer
+	isoEncoder := marc.NewISO2709Encoder(&isoFile)
+	xmlEncoder := marc.NewMARCXMLEncoder(&xmlFile)
+	csvFile.WriteString("title,author,category\n")
+	for i := 0; i < n; i++ {
+		title := fmt.Sprintf("Book %d", i)
+		fmt.Fprintf(&csvFile, "%s,Author,Fiction\n", title)
+		fmt.Fprintf(&ndjsonFile, `{"title":%q,"author":"Author","category":"Fiction"}`+"\n", title)
+		record := marc.FromBook(domain.Book{Title: title, Author: "Author", Category: "Fiction"})
+		for _, enc := range []marc.Encoder{isoEncoder, xmlEncoder} {
+			if err := enc.Encode(record); err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+		}
+	}
+	for _, enc := range []marc.Encoder{isoEncoder, xmlEncoder} {
+		if err := enc.Close(); err != nil {
+			t.Fatalf("close: %v", err)
+		}
+	}
+	return map[string][]byte{
+		"csv":     csvFile.Bytes(),
+		"ndjson":  ndjsonFile.Bytes(),
+		"iso2709": isoFile.Bytes(),
+		"marcxml": xmlFile.Bytes(),
+	}
+}
+
+func mapImportFile(format string, r io.Reader, maxRows int) ([]domain.ImportRow, error) {
+	switch format {
+	case "csv":
+		return MapCsvToDomainImportRows(r, maxRows)
+	case "ndjson":
+		return MapNdjsonToDomainImportRows(r, maxRows)
+	case "iso2709":
+		return MapMarcToDomainImportRows(marc.NewISO2709Decoder(r), maxRows)
+	default:
+		return MapMarcToDomainImportRows(marc.NewMARCXMLDecoder(r), maxRows)
+	}
+}
+
+func TestMapImportRowsStopsAfterMaxRows(t *testing.T) {
+	for format, file := range importFiles(t, 10) {
+		t.Run(format, func(t *testing.T) {
+			rows, err := mapImportFile(format, bytes.NewReader(file), 3)
+			if err != nil {
+				t.Fatalf("map: %v", err)
+			}
+			if len(rows) != 4 {
+				t.Errorf("got %d rows, want 4: one past the limit is enough to refuse the file", len(rows))
+			}
+
+			rows, err = mapImportFile(format, bytes.NewReader(file), 10)
+			if err != nil {
+				t.Fatalf("map: %v", err)
+			}
+			if len(rows) != 10 {
+				t.Errorf("got %d rows, want all 10", len(rows))
+			}
+		})
+	}
+}
+
+func TestMapImportRowsRefusesOversizedFile(t *testing.T) {
+	for format, file := range importFiles(t, 100) {
+		t.Run(format, func(t *testing.T) {
+			body := http.MaxBytesReader(nil, io.NopCloser(bytes.NewReader(file)), int64(len(file)/2))
+			_, err := mapImportFile(format, body, 1000)
+			if !errors.Is(err, errorhandler.ErrImportTooLarge) {
+				t.Fatalf("map: got %v, want ErrImportTooLarge", err)
+			}
+			if !strings.Contains(err.Error(), fmt.Sprintf("%d bytes", len(file)/2)) {
+				t.Errorf("error %q does not tell the limit", err)
+			}
+		})
+	}
+}
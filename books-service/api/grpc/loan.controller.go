@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+)
+
+type LoanController struct {
+	books.UnimplementedLoansServiceServer
+	loanUseCase *usecase.LoanUseCase
+}
+
+func NewLoanController() *LoanController {
+	return &LoanController{
+		loanUseCase: usecase.NewLoanUseCase(),
+	}
+}
+
+// ListLoans lists the loan history of the whole library.
+func (c *LoanController) ListLoans(ctx context.Context, req *books.ListLoansReq) (*books.LoanList, error) {
+	res, err := c.loanUseCase.GetLoans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainLoansToProtoLoanList(res), nil
+}
+
+// ListMyLoans lists the loans of the caller.
+func (c *LoanController) ListMyLoans(ctx context.Context, req *books.ListMyLoansReq) (*books.LoanList, error) {
+	res, err := c.loanUseCase.GetMyLoans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainLoansToProtoLoanList(res), nil
+}
+
+func (c *LoanController) ListUserLoans(ctx context.Context, req *books.ListUserLoansReq) (*books.LoanList, error) {
+	res, err := c.loanUseCase.GetUserLoans(ctx, MapProtoListUserLoansReqToDomainLoan(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainLoansToProtoLoanList(res), nil
+}
+
+func (c *LoanController) ListBookLoans(ctx context.Context, req *books.ListBookLoansReq) (*books.LoanList, error) {
+	res, err := c.loanUseCase.GetBookLoans(ctx, MapProtoListBookLoansReqToDomainLoan(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainLoansToProtoLoanList(res), nil
+}
@@ -0,0 +1,26 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainLoansToProtoLoanList(loans []domain.Loan) *books.LoanList {
+	res := &books.LoanList{}
+	for _, loan := range loans {
+		res.Loans = append(res.Loans, MapDomainLoanToProtoLoan(loan))
+	}
+	return res
+}
+
+func MapProtoListUserLoansReqToDomainLoan(req *books.ListUserLoansReq) domain.Loan {
+	return domain.Loan{
+		UserID: uint(req.GetUserId()),
+	}
+}
+
+func MapProtoListBookLoansReqToDomainLoan(req *books.ListBookLoansReq) domain.Loan {
+	return domain.Loan{
+		BookID: uint(req.GetBookId()),
+	}
+}
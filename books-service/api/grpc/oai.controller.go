@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/oaipmh"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/errorhandler"
+	"time"
+)
+
+// Harvest answers OAI-PMH requests. Protocol errors are reported inside the XML response; only
+// failures of the service itself end the call with an error.
+func (c *ProtocolController) Harvest(ctx context.Context, protocolReq *books.ProtocolReq) (*books.Document, error) {
+	identity := c.harvestUseCase.Identity()
+
+	req, protocolErr := oaipmh.ParseRequest(MapProtoProtocolReqToValues(protocolReq))
+	req.BaseURL = identity.BaseURL
+	res := oaipmh.NewResponse(req, time.Now())
+
+	var err error
+	if protocolErr != nil {
+		res.Fail(protocolErr)
+	} else {
+		switch req.Verb {
+		case oaipmh.VerbIdentify:
+			err = c.identify(ctx, res, identity)
+		case oaipmh.VerbListMetadataFormats:
+			err = c.listMetadataFormats(ctx, res, identity, req)
+		case oaipmh.VerbListSets:
+			res.Fail(oaipmh.Errorf(oaipmh.ErrNoSetHierarchy, "the catalogue is not organized in sets"))
+		case oaipmh.VerbListIdentifiers, oaipmh.VerbListRecords:
+			err = c.list(ctx, res, identity, req)
+		case oaipmh.VerbGetRecord:
+			err = c.getRecord(ctx, res, identity, req)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := res.Encode(&buf); err != nil {
+		return nil, err
+	}
+	return &books.Document{ContentType: "text/xml; charset=utf-8", Data: buf.Bytes()}, nil
+}
+
+func (c *ProtocolController) identify(ctx context.Context, res *oaipmh.Response, identity domain.HarvestIdentity) error {
+	earliest, err := c.harvestUseCase.EarliestDatestamp(ctx)
+	if err != nil {
+		return err
+	}
+	res.Identify = &oaipmh.Identify{
+		RepositoryName:    identity.Name,
+		BaseURL:           identity.BaseURL,
+		ProtocolVersion:   oaipmh.ProtocolVersion,
+		AdminEmails:       []string{identity.AdminEmail},
+		EarliestDatestamp: oaipmh.FormatDatestamp(earliest),
+		DeletedRecord:     oaipmh.DeletedRecordPersistent,
+		Granularity:       oaipmh.Granularity,
+	}
+	return nil
+}
+
+// listMetadataFormats lists the formats of the whole catalogue or, given an identifier, of a
+// single record. Every record, deleted ones included, is available in the same formats.
+func (c *ProtocolController) listMetadataFormats(ctx context.Context, res *oaipmh.Response, identity domain.HarvestIdentity, req oaipmh.Request) error {
+	if req.Identifier != "" {
+		if _, ok, err := c.getHarvestRecord(ctx, res, identity, req.Identifier); !ok {
+			return err
+		}
+	}
+	res.ListMetadataFormats = &oaipmh.ListMetadataFormats{
+		Formats: []oaipmh.MetadataFormat{oaipmh.FormatOAIDC},
+	}
+	return nil
+}
+
+// list serves ListIdentifiers and ListRecords. Incomplete lists end with a resumption token,
+// and the last part of a list delivered in several parts ends with an empty one.
+func (c *ProtocolController) list(ctx context.Context, res *oaipmh.Response, identity domain.HarvestIdentity, req oaipmh.Request) error {
+	if req.Set != "" {
+		res.Fail(oaipmh.Errorf(oaipmh.ErrNoSetHierarchy, "the catalogue is not organized in sets"))
+		return nil
+	}
+	selection := req.Selection
+	if !checkMetadataPrefix(res, selection.MetadataPrefix) {
+		return nil
+	}
+
+	page, err := c.harvestUseCase.ListRecords(ctx, domain.HarvestQuery{
+		From:           selection.From,
+		Until:          selection.Until,
+		AfterDatestamp: selection.After,
+		AfterID:        selection.AfterID,
+	})
+	if err != nil {
+		return err
+	}
+	if len(page.Records) == 0 {
+		res.Fail(oaipmh.Errorf(oaipmh.ErrNoRecordsMatch, "no records match the request"))
+		return nil
+	}
+
+	var resumptionToken *oaipmh.ResumptionToken
+	if page.More {
+		last := page.Records[len(page.Records)-1]
+		resumptionToken = &oaipmh.ResumptionToken{Token: selection.Resume(last.Datestamp, last.BookID)}
+	} else if req.ResumptionToken != "" {
+		resumptionToken = &oaipmh.ResumptionToken{}
+	}
+
+	if req.Verb == oaipmh.VerbListIdentifiers {
+		res.ListIdentifiers = &oaipmh.ListIdentifiers{
+			Headers:         MapDomainHarvestRecordsToOaiHeaders(identity, page.Records),
+			ResumptionToken: resumptionToken,
+		}
+	} else {
+		res.ListRecords = &oaipmh.ListRecords{
+			Records:         MapDomainHarvestRecordsToOaiRecords(identity, page.Records),
+			ResumptionToken: resumptionToken,
+		}
+	}
+	return nil
+}
+
+func (c *ProtocolController) getRecord(ctx context.Context, res *oaipmh.Response, identity domain.HarvestIdentity, req oaipmh.Request) error {
+	record, ok, err := c.getHarvestRecord(ctx, res, identity, req.Identifier)
+	if !ok {
+		return err
+	}
+	if !checkMetadataPrefix(res, req.MetadataPrefix) {
+		return nil
+	}
+	res.GetRecord = &oaipmh.GetRecord{
+		Record: MapDomainHarvestRecordToOaiRecord(identity, record),
+	}
+	return nil
+}
+
+// getHarvestRecord looks up the record an identifier refers to. When it is not found, the
+// response fails with idDoesNotExist and ok is false.
+func (c *ProtocolController) getHarvestRecord(ctx context.Context, res *oaipmh.Response, identity domain.HarvestIdentity, identifier string) (domain.HarvestRecord, bool, error) {
+	bookID, ok := oaipmh.ParseIdentifier(identity.Identifier, identifier)
+	if !ok {
+		res.Fail(oaipmh.Errorf(oaipmh.ErrIDDoesNotExist, "%s is not an identifier of this repository", identifier))
+		return domain.HarvestRecord{}, false, nil
+	}
+	record, err := c.harvestUseCase.GetRecord(ctx, bookID)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrBookNotFound) {
+			res.Fail(oaipmh.Errorf(oaipmh.ErrIDDoesNotExist, "%s does not exist", identifier))
+			return domain.HarvestRecord{}, false, nil
+		}
+		return domain.HarvestRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// checkMetadataPrefix fails the response unless records can be disseminated in the format.
+func checkMetadataPrefix(res *oaipmh.Response, metadataPrefix string) bool {
+	if metadataPrefix != oaipmh.FormatOAIDC.Prefix {
+		res.Fail(oaipmh.Errorf(oaipmh.ErrCannotDisseminateFormat, "records are only available as %s", oaipmh.FormatOAIDC.Prefix))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/dublincore"
+	"library-management-api/books-service/pkg/oaipmh"
+)
+
+func MapDomainHarvestRecordToOaiHeader(identity domain.HarvestIdentity, record domain.HarvestRecord) oaipmh.Header {
+	header := oaipmh.Header{
+		Identifier: oaipmh.Identifier(identity.Identifier, record.BookID),
+		Datestamp:  oaipmh.FormatDatestamp(record.Datestamp),
+	}
+	if record.Deleted {
+		header.Status = "deleted"
+	}
+	return header
+}
+
+func MapDomainHarvestRecordsToOaiHeaders(identity domain.HarvestIdentity, records []domain.HarvestRecord) []oaipmh.Header {
+	var res []oaipmh.Header
+	for _, record := range records {
+		res = append(res, MapDomainHarvestRecordToOaiHeader(identity, record))
+	}
+	return res
+}
+
+// MapDomainHarvestRecordToOaiRecord describes a book in Dublin Core. Tombstones only have a header.
+func MapDomainHarvestRecordToOaiRecord(identity domain.HarvestIdentity, record domain.HarvestRecord) oaipmh.Record {
+	res := oaipmh.Record{
+		Header: MapDomainHarvestRecordToOaiHeader(identity, record),
+	}
+	if !record.Deleted {
+		res.Metadata = &oaipmh.Metadata{DC: dublincore.FromBook(record.Book)}
+	}
+	return res
+}
+
+func MapDomainHarvestRecordsToOaiRecords(identity domain.HarvestIdentity, records []domain.HarvestRecord) []oaipmh.Record {
+	var res []oaipmh.Record
+	for _, record := range records {
+		res = append(res, MapDomainHarvestRecordToOaiRecord(identity, record))
+	}
+	return res
+}
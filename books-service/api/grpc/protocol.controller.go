@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+)
+
+// ProtocolController answers the metadata protocols: OAI-PMH in oai.controller.go and SRU in
+// sru.controller.go.
+type ProtocolController struct {
+	books.UnimplementedProtocolServiceServer
+	harvestUseCase *usecase.HarvestUseCase
+	sruUseCase     *usecase.SruUseCase
+}
+
+func NewProtocolController() *ProtocolController {
+	return &ProtocolController{
+		harvestUseCase: usecase.NewHarvestUseCase(),
+		sruUseCase:     usecase.NewSruUseCase(),
+	}
+}
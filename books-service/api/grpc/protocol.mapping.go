@@ -0,0 +1,14 @@
+package grpc
+
+import (
+	"library-management-api/pkg/proto/books"
+	"net/url"
+)
+
+func MapProtoProtocolReqToValues(req *books.ProtocolReq) url.Values {
+	values := url.Values{}
+	for _, parameter := range req.GetParameters() {
+		values[parameter.GetName()] = append(values[parameter.GetName()], parameter.GetValues()...)
+	}
+	return values
+}
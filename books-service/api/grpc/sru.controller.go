@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"library-management-api/books-service/pkg/cql"
+	"library-management-api/books-service/pkg/dublincore"
+	"library-management-api/books-service/pkg/sru"
+	"library-management-api/pkg/proto/books"
+	"strconv"
+)
+
+// SearchRetrieve answers SRU 2.0 requests. A request without a query is answered with the
+// explain record. Diagnostics are reported inside the XML response; only failures of the
+// service itself end the call with an error.
+func (c *ProtocolController) SearchRetrieve(ctx context.Context, req *books.ProtocolReq) (*books.Document, error) {
+	service := c.sruUseCase.SearchService()
+	values := MapProtoProtocolReqToValues(req)
+
+	if !values.Has("query") {
+		return sruDocument(sru.NewExplainResponse(MapDomainSearchServiceToSruExplain(service)))
+	}
+
+	res := sru.NewSearchRetrieveResponse()
+	startRecord, maximumRecords := 1, service.DefaultRecords
+	if v := values.Get("startRecord"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			res.Fail(sru.NewDiagnostic(sru.DiagUnsupportedParameterValue, "startRecord"))
+			return sruDocument(res)
+		}
+		startRecord = n
+	}
+	if v := values.Get("maximumRecords"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			res.Fail(sru.NewDiagnostic(sru.DiagUnsupportedParameterValue, "maximumRecords"))
+			return sruDocument(res)
+		}
+		maximumRecords = n
+	}
+	switch schema := values.Get("recordSchema"); schema {
+	case "", sru.SchemaDC, sru.SchemaDCShort:
+	default:
+		res.Fail(sru.NewDiagnostic(sru.DiagUnknownSchemaForRetrieval, schema))
+		return sruDocument(res)
+	}
+	escaping := values.Get("recordXMLEscaping")
+	switch escaping {
+	case "":
+		escaping = sru.EscapingXML
+	case sru.EscapingXML, sru.EscapingString:
+	default:
+		res.Fail(sru.NewDiagnostic(sru.DiagUnsupportedRecordXMLEscaping, escaping))
+		return sruDocument(res)
+	}
+
+	node, err := cql.Parse(values.Get("query"))
+	if err != nil {
+		res.Fail(sru.NewDiagnostic(sru.DiagQuerySyntaxError, err.Error()))
+		return sruDocument(res)
+	}
+	condition, diagnostic := MapCqlToDomainBookCondition(node)
+	if diagnostic != nil {
+		res.Fail(diagnostic)
+		return sruDocument(res)
+	}
+
+	page, err := c.sruUseCase.SearchRetrieve(ctx, condition, startRecord-1, maximumRecords)
+	if err != nil {
+		return nil, err
+	}
+	res.NumberOfRecords = page.Total
+	if page.Total > 0 && startRecord > page.Total {
+		res.Fail(sru.NewDiagnostic(sru.DiagFirstRecordOutOfRange, strconv.Itoa(startRecord)))
+		return sruDocument(res)
+	}
+
+	if len(page.Items) > 0 {
+		res.Records = &sru.Records{}
+		for i, book := range page.Items {
+			record, err := sru.NewDCRecord(dublincore.FromBook(book), escaping, startRecord+i)
+			if err != nil {
+				return nil, err
+			}
+			res.Records.Records = append(res.Records.Records, record)
+		}
+	}
+	if next := startRecord + len(page.Items); len(page.Items) > 0 && next <= page.Total {
+		res.NextRecordPosition = next
+	}
+	return sruDocument(res)
+}
+
+func sruDocument(res any) (*books.Document, error) {
+	var buf bytes.Buffer
+	if err := sru.Encode(&buf, res); err != nil {
+		return nil, err
+	}
+	return &books.Document{ContentType: "application/xml; charset=utf-8", Data: buf.Bytes()}, nil
+}
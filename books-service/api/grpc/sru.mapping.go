@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/cql"
+	"library-management-api/books-service/pkg/sru"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// sruIndexes lists the searchable CQL indexes, under every name they can be given.
+var sruIndexes = []struct {
+	title string
+	field domain.BookField
+	names []sru.IndexID
+}{
+	{"Any field", domain.BookFieldAny, []sru.IndexID{{Set: "cql", Name: "serverChoice"}}},
+	{"Title", domain.BookFieldTitle, []sru.IndexID{{Set: "dc", Name: "title"}}},
+	{"Author", domain.BookFieldAuthor, []sru.IndexID{{Set: "dc", Name: "creator"}, {Set: "dc", Name: "author"}}},
+	{"Subject", domain.BookFieldSubject, []sru.IndexID{{Set: "dc", Name: "subject"}}},
+}
+
+// sruContextSets are the context sets the index names belong to.
+var sruContextSets = []sru.ContextSet{
+	{Name: "cql", Identifier: "info:srw/cql-context-set/1/cql-v1.2"},
+	{Name: "dc", Identifier: "info:srw/cql-context-set/1/dc-v1.1"},
+}
+
+// sruRelations maps the supported CQL relations to match modes.
+var sruRelations = map[string]domain.BookMatchMode{
+	"=":       domain.BookMatchPhrase,
+	"any":     domain.BookMatchAnyWord,
+	"cql.any": domain.BookMatchAnyWord,
+	"all":     domain.BookMatchAllWords,
+	"cql.all": domain.BookMatchAllWords,
+}
+
+// sruIndexField returns the field an index name refers to. Names are compared ignoring case
+// and may leave out the context set, as in "title" for dc.title.
+func sruIndexField(index string) (domain.BookField, bool) {
+	index = strings.ToLower(index)
+	for _, i := range sruIndexes {
+		for _, name := range i.names {
+			if index == strings.ToLower(name.Set+"."+name.Name) || index == strings.ToLower(name.Name) {
+				return i.field, true
+			}
+		}
+	}
+	return "", false
+}
+
+// MapCqlToDomainBookCondition translates a parsed CQL query into a book condition. Parts of the
+// query outside the supported subset are reported as SRU diagnostics.
+func MapCqlToDomainBookCondition(node cql.Node) (domain.BookCondition, *sru.Diagnostic) {
+	switch n := node.(type) {
+	case *cql.Boolean:
+		if len(n.Modifiers) > 0 {
+			return domain.BookCondition{}, sru.NewDiagnostic(sru.DiagUnsupportedBooleanModifier, n.Modifiers[0].Name)
+		}
+		var op domain.BookConditionOp
+		switch n.Op {
+		case "AND":
+			op = domain.BookConditionAnd
+		case "OR":
+			op = domain.BookConditionOr
+		case "NOT":
+			op = domain.BookConditionAndNot
+		default:
+			return domain.BookCondition{}, sru.NewDiagnostic(sru.DiagUnsupportedBooleanOperator, n.Op)
+		}
+		left, diagnostic := MapCqlToDomainBookCondition(n.Left)
+		if diagnostic != nil {
+			return domain.BookCondition{}, diagnostic
+		}
+		right, diagnostic := MapCqlToDomainBookCondition(n.Right)
+		if diagnostic != nil {
+			return domain.BookCondition{}, diagnostic
+		}
+		return domain.BookCondition{Op: op, Left: &left, Right: &right}, nil
+
+	case *cql.Clause:
+		if len(n.Modifiers) > 0 {
+			return domain.BookCondition{}, sru.NewDiagnostic(sru.DiagUnsupportedRelationModifier, n.Modifiers[0].Name)
+		}
+		mode, ok := sruRelations[n.Relation]
+		if !ok {
+			return domain.BookCondition{}, sru.NewDiagnostic(sru.DiagUnsupportedRelation, n.Relation)
+		}
+		if strings.EqualFold(n.Index, "cql.allRecords") {
+			return domain.BookCondition{Op: domain.BookConditionAll}, nil
+		}
+		field, ok := sruIndexField(n.Index)
+		if !ok {
+			return domain.BookCondition{}, sru.NewDiagnostic(sru.DiagUnsupportedIndex, n.Index)
+		}
+		if strings.TrimSpace(n.Term) == "" {
+			return domain.BookCondition{}, sru.NewDiagnostic(sru.DiagEmptyTermUnsupported, "")
+		}
+		return domain.BookCondition{Field: field, Mode: mode, Terms: n.Term}, nil
+	}
+	return domain.BookCondition{}, sru.NewDiagnostic(sru.DiagQuerySyntaxError, "")
+}
+
+// MapDomainSearchServiceToSruExplain describes the endpoint, its indexes and its limits.
+func MapDomainSearchServiceToSruExplain(service domain.SearchService) sru.Explain {
+	serverInfo := sru.ServerInfo{Protocol: "SRU", Version: sru.Version}
+	if u, err := url.Parse(service.BaseURL); err == nil {
+		serverInfo.Host = u.Hostname()
+		serverInfo.Port = u.Port()
+		if serverInfo.Port == "" {
+			serverInfo.Port = "80"
+			if u.Scheme == "https" {
+				serverInfo.Port = "443"
+			}
+		}
+		serverInfo.Database = strings.Trim(u.Path, "/")
+	}
+
+	indexInfo := sru.IndexInfo{Sets: sruContextSets}
+	for _, i := range sruIndexes {
+		indexInfo.Indexes = append(indexInfo.Indexes, sru.Index{Title: i.title, Names: i.names})
+	}
+
+	return sru.Explain{
+		ServerInfo:   serverInfo,
+		DatabaseInfo: sru.DatabaseInfo{Title: service.Title},
+		IndexInfo:    indexInfo,
+		SchemaInfo:   []sru.Schema{{Identifier: sru.SchemaDC, Name: sru.SchemaDCShort, Title: "Dublin Core"}},
+		ConfigInfo: sru.ConfigInfo{
+			Defaults: []sru.Setting{{Type: "numberOfRecords", Value: strconv.Itoa(service.DefaultRecords)}},
+			Settings: []sru.Setting{{Type: "maximumRecords", Value: strconv.Itoa(service.MaxRecords)}},
+		},
+	}
+}
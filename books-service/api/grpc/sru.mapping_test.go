@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/cql"
+	"library-management-api/books-service/pkg/sru"
+	"reflect"
+	"testing"
+)
+
+func TestMapCqlToDomainBookCondition(t *testing.T) {
+	match := func(field domain.BookField, mode domain.BookMatchMode, terms string) domain.BookCondition {
+		return domain.BookCondition{Field: field, Mode: mode, Terms: terms}
+	}
+
+	tests := []struct {
+		query string
+		want  domain.BookCondition
+	}{
+		{"dune", match(domain.BookFieldAny, domain.BookMatchPhrase, "dune")},
+		{"cql.serverChoice = dune", match(domain.BookFieldAny, domain.BookMatchPhrase, "dune")},
+		{"dc.title = dune", match(domain.BookFieldTitle, domain.BookMatchPhrase, "dune")},
+		{"title = dune", match(domain.BookFieldTitle, domain.BookMatchPhrase, "dune")},
+		{"DC.Title = dune", match(domain.BookFieldTitle, domain.BookMatchPhrase, "dune")},
+		{`dc.creator any "le guin"`, match(domain.BookFieldAuthor, domain.BookMatchAnyWord, "le guin")},
+		{"dc.author cql.any guin", match(domain.BookFieldAuthor, domain.BookMatchAnyWord, "guin")},
+		{`subject all "science fiction"`, match(domain.BookFieldSubject, domain.BookMatchAllWords, "science fiction")},
+		{"title cql.all dune", match(domain.BookFieldTitle, domain.BookMatchAllWords, "dune")},
+		{`cql.allRecords = 1`, domain.BookCondition{Op: domain.BookConditionAll}},
+		{"title = dune and creator = herbert", domain.BookCondition{
+			Op:    domain.BookConditionAnd,
+			Left:  &domain.BookCondition{Field: domain.BookFieldTitle, Mode: domain.BookMatchPhrase, Terms: "dune"},
+			Right: &domain.BookCondition{Field: domain.BookFieldAuthor, Mode: domain.BookMatchPhrase, Terms: "herbert"},
+		}},
+		{"dune or arrakis not messiah", domain.BookCondition{
+			Op: domain.BookConditionAndNot,
+			Left: &domain.BookCondition{
+				Op:    domain.BookConditionOr,
+				Left:  &domain.BookCondition{Field: domain.BookFieldAny, Mode: domain.BookMatchPhrase, Terms: "dune"},
+				Right: &domain.BookCondition{Field: domain.BookFieldAny, Mode: domain.BookMatchPhrase, Terms: "arrakis"},
+			},
+			Right: &domain.BookCondition{Field: domain.BookFieldAny, Mode: domain.BookMatchPhrase, Terms: "messiah"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			node, err := cql.Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.query, err)
+			}
+			got, diagnostic := MapCqlToDomainBookCondition(node)
+			if diagnostic != nil {
+				t.Fatalf("MapCqlToDomainBookCondition(%q) diagnostic = %v", tt.query, diagnostic)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapCqlToDomainBookCondition(%q) = %+v, want %+v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapCqlToDomainBookConditionDiagnostics(t *testing.T) {
+	tests := []struct {
+		query       string
+		wantNumber  int
+		wantDetails string
+	}{
+		{"dc.publisher = tor", sru.DiagUnsupportedIndex, "dc.publisher"},
+		{"bath.isbn = 9780441013593", sru.DiagUnsupportedIndex, "bath.isbn"},
+		{"title = dune and isbn = 1", sru.DiagUnsupportedIndex, "isbn"},
+		{"title == dune", sru.DiagUnsupportedRelation, "=="},
+		{"date > 1990", sru.DiagUnsupportedRelation, ">"},
+		{"title adj dune", sru.DiagUnsupportedRelation, "adj"},
+		{"title =/relevant dune", sru.DiagUnsupportedRelationModifier, "relevant"},
+		{"dune prox arrakis", sru.DiagUnsupportedBooleanOperator, "PROX"},
+		{"dune and/distance<2 arrakis", sru.DiagUnsupportedBooleanModifier, "distance"},
+		{`title = ""`, sru.DiagEmptyTermUnsupported, ""},
+		{`title = "  "`, sru.DiagEmptyTermUnsupported, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			node, err := cql.Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.query, err)
+			}
+			_, diagnostic := MapCqlToDomainBookCondition(node)
+			want := sru.NewDiagnostic(tt.wantNumber, tt.wantDetails)
+			if !reflect.DeepEqual(diagnostic, want) {
+				t.Errorf("MapCqlToDomainBookCondition(%q) diagnostic = %+v, want %+v", tt.query, diagnostic, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import "library-management-api/pkg/proto/books"
+
+// streamChunkSize bounds the data sent in one message of a file or image download.
+const streamChunkSize = 32 * 1024
+
+// chunkReader reads the data of an upload received in chunks. next returns the data of the
+// next message, or io.EOF once the client has sent them all.
+type chunkReader struct {
+	next func() ([]byte, error)
+	data []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		data, err := r.next()
+		if err != nil {
+			return 0, err
+		}
+		r.data = data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// fileWriter sends a file in chunks of streamChunkSize. The first chunk names the file, so
+// nothing is sent until the file is known to start, and failures before that are still
+// answered with a status of their own.
+type fileWriter struct {
+	stream      books.CatalogueService_ExportBooksServer
+	contentType string
+	filename    string
+	buf         []byte
+	started     bool
+}
+
+func (w *fileWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= streamChunkSize {
+		if err := w.send(w.buf[:streamChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[streamChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends the data left, or the name of an empty file.
+func (w *fileWriter) Flush() error {
+	if w.started && len(w.buf) == 0 {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *fileWriter) send(data []byte) error {
+	chunk := &books.FileChunk{Data: data}
+	if !w.started {
+		w.started = true
+		chunk.ContentType = w.contentType
+		chunk.Filename = w.filename
+	}
+	return w.stream.Send(chunk)
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type SubjectController struct {
+	books.UnimplementedSubjectsServiceServer
+	subjectUseCase *usecase.SubjectUseCase
+}
+
+func NewSubjectController() *SubjectController {
+	return &SubjectController{
+		subjectUseCase: usecase.NewSubjectUseCase(),
+	}
+}
+
+func (c *SubjectController) AddSubject(ctx context.Context, req *books.AddSubjectReq) (*books.Subject, error) {
+	res, err := c.subjectUseCase.AddSubject(ctx, MapProtoAddSubjectReqToDomainSubject(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainSubjectToProtoSubject(res), nil
+}
+
+func (c *SubjectController) ListSubjects(ctx context.Context, req *books.ListSubjectsReq) (*books.SubjectList, error) {
+	res, err := c.subjectUseCase.GetSubjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainSubjectsToProtoSubjectList(res), nil
+}
+
+func (c *SubjectController) UpdateSubject(ctx context.Context, req *books.UpdateSubjectReq) (*books.Subject, error) {
+	res, err := c.subjectUseCase.UpdateSubject(ctx, MapProtoUpdateSubjectReqToDomainSubject(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainSubjectToProtoSubject(res), nil
+}
+
+func (c *SubjectController) DeleteSubject(ctx context.Context, req *books.DeleteSubjectReq) (*emptypb.Empty, error) {
+	if err := c.subjectUseCase.DeleteSubject(ctx, MapProtoDeleteSubjectReqToDomainSubject(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// MergeSubject folds a subject into another, which files its books and narrower subjects.
+func (c *SubjectController) MergeSubject(ctx context.Context, req *books.MergeSubjectReq) (*books.Subject, error) {
+	source, target := MapProtoMergeSubjectReqToDomainSubjects(req)
+	res, err := c.subjectUseCase.MergeSubject(ctx, source, target)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainSubjectToProtoSubject(res), nil
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainSubjectToProtoSubject(subject domain.Subject) *books.Subject {
+	return &books.Subject{
+		Id:        int32(subject.ID),
+		Name:      subject.Name,
+		ParentId:  int32(subject.ParentID),
+		BookCount: int32(subject.BookCount),
+		CreatedAt: mapTime(subject.CreatedAt),
+	}
+}
+
+func MapDomainSubjectsToProtoSubjectList(subjects []domain.Subject) *books.SubjectList {
+	res := &books.SubjectList{}
+	for _, subject := range subjects {
+		res.Subjects = append(res.Subjects, MapDomainSubjectToProtoSubject(subject))
+	}
+	return res
+}
+
+func MapProtoAddSubjectReqToDomainSubject(req *books.AddSubjectReq) domain.Subject {
+	return domain.Subject{
+		Name:     req.GetName(),
+		ParentID: uint(req.GetParentId()),
+	}
+}
+
+func MapProtoUpdateSubjectReqToDomainSubject(req *books.UpdateSubjectReq) domain.Subject {
+	return domain.Subject{
+		ID:       uint(req.GetId()),
+		Name:     req.GetName(),
+		ParentID: uint(req.GetParentId()),
+	}
+}
+
+func MapProtoDeleteSubjectReqToDomainSubject(req *books.DeleteSubjectReq) domain.Subject {
+	return domain.Subject{
+		ID: uint(req.GetId()),
+	}
+}
+
+// MapProtoMergeSubjectReqToDomainSubjects returns the source and the target of a merge.
+func MapProtoMergeSubjectReqToDomainSubjects(req *books.MergeSubjectReq) (domain.Subject, domain.Subject) {
+	return domain.Subject{ID: uint(req.GetId())}, domain.Subject{ID: uint(req.GetIntoId())}
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TagController struct {
+	books.UnimplementedTagsServiceServer
+	tagUseCase *usecase.TagUseCase
+}
+
+func NewTagController() *TagController {
+	return &TagController{
+		tagUseCase: usecase.NewTagUseCase(),
+	}
+}
+
+func (c *TagController) AddTag(ctx context.Context, req *books.AddTagReq) (*books.Tag, error) {
+	res, err := c.tagUseCase.AddTag(ctx, MapProtoAddTagReqToDomainTag(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainTagToProtoTag(res), nil
+}
+
+func (c *TagController) ListTags(ctx context.Context, req *books.ListTagsReq) (*books.TagList, error) {
+	res, err := c.tagUseCase.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainTagsToProtoTagList(res), nil
+}
+
+func (c *TagController) UpdateTag(ctx context.Context, req *books.UpdateTagReq) (*books.Tag, error) {
+	res, err := c.tagUseCase.UpdateTag(ctx, MapProtoUpdateTagReqToDomainTag(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainTagToProtoTag(res), nil
+}
+
+func (c *TagController) DeleteTag(ctx context.Context, req *books.DeleteTagReq) (*emptypb.Empty, error) {
+	if err := c.tagUseCase.DeleteTag(ctx, MapProtoDeleteTagReqToDomainTag(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainTagToProtoTag(tag domain.Tag) *books.Tag {
+	return &books.Tag{
+		Id:        int32(tag.ID),
+		Name:      tag.Name,
+		BookCount: int32(tag.BookCount),
+		CreatedAt: mapTime(tag.CreatedAt),
+	}
+}
+
+func MapDomainTagsToProtoTagList(tags []domain.Tag) *books.TagList {
+	res := &books.TagList{}
+	for _, tag := range tags {
+		res.Tags = append(res.Tags, MapDomainTagToProtoTag(tag))
+	}
+	return res
+}
+
+func MapProtoAddTagReqToDomainTag(req *books.AddTagReq) domain.Tag {
+	return domain.Tag{
+		Name: req.GetName(),
+	}
+}
+
+func MapProtoUpdateTagReqToDomainTag(req *books.UpdateTagReq) domain.Tag {
+	return domain.Tag{
+		ID:   uint(req.GetId()),
+		Name: req.GetName(),
+	}
+}
+
+func MapProtoDeleteTagReqToDomainTag(req *books.DeleteTagReq) domain.Tag {
+	return domain.Tag{
+		ID: uint(req.GetId()),
+	}
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"library-management-api/books-service/core/usecase"
+	"library-management-api/pkg/proto/books"
+)
+
+type WorkController struct {
+	books.UnimplementedWorksServiceServer
+	workUseCase *usecase.WorkUseCase
+}
+
+func NewWorkController() *WorkController {
+	return &WorkController{
+		workUseCase: usecase.NewWorkUseCase(),
+	}
+}
+
+func (c *WorkController) AddWork(ctx context.Context, req *books.AddWorkReq) (*books.Work, error) {
+	res, err := c.workUseCase.AddWork(ctx, MapProtoAddWorkReqToDomainWork(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainWorkToProtoWork(res), nil
+}
+
+// GetWork returns a work with its editions.
+func (c *WorkController) GetWork(ctx context.Context, req *books.GetWorkReq) (*books.Work, error) {
+	res, err := c.workUseCase.GetWork(ctx, MapProtoGetWorkReqToDomainWork(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainWorkToProtoWork(res), nil
+}
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"library-management-api/books-service/core/domain"
+	"library-management-api/pkg/proto/books"
+)
+
+func MapDomainWorkToProtoWork(work domain.Work) *books.Work {
+	res := &books.Work{
+		Id:        int32(work.ID),
+		Title:     work.Title,
+		CreatedAt: mapTime(work.CreatedAt),
+	}
+	for _, edition := range work.Editions {
+		res.Editions = append(res.Editions, MapDomainBookToProtoBook(edition))
+	}
+	return res
+}
+
+func MapProtoAddWorkReqToDomainWork(req *books.AddWorkReq) domain.Work {
+	return domain.Work{
+		Title: req.GetTitle(),
+	}
+}
+
+func MapProtoGetWorkReqToDomainWork(req *books.GetWorkReq) domain.Work {
+	return domain.Work{
+		ID: uint(req.GetId()),
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/gateway/grpc"
+	"library-management-api/books-service/init/database"
+	"library-management-api/books-service/init/jobs"
+	"os"
+)
+
+func init() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	configs.RunConfig("books-service")
+	database.RunDB()
+	jobs.RunJobs()
+}
+
+func main() {
+	grpc.RunGRPC()
+}
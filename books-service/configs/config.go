@@ -4,12 +4,27 @@ import (
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
+	"library-management-api/util/grpcconn"
+	"net/url"
+	"strings"
+	"time"
 )
 
 // Config holds the application wide configurations.
 // The values are read by viper from the config file or environment variables.
 type Config struct {
-	PSQL PSQL `mapstructure:"psql"`
+	PSQL    PSQL    `mapstructure:"psql"`
+	Loan    Loan    `mapstructure:"loan"`
+	Hold    Hold    `mapstructure:"hold"`
+	Fine    Fine    `mapstructure:"fine"`
+	Suggest Suggest `mapstructure:"suggest"`
+	Import  Import  `mapstructure:"import"`
+	OAI     OAI     `mapstructure:"oai"`
+	SRU     SRU     `mapstructure:"sru"`
+	Storage Storage `mapstructure:"storage"`
+	Cover   Cover   `mapstructure:"cover"`
+	GRPC    GRPC    `mapstructure:"grpc"`
+	Peers   Peers   `mapstructure:"peers"`
 }
 
 // PSQL holds PostgreSQL connection configuration.
@@ -22,6 +37,92 @@ type PSQL struct {
 	SSLMode  string `mapstructure:"ssl_mode"`
 }
 
+// Loan holds the circulation rules applied when lending and renewing copies.
+type Loan struct {
+	Period         time.Duration `mapstructure:"period"`
+	MaxRenewals    uint          `mapstructure:"max_renewals"`
+	MaxActiveLoans uint          `mapstructure:"max_active_loans"`
+}
+
+// Hold holds the reservation rules applied to the hold shelf.
+type Hold struct {
+	PickupWindow  time.Duration `mapstructure:"pickup_window"`
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+}
+
+// Fine holds the late fee rules. Amounts are in cents.
+type Fine struct {
+	GracePeriod         time.Duration `mapstructure:"grace_period"`
+	DailyRateCents      int64         `mapstructure:"daily_rate_cents"`
+	MaxPerLoanCents     int64         `mapstructure:"max_per_loan_cents"`
+	BlockThresholdCents int64         `mapstructure:"block_threshold_cents"`
+	ScanInterval        time.Duration `mapstructure:"scan_interval"`
+}
+
+// Suggest holds the limits of the typeahead endpoint, which is called on every keystroke.
+type Suggest struct {
+	DefaultLimit int           `mapstructure:"default_limit"`
+	MaxLimit     int           `mapstructure:"max_limit"`
+	CacheTTL     time.Duration `mapstructure:"cache_ttl"`
+	CacheSize    int           `mapstructure:"cache_size"`
+}
+
+// Import holds the limits of bulk catalogue imports.
+type Import struct {
+	BatchSize int   `mapstructure:"batch_size"`
+	MaxRows   int   `mapstructure:"max_rows"`
+	MaxBytes  int64 `mapstructure:"max_bytes"`
+}
+
+// OAI describes the catalogue to OAI-PMH harvesters. RepositoryIdentifier is the domain name
+// that prefixes the OAI identifiers of the records.
+type OAI struct {
+	RepositoryName       string `mapstructure:"repository_name"`
+	BaseURL              string `mapstructure:"base_url"`
+	AdminEmail           string `mapstructure:"admin_email"`
+	RepositoryIdentifier string `mapstructure:"repository_identifier"`
+	PageSize             int    `mapstructure:"page_size"`
+}
+
+// SRU holds the settings of the SRU search endpoint. BaseURL is where partners reach it and is
+// published in the explain record.
+type SRU struct {
+	BaseURL        string `mapstructure:"base_url"`
+	DatabaseTitle  string `mapstructure:"database_title"`
+	DefaultRecords int    `mapstructure:"default_records"`
+	MaxRecords     int    `mapstructure:"max_records"`
+}
+
+// Storage selects where uploaded files are kept. The only driver so far is "local", which keeps
+// them in LocalDir.
+type Storage struct {
+	Driver   string `mapstructure:"driver"`
+	LocalDir string `mapstructure:"local_dir"`
+}
+
+// Cover holds the limits of cover uploads and the box thumbnails are scaled to fit in.
+// MaxPixels guards against images that are small on the wire but huge once decoded.
+type Cover struct {
+	MaxBytes        int64 `mapstructure:"max_bytes"`
+	MaxPixels       int   `mapstructure:"max_pixels"`
+	ThumbnailWidth  int   `mapstructure:"thumbnail_width"`
+	ThumbnailHeight int   `mapstructure:"thumbnail_height"`
+}
+
+// GRPC holds the address the gRPC server of the service listens on, and the deadlines given to
+// unary calls that arrive without one. Streams carry imports, exports and cover images, so they
+// are only cut once they have sent and received nothing for StreamIdleTimeout.
+type GRPC struct {
+	Address           string        `mapstructure:"address"`
+	UnaryTimeout      time.Duration `mapstructure:"unary_timeout"`
+	StreamIdleTimeout time.Duration `mapstructure:"stream_idle_timeout"`
+}
+
+// Peers holds how to reach the services this one calls.
+type Peers struct {
+	Auth grpcconn.Peer `mapstructure:"auth"`
+}
+
 var c *Config
 
 // C returns the loaded configuration globally.
@@ -62,6 +163,39 @@ func LoadConfig(path string) (*Config, error) {
 	if err := validatePSQLConfig(config.PSQL); err != nil {
 		return nil, err
 	}
+	if err := validateLoanConfig(config.Loan); err != nil {
+		return nil, err
+	}
+	if err := validateHoldConfig(config.Hold); err != nil {
+		return nil, err
+	}
+	if err := validateFineConfig(config.Fine); err != nil {
+		return nil, err
+	}
+	if err := validateSuggestConfig(config.Suggest); err != nil {
+		return nil, err
+	}
+	if err := validateImportConfig(config.Import); err != nil {
+		return nil, err
+	}
+	if err := validateOAIConfig(config.OAI); err != nil {
+		return nil, err
+	}
+	if err := validateSRUConfig(config.SRU); err != nil {
+		return nil, err
+	}
+	if err := validateStorageConfig(config.Storage); err != nil {
+		return nil, err
+	}
+	if err := validateCoverConfig(config.Cover); err != nil {
+		return nil, err
+	}
+	if err := validateGRPCConfig(config.GRPC); err != nil {
+		return nil, err
+	}
+	if err := validatePeersConfig(config.Peers); err != nil {
+		return nil, err
+	}
 
 	return &config, nil
 }
@@ -74,6 +208,51 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("psql.password", "secret")
 	v.SetDefault("psql.database", "library_books_db")
 	v.SetDefault("psql.ssl_mode", "disable")
+	v.SetDefault("loan.period", "336h")
+	v.SetDefault("loan.max_renewals", 2)
+	v.SetDefault("loan.max_active_loans", 5)
+	v.SetDefault("hold.pickup_window", "72h")
+	v.SetDefault("hold.sweep_interval", "5m")
+	v.SetDefault("fine.grace_period", "24h")
+	v.SetDefault("fine.daily_rate_cents", 25)
+	v.SetDefault("fine.max_per_loan_cents", 1000)
+	v.SetDefault("fine.block_threshold_cents", 500)
+	v.SetDefault("fine.scan_interval", "1h")
+	v.SetDefault("suggest.default_limit", 10)
+	v.SetDefault("suggest.max_limit", 25)
+	v.SetDefault("suggest.cache_ttl", "30s")
+	v.SetDefault("suggest.cache_size", 1024)
+	v.SetDefault("import.batch_size", 500)
+	v.SetDefault("import.max_rows", 100000)
+	v.SetDefault("import.max_bytes", 128<<20)
+	v.SetDefault("oai.repository_name", "Library Management API")
+	v.SetDefault("oai.base_url", "http://localhost:8080/oai")
+	v.SetDefault("oai.admin_email", "admin@library.example.org")
+	v.SetDefault("oai.repository_identifier", "library.example.org")
+	v.SetDefault("oai.page_size", 100)
+	v.SetDefault("sru.base_url", "http://localhost:8080/sru")
+	v.SetDefault("sru.database_title", "Library Management API catalogue")
+	v.SetDefault("sru.default_records", 10)
+	v.SetDefault("sru.max_records", 100)
+	v.SetDefault("storage.driver", "local")
+	v.SetDefault("storage.local_dir", "data/files")
+	v.SetDefault("cover.max_bytes", 5<<20)
+	v.SetDefault("cover.max_pixels", 40_000_000)
+	v.SetDefault("cover.thumbnail_width", 200)
+	v.SetDefault("cover.thumbnail_height", 300)
+	v.SetDefault("grpc.address", ":8083")
+	v.SetDefault("grpc.unary_timeout", "10s")
+	v.SetDefault("grpc.stream_idle_timeout", "5m")
+	v.SetDefault("peers.auth.addresses", []string{"localhost:8081"})
+	v.SetDefault("peers.auth.dial_timeout", "5s")
+	v.SetDefault("peers.auth.keepalive_time", "30s")
+	v.SetDefault("peers.auth.keepalive_timeout", "10s")
+	v.SetDefault("peers.auth.policy.call_timeout", "2s")
+	v.SetDefault("peers.auth.policy.max_retries", 2)
+	v.SetDefault("peers.auth.policy.retry_backoff", "100ms")
+	v.SetDefault("peers.auth.policy.max_retry_backoff", "1s")
+	v.SetDefault("peers.auth.policy.breaker_failures", 5)
+	v.SetDefault("peers.auth.policy.breaker_cooldown", "30s")
 }
 
 // validatePSQLConfig ensures that essential PSQL config values are present.
@@ -96,6 +275,166 @@ func validatePSQLConfig(psqlConfig PSQL) error {
 	return nil
 }
 
+// validateLoanConfig ensures that the loan policy can actually lend books.
+func validateLoanConfig(loanConfig Loan) error {
+	if loanConfig.Period <= 0 {
+		return fmt.Errorf("loan period must be positive")
+	}
+	if loanConfig.MaxActiveLoans == 0 {
+		return fmt.Errorf("max active loans must be at least 1")
+	}
+	return nil
+}
+
+// validateHoldConfig ensures that held copies are eventually released.
+func validateHoldConfig(holdConfig Hold) error {
+	if holdConfig.PickupWindow <= 0 {
+		return fmt.Errorf("hold pickup window must be positive")
+	}
+	if holdConfig.SweepInterval <= 0 {
+		return fmt.Errorf("hold sweep interval must be positive")
+	}
+	return nil
+}
+
+// validateFineConfig ensures that the fine policy never charges negative amounts.
+func validateFineConfig(fineConfig Fine) error {
+	if fineConfig.GracePeriod < 0 {
+		return fmt.Errorf("fine grace period cannot be negative")
+	}
+	if fineConfig.DailyRateCents < 0 {
+		return fmt.Errorf("fine daily rate cannot be negative")
+	}
+	if fineConfig.MaxPerLoanCents < 0 {
+		return fmt.Errorf("fine maximum per loan cannot be negative")
+	}
+	if fineConfig.BlockThresholdCents < 0 {
+		return fmt.Errorf("fine block threshold cannot be negative")
+	}
+	if fineConfig.ScanInterval <= 0 {
+		return fmt.Errorf("fine scan interval must be positive")
+	}
+	return nil
+}
+
+// validateSuggestConfig ensures that suggestions are bounded and cached.
+func validateSuggestConfig(suggestConfig Suggest) error {
+	if suggestConfig.DefaultLimit <= 0 {
+		return fmt.Errorf("suggest default limit must be positive")
+	}
+	if suggestConfig.MaxLimit < suggestConfig.DefaultLimit {
+		return fmt.Errorf("suggest max limit cannot be below the default limit")
+	}
+	if suggestConfig.CacheTTL <= 0 {
+		return fmt.Errorf("suggest cache ttl must be positive")
+	}
+	if suggestConfig.CacheSize <= 0 {
+		return fmt.Errorf("suggest cache size must be positive")
+	}
+	return nil
+}
+
+// validateImportConfig ensures that imports make progress and stay bounded.
+func validateImportConfig(importConfig Import) error {
+	if importConfig.BatchSize <= 0 {
+		return fmt.Errorf("import batch size must be positive")
+	}
+	if importConfig.MaxRows < importConfig.BatchSize {
+		return fmt.Errorf("import max rows cannot be below the batch size")
+	}
+	if importConfig.MaxBytes <= 0 {
+		return fmt.Errorf("import max bytes must be positive")
+	}
+	return nil
+}
+
+// validateOAIConfig ensures that the repository can be identified and harvested.
+func validateOAIConfig(oaiConfig OAI) error {
+	if oaiConfig.RepositoryName == "" {
+		return fmt.Errorf("oai repository name is required")
+	}
+	if oaiConfig.BaseURL == "" {
+		return fmt.Errorf("oai base url is required")
+	}
+	if !strings.Contains(oaiConfig.AdminEmail, "@") {
+		return fmt.Errorf("oai admin email must be an email address")
+	}
+	if !strings.Contains(oaiConfig.RepositoryIdentifier, ".") || strings.Contains(oaiConfig.RepositoryIdentifier, ":") {
+		return fmt.Errorf("oai repository identifier must be a domain name")
+	}
+	if oaiConfig.PageSize <= 0 {
+		return fmt.Errorf("oai page size must be positive")
+	}
+	return nil
+}
+
+// validateSRUConfig ensures that the explain record can locate the endpoint and that result
+// pages are bounded.
+func validateSRUConfig(sruConfig SRU) error {
+	if u, err := url.Parse(sruConfig.BaseURL); err != nil || u.Host == "" {
+		return fmt.Errorf("sru base url must be an absolute url")
+	}
+	if sruConfig.DefaultRecords <= 0 {
+		return fmt.Errorf("sru default records must be positive")
+	}
+	if sruConfig.MaxRecords < sruConfig.DefaultRecords {
+		return fmt.Errorf("sru max records cannot be below the default records")
+	}
+	return nil
+}
+
+// validateStorageConfig ensures that the storage driver is known and usable.
+func validateStorageConfig(storageConfig Storage) error {
+	switch storageConfig.Driver {
+	case "local":
+		if storageConfig.LocalDir == "" {
+			return fmt.Errorf("storage local dir is required")
+		}
+	default:
+		return fmt.Errorf("unsupported storage driver %q: must be 'local'", storageConfig.Driver)
+	}
+	return nil
+}
+
+// validateCoverConfig ensures that cover uploads are bounded and thumbnails have a size.
+func validateCoverConfig(coverConfig Cover) error {
+	if coverConfig.MaxBytes <= 0 {
+		return fmt.Errorf("cover max bytes must be positive")
+	}
+	if coverConfig.MaxPixels <= 0 {
+		return fmt.Errorf("cover max pixels must be positive")
+	}
+	if coverConfig.ThumbnailWidth <= 0 || coverConfig.ThumbnailHeight <= 0 {
+		return fmt.Errorf("cover thumbnail width and height must be positive")
+	}
+	return nil
+}
+
+// validateGRPCConfig ensures that the gRPC server has an address to listen on.
+func validateGRPCConfig(grpcConfig GRPC) error {
+	if grpcConfig.Address == "" {
+		return fmt.Errorf("grpc address is required")
+	}
+	if grpcConfig.UnaryTimeout <= 0 {
+		return fmt.Errorf("grpc unary timeout must be positive")
+	}
+	if grpcConfig.StreamIdleTimeout <= 0 {
+		return fmt.Errorf("grpc stream idle timeout must be positive")
+	}
+	return nil
+}
+
+// validatePeersConfig ensures that the services called by this one can be reached.
+func validatePeersConfig(peersConfig Peers) error {
+	if err := peersConfig.Auth.Validate("auth service"); err != nil {
+		return err
+	}
+	if err := peersConfig.Auth.Policy.Validate("auth service"); err != nil {
+		return err
+	}
+	return nil
+}
+
 // RunConfig initializes and loads the configuration.
 func RunConfig(path string) {
 	config, err := LoadConfig(path)
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// Author is a person credited with books. Name is the preferred form of the name; spellings
+// that only differ in case, punctuation or inversion, such as "Tolkien, J.R.R." and
+// "J. R. R. Tolkien", refer to the same author.
+type Author struct {
+	ID        uint
+	Name      string
+	BookCount uint
+	CreatedAt time.Time
+}
+
+// AuthorFilter narrows a list of authors. Name matches part of the name, ignoring case.
+type AuthorFilter struct {
+	Name string
+}
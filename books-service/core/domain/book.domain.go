@@ -2,15 +2,135 @@ package domain
 
 import "time"
 
+// Book is a bibliographic record. Authors are the credited authors in order; Author holds their
+// names separated by semicolons, as displayed and searched. A book is an edition of its Work,
+// when it has one, and volume SeriesVolume of its Series. Tags are sorted by name.
 type Book struct {
-	ID            uint
-	Title         string
-	Author        string
-	Category      string
-	Subject       string
-	Genre         string
-	PublishedYear uint
-	Available     bool
-	BorrowerID    uint
-	CreatedAt     time.Time
+	ID              uint
+	Title           string
+	Author          string
+	Authors         []Author
+	Tags            []Tag
+	Category        string
+	Subject         string
+	Genre           string
+	PublishedYear   uint
+	ISBN10          string
+	ISBN13          string
+	Work            *Work
+	Edition         string
+	Language        string
+	Format          BookFormat
+	Series          *Series
+	SeriesVolume    uint
+	Cover           *Cover
+	TotalCopies     uint
+	AvailableCopies uint
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// BookFilter narrows a list of books. Criteria left at their zero value are not applied.
+type BookFilter struct {
+	YearFrom  uint
+	YearTo    uint
+	Available *bool
+	Author    string
+	Category  string
+	Subject   string
+	Genre     string
+	// Tags are matched ignoring case; a book has to carry all of them.
+	Tags []string
+}
+
+// BookSearch is a catalogue search. Query is free text matched against the title, author,
+// subject and genre, while the other fields each match a single column. Every criterion
+// tolerates typos; a book has to match all of the criteria that are set.
+type BookSearch struct {
+	Query    string
+	Title    string
+	Author   string
+	Category string
+}
+
+// BookMatch is a book found by a search. Score grows with the relevance of the match and
+// Highlight is the book's text with the matched words wrapped in <mark> tags.
+type BookMatch struct {
+	Book      Book
+	Score     float64
+	Highlight string
+}
+
+// Facet is a value of a book field together with the number of books of a result having it.
+type Facet struct {
+	Value string
+	Count uint
+}
+
+// BookFacets break the books of a search result down by field, most common value first, so
+// that the result can be narrowed further. Decades are named by their first year and
+// Availability tells "available" books, with a copy on the shelf, from "unavailable" ones.
+type BookFacets struct {
+	Genres       []Facet
+	Subjects     []Facet
+	Tags         []Facet
+	Decades      []Facet
+	Availability []Facet
+}
+
+// BookField is a field of a book that a BookCondition can match.
+type BookField string
+
+const (
+	// BookFieldAny matches the title, author or subject.
+	BookFieldAny     BookField = "any"
+	BookFieldTitle   BookField = "title"
+	BookFieldAuthor  BookField = "author"
+	BookFieldSubject BookField = "subject"
+)
+
+// BookMatchMode tells how the terms of a BookCondition are matched.
+type BookMatchMode string
+
+const (
+	// BookMatchPhrase matches the terms as a single phrase.
+	BookMatchPhrase BookMatchMode = "phrase"
+	// BookMatchAnyWord matches any of the space separated words of the terms.
+	BookMatchAnyWord BookMatchMode = "any"
+	// BookMatchAllWords matches all of the space separated words of the terms.
+	BookMatchAllWords BookMatchMode = "all"
+)
+
+// BookConditionOp combines the operands of a BookCondition.
+type BookConditionOp string
+
+const (
+	BookConditionMatch BookConditionOp = ""
+	BookConditionAll   BookConditionOp = "all"
+	BookConditionAnd   BookConditionOp = "and"
+	BookConditionOr    BookConditionOp = "or"
+	// BookConditionAndNot matches the left operand unless the right one matches too.
+	BookConditionAndNot BookConditionOp = "and not"
+)
+
+// BookCondition is a boolean combination of criteria on books. A match condition checks Field
+// against Terms, ignoring case; a phrase or word matches anywhere in the field. Terms may be
+// masked: * stands for any run of characters, ? for a single one, and a backslash makes the next
+// character literal. An all condition matches every book.
+type BookCondition struct {
+	Op    BookConditionOp
+	Left  *BookCondition
+	Right *BookCondition
+	Field BookField
+	Mode  BookMatchMode
+	Terms string
+}
+
+// SearchService describes the catalogue's search endpoint to partners. Result pages hold
+// DefaultRecords books unless another size, up to MaxRecords, is asked for.
+type SearchService struct {
+	BaseURL        string
+	Title          string
+	DefaultRecords int
+	MaxRecords     int
 }
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// CopyStatus describes where a physical copy of a book currently is.
+type CopyStatus string
+
+const (
+	CopyStatusAvailable   CopyStatus = "available"
+	CopyStatusBorrowed    CopyStatus = "borrowed"
+	CopyStatusOnHold      CopyStatus = "on_hold"
+	CopyStatusMaintenance CopyStatus = "maintenance"
+	CopyStatusLost        CopyStatus = "lost"
+)
+
+// Copy is a single physical item of a bibliographic Book record.
+type Copy struct {
+	ID            uint
+	BookID        uint
+	Barcode       string
+	ShelfLocation string
+	Status        CopyStatus
+	BorrowerID    uint
+	CreatedAt     time.Time
+}
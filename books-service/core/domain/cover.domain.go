@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Cover is the cover image of a book. The image and its thumbnail are kept in file storage
+// under Key and ThumbnailKey; the thumbnail is always a JPEG.
+type Cover struct {
+	Key          string
+	ThumbnailKey string
+	ContentType  string
+	UpdatedAt    time.Time
+}
+
+// CoverSize selects the original cover image or its thumbnail.
+type CoverSize string
+
+const (
+	CoverSizeOriginal  CoverSize = "original"
+	CoverSizeThumbnail CoverSize = "thumbnail"
+)
+
+// CoverImage is the content of a stored cover image.
+type CoverImage struct {
+	Key         string
+	ContentType string
+	Data        []byte
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// FineKind tells whether a ledger entry adds to or settles a patron's balance.
+type FineKind string
+
+const (
+	FineKindCharge  FineKind = "charge"
+	FineKindPayment FineKind = "payment"
+	FineKindWaiver  FineKind = "waiver"
+)
+
+// Fine is a single entry of the fines ledger. Amounts are in cents and always positive;
+// the kind decides whether the entry is owed or paid off.
+type Fine struct {
+	ID          uint
+	UserID      uint
+	LoanID      uint
+	Kind        FineKind
+	AmountCents int64
+	Note        string
+	CreatedBy   uint
+	CreatedAt   time.Time
+}
+
+// FineBalance sums up the ledger of a patron. Entries holds the ledger itself when it was requested.
+type FineBalance struct {
+	UserID       uint
+	ChargedCents int64
+	PaidCents    int64
+	WaivedCents  int64
+	Entries      []Fine
+}
+
+// OutstandingCents returns what the patron still owes.
+func (b FineBalance) OutstandingCents() int64 {
+	return b.ChargedCents - b.PaidCents - b.WaivedCents
+}
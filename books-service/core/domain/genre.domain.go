@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// Genre is a term of the genre vocabulary. Synonyms are alternative names that resolve to the
+// genre, such as "Sci-Fi" for "Science Fiction". BookCount is the number of books of the genre.
+type Genre struct {
+	ID        uint
+	Name      string
+	Synonyms  []string
+	BookCount uint
+	CreatedAt time.Time
+}
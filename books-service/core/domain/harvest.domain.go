@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// HarvestRecord is a book as seen by metadata harvesters. Its datestamp is the time of the last
+// change to the book. A deleted book is kept as a tombstone carrying only its ID and the time
+// of deletion.
+type HarvestRecord struct {
+	BookID    uint
+	Datestamp time.Time
+	Deleted   bool
+	Book      Book
+}
+
+// HarvestQuery selects the records whose datestamp lies in [From, Until). Bounds left at their
+// zero value are not applied. Records are listed by datestamp and book ID; AfterDatestamp and
+// AfterID resume a listing after the last record handed out.
+type HarvestQuery struct {
+	From           time.Time
+	Until          time.Time
+	AfterDatestamp time.Time
+	AfterID        uint
+	Limit          int
+}
+
+// HarvestPage is one page of a harvest. More tells whether further records follow.
+type HarvestPage struct {
+	Records []HarvestRecord
+	More    bool
+}
+
+// HarvestIdentity describes the catalogue to harvesters. Identifier is the domain name that
+// prefixes the identifiers of its records.
+type HarvestIdentity struct {
+	Name       string
+	BaseURL    string
+	AdminEmail string
+	Identifier string
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// HoldStatus describes how far a reservation has progressed through the queue.
+type HoldStatus string
+
+const (
+	HoldStatusWaiting   HoldStatus = "waiting"
+	HoldStatusReady     HoldStatus = "ready"
+	HoldStatusFulfilled HoldStatus = "fulfilled"
+	HoldStatusCancelled HoldStatus = "cancelled"
+	HoldStatusExpired   HoldStatus = "expired"
+)
+
+// Hold is a patron's place in the FIFO reservation queue of a book.
+// Once a copy comes back it is set aside on the hold shelf until ExpiresAt. A hold on any edition
+// is also served by copies of the other editions of the book's work.
+type Hold struct {
+	ID         uint
+	BookID     uint
+	UserID     uint
+	CopyID     uint
+	AnyEdition bool
+	Status     HoldStatus
+	Position   uint
+	CreatedAt  time.Time
+	ReadyAt    time.Time
+	ExpiresAt  time.Time
+}
+
+// IsOpen reports whether the hold is still waiting for, or sitting on, a copy.
+func (h Hold) IsOpen() bool {
+	return h.Status == HoldStatusWaiting || h.Status == HoldStatusReady
+}
@@ -0,0 +1,37 @@
+package domain
+
+// ImportStatus is the outcome of a single row of a catalogue import.
+type ImportStatus string
+
+const (
+	ImportStatusPending ImportStatus = "pending"
+	ImportStatusCreated ImportStatus = "created"
+	ImportStatusSkipped ImportStatus = "skipped"
+	ImportStatusFailed  ImportStatus = "failed"
+)
+
+// ImportRow is one record of an import file. Line locates the record in the file so that
+// failures can be fixed at the source.
+type ImportRow struct {
+	Line   uint
+	Book   Book
+	Status ImportStatus
+	Reason string
+}
+
+// ImportReport summarizes an import row by row. In a dry run nothing is written and the
+// statuses tell what a real import of the same file would do.
+type ImportReport struct {
+	DryRun  bool
+	Created uint
+	Skipped uint
+	Failed  uint
+	Rows    []ImportRow
+}
+
+// ImportLimits bound a single import file, so that an upload cannot exhaust the memory of the
+// service before its rows are counted.
+type ImportLimits struct {
+	MaxRows  int
+	MaxBytes int64
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Loan records a single checkout of a copy, from the moment it is borrowed until it is returned.
+type Loan struct {
+	ID         uint
+	CopyID     uint
+	BookID     uint
+	UserID     uint
+	BorrowedAt time.Time
+	DueAt      time.Time
+	ReturnedAt time.Time
+	BorrowedBy uint
+	ReturnedBy uint
+	Renewals   uint
+}
+
+// IsActive reports whether the loaned copy is still out.
+func (l Loan) IsActive() bool {
+	return l.ReturnedAt.IsZero()
+}
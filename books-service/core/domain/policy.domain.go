@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// LoanPolicy holds the circulation rules for lending and renewing copies.
+type LoanPolicy struct {
+	Period         time.Duration
+	MaxRenewals    uint
+	MaxActiveLoans uint
+}
+
+// DueDate returns when a copy borrowed at the given time has to be back.
+func (p LoanPolicy) DueDate(borrowedAt time.Time) time.Time {
+	return borrowedAt.Add(p.Period)
+}
+
+// AllowsBorrow reports whether a patron holding activeLoans copies may borrow another one.
+func (p LoanPolicy) AllowsBorrow(activeLoans uint) bool {
+	return activeLoans < p.MaxActiveLoans
+}
+
+// AllowsRenewal reports whether the loan may be extended once more.
+func (p LoanPolicy) AllowsRenewal(loan Loan) bool {
+	return loan.IsActive() && loan.Renewals < p.MaxRenewals
+}
+
+// RenewedDueDate extends the loan by one period, counted from whichever is later of now and the current due date.
+func (p LoanPolicy) RenewedDueDate(loan Loan, now time.Time) time.Time {
+	from := now
+	if loan.DueAt.After(now) {
+		from = loan.DueAt
+	}
+	return from.Add(p.Period)
+}
+
+// FinePolicy holds the rules for charging late returns. Amounts are in cents.
+type FinePolicy struct {
+	GracePeriod         time.Duration
+	DailyRateCents      int64
+	MaxPerLoanCents     int64
+	BlockThresholdCents int64
+}
+
+// Accrued returns the total fine owed for the loan as of now. Every started day past the
+// due date is charged once the grace period is over, up to the per-loan cap.
+func (p FinePolicy) Accrued(loan Loan, now time.Time) int64 {
+	if loan.DueAt.IsZero() {
+		return 0
+	}
+	end := now
+	if !loan.IsActive() {
+		end = loan.ReturnedAt
+	}
+	late := end.Sub(loan.DueAt)
+	if late <= p.GracePeriod {
+		return 0
+	}
+
+	days := int64((late + 24*time.Hour - 1) / (24 * time.Hour))
+	amount := days * p.DailyRateCents
+	if p.MaxPerLoanCents > 0 && amount > p.MaxPerLoanCents {
+		amount = p.MaxPerLoanCents
+	}
+	return amount
+}
+
+// AllowsBorrow reports whether a patron owing outstandingCents may still borrow.
+func (p FinePolicy) AllowsBorrow(outstandingCents int64) bool {
+	return outstandingCents <= p.BlockThresholdCents
+}
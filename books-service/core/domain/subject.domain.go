@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// Subject is a heading of the subject vocabulary. A subject with a parent is narrower than its
+// parent, so books about it are also about the parent. BookCount is the number of books
+// filed under the subject itself.
+type Subject struct {
+	ID        uint
+	Name      string
+	ParentID  uint
+	BookCount uint
+	CreatedAt time.Time
+}
@@ -0,0 +1,17 @@
+package domain
+
+// SuggestionField is the book column a suggestion completes.
+type SuggestionField string
+
+const (
+	SuggestionFieldTitle  SuggestionField = "title"
+	SuggestionFieldAuthor SuggestionField = "author"
+)
+
+// Suggestion completes a typed prefix to a distinct title or author.
+// Count is the number of books carrying that value.
+type Suggestion struct {
+	Field SuggestionField
+	Value string
+	Count uint
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// Tag is a free-form label librarians attach to books. BookCount is the number of books
+// carrying the tag.
+type Tag struct {
+	ID        uint
+	Name      string
+	BookCount uint
+	CreatedAt time.Time
+}
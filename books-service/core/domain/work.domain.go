@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// Work groups the editions of the same text, such as its printings, formats and translations.
+// Editions lists the books of the work when it is read on its own.
+type Work struct {
+	ID        uint
+	Title     string
+	Editions  []Book
+	CreatedAt time.Time
+}
+
+// Series is a sequence of books published under a common title.
+type Series struct {
+	ID        uint
+	Title     string
+	CreatedAt time.Time
+}
+
+// BookFormat is the physical or digital form an edition is published in.
+type BookFormat string
+
+const (
+	BookFormatHardcover BookFormat = "hardcover"
+	BookFormatPaperback BookFormat = "paperback"
+	BookFormatEbook     BookFormat = "ebook"
+	BookFormatAudiobook BookFormat = "audiobook"
+)
+
+// IsValid reports whether the format is one of the known formats. The empty format, for books
+// whose format is not recorded, is valid.
+func (f BookFormat) IsValid() bool {
+	switch f {
+	case "", BookFormatHardcover, BookFormatPaperback, BookFormatEbook, BookFormatAudiobook:
+		return true
+	}
+	return false
+}
@@ -3,15 +3,140 @@ package ports
 import (
 	"context"
 	"library-management-api/books-service/core/domain"
+	"library-management-api/util/listquery"
+	"time"
 )
 
 type BookRepository interface {
 	AddBook(ctx context.Context, book domain.Book) (domain.Book, error)
-	GetBooks(ctx context.Context) ([]domain.Book, error)
+	GetBooks(ctx context.Context, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error)
 	GetBook(ctx context.Context, book domain.Book) (domain.Book, error)
+	GetBookByISBN(ctx context.Context, book domain.Book) (domain.Book, error)
 	UpdateBook(ctx context.Context, book domain.Book) (domain.Book, error)
 	DeleteBook(ctx context.Context, book domain.Book) error
-	SearchBooks(ctx context.Context, book domain.Book) ([]domain.Book, error)
-	CategoryBooks(ctx context.Context, book domain.Book) ([]domain.Book, error)
-	AvailableBooks(ctx context.Context) ([]domain.Book, error)
+	// UpdateBookTags replaces the tags of the book, creating the ones that are new.
+	UpdateBookTags(ctx context.Context, book domain.Book) (domain.Book, error)
+	// ReplaceBookCover sets the cover of the book, or removes it when book.Cover is nil, and
+	// returns the cover it replaced, if any.
+	ReplaceBookCover(ctx context.Context, book domain.Book) (*domain.Cover, error)
+	SearchBooks(ctx context.Context, search domain.BookSearch, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.BookMatch], error)
+	// FacetBooks counts the books matching the search and filter per value of each facet.
+	FacetBooks(ctx context.Context, search domain.BookSearch, filter domain.BookFilter) (domain.BookFacets, error)
+	CategoryBooks(ctx context.Context, book domain.Book, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error)
+	AvailableBooks(ctx context.Context, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error)
+	AuthorBooks(ctx context.Context, author domain.Author, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error)
+	// FindBooks returns limit books matching the condition, in ID order, starting at offset.
+	FindBooks(ctx context.Context, condition domain.BookCondition, offset int, limit int) (listquery.Page[domain.Book], error)
+	GetSuggestions(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error)
+	GetBooksByISBN(ctx context.Context, isbns []string) ([]domain.Book, error)
+	// ImportBooks adds the pending rows in a single transaction and reports the outcome of each.
+	ImportBooks(ctx context.Context, rows []domain.ImportRow) ([]domain.ImportRow, error)
+	// ExportBooks calls fn with every book in ID order without loading the whole catalogue.
+	ExportBooks(ctx context.Context, fn func(book domain.Book) error) error
+	// HarvestBooks lists books and deletion tombstones by datestamp, asking for one record more
+	// than the limit so that callers can tell whether another page follows.
+	HarvestBooks(ctx context.Context, query domain.HarvestQuery) ([]domain.HarvestRecord, error)
+	GetHarvestRecord(ctx context.Context, book domain.Book) (domain.HarvestRecord, error)
+	// EarliestDatestamp is the zero time when neither books nor tombstones exist.
+	EarliestDatestamp(ctx context.Context) (time.Time, error)
+	// Circulation state transitions; each one runs in a single transaction.
+	BorrowCopy(ctx context.Context, loan domain.Loan, loanPolicy domain.LoanPolicy, finePolicy domain.FinePolicy) (domain.Loan, error)
+	ReturnCopy(ctx context.Context, loan domain.Loan, hold domain.Hold, finePolicy domain.FinePolicy) (domain.Loan, error)
+	ShelveCopy(ctx context.Context, bookCopy domain.Copy, hold domain.Hold) (domain.Copy, error)
+}
+
+// FileStorage keeps uploaded files, such as cover images, under keys chosen by the caller.
+// Keys are slash separated paths.
+type FileStorage interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	// Get returns errorhandler.ErrFileNotFound when nothing is stored under the key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the file stored under the key. Deleting a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+type AuthorRepository interface {
+	AddAuthor(ctx context.Context, author domain.Author) (domain.Author, error)
+	GetAuthors(ctx context.Context, filter domain.AuthorFilter, query listquery.Query) (listquery.Page[domain.Author], error)
+	GetAuthor(ctx context.Context, author domain.Author) (domain.Author, error)
+	// UpdateAuthor also rewrites the author names of the books credited to the author.
+	UpdateAuthor(ctx context.Context, author domain.Author) (domain.Author, error)
+	DeleteAuthor(ctx context.Context, author domain.Author) error
+}
+
+type WorkRepository interface {
+	AddWork(ctx context.Context, work domain.Work) (domain.Work, error)
+	// GetWork returns the work together with its editions.
+	GetWork(ctx context.Context, work domain.Work) (domain.Work, error)
+}
+
+type SubjectRepository interface {
+	AddSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error)
+	// GetSubjects lists every subject by name.
+	GetSubjects(ctx context.Context) ([]domain.Subject, error)
+	GetSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error)
+	// GetSubjectByName finds a subject by name, ignoring case.
+	GetSubjectByName(ctx context.Context, name string) (domain.Subject, error)
+	// UpdateSubject also rewrites the subject of the books filed under the old name.
+	UpdateSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error)
+	DeleteSubject(ctx context.Context, subject domain.Subject) error
+	// MergeSubject files the books and narrower subjects of source under target and deletes source.
+	MergeSubject(ctx context.Context, source domain.Subject, target domain.Subject) (domain.Subject, error)
+}
+
+type GenreRepository interface {
+	AddGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error)
+	// GetGenres lists every genre by name.
+	GetGenres(ctx context.Context) ([]domain.Genre, error)
+	GetGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error)
+	// GetGenreByName finds a genre by its name or one of its synonyms, ignoring case.
+	GetGenreByName(ctx context.Context, name string) (domain.Genre, error)
+	// UpdateGenre replaces the name and synonyms of the genre and rewrites the genre of the
+	// books using the old name.
+	UpdateGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error)
+	DeleteGenre(ctx context.Context, genre domain.Genre) error
+	// MergeGenre moves the books of source to target, makes the name and synonyms of source
+	// synonyms of target and deletes source.
+	MergeGenre(ctx context.Context, source domain.Genre, target domain.Genre) (domain.Genre, error)
+}
+
+type TagRepository interface {
+	AddTag(ctx context.Context, tag domain.Tag) (domain.Tag, error)
+	// GetTags lists every tag by name.
+	GetTags(ctx context.Context) ([]domain.Tag, error)
+	UpdateTag(ctx context.Context, tag domain.Tag) (domain.Tag, error)
+	// DeleteTag also removes the tag from the books carrying it.
+	DeleteTag(ctx context.Context, tag domain.Tag) error
+}
+
+type CopyRepository interface {
+	AddCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error)
+	GetCopies(ctx context.Context, bookCopy domain.Copy) ([]domain.Copy, error)
+	GetCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error)
+	UpdateCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error)
+	DeleteCopy(ctx context.Context, bookCopy domain.Copy) error
+	GetHoldableCopies(ctx context.Context) ([]domain.Copy, error)
+}
+
+type LoanRepository interface {
+	AddLoan(ctx context.Context, loan domain.Loan) (domain.Loan, error)
+	GetLoans(ctx context.Context, loan domain.Loan) ([]domain.Loan, error)
+	GetActiveLoan(ctx context.Context, loan domain.Loan) (domain.Loan, error)
+	RenewLoan(ctx context.Context, loan domain.Loan, policy domain.LoanPolicy, now time.Time) (domain.Loan, error)
+	GetOverdueLoans(ctx context.Context, dueBefore time.Time) ([]domain.Loan, error)
+}
+
+type HoldRepository interface {
+	AddHold(ctx context.Context, hold domain.Hold) (domain.Hold, error)
+	GetHolds(ctx context.Context, hold domain.Hold) ([]domain.Hold, error)
+	GetHold(ctx context.Context, hold domain.Hold) (domain.Hold, error)
+	CloseHold(ctx context.Context, hold domain.Hold, next domain.Hold) (domain.Hold, error)
+	GetExpiredHolds(ctx context.Context, now time.Time) ([]domain.Hold, error)
+}
+
+type FineRepository interface {
+	SettleFine(ctx context.Context, fine domain.Fine) (domain.Fine, error)
+	GetFines(ctx context.Context, fine domain.Fine) ([]domain.Fine, error)
+	GetBalance(ctx context.Context, fine domain.Fine) (domain.FineBalance, error)
+	ChargeLoan(ctx context.Context, loan domain.Loan, policy domain.FinePolicy, now time.Time) (domain.Fine, error)
 }
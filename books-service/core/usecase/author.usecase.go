@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/listquery"
+	"strings"
+)
+
+type AuthorUseCase struct {
+	authorRepository ports.AuthorRepository
+	authService      *auth.AuthService
+}
+
+func NewAuthorUseCase() *AuthorUseCase {
+	return &AuthorUseCase{
+		authorRepository: repository.NewAuthorRepository(),
+		authService:      auth.NewAuthService(),
+	}
+}
+
+// normalizeAuthorName collapses the whitespace of a name. A name cannot hold the separator of
+// co-authors, as it would be split apart when the author field of a book is read back.
+func normalizeAuthorName(author *domain.Author) error {
+	author.Name = strings.Join(strings.Fields(author.Name), " ")
+	if author.Name == "" || strings.Contains(author.Name, authorSeparator) {
+		return errorhandler.ErrInvalidAuthorName
+	}
+	return nil
+}
+
+func (a *AuthorUseCase) AddAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Author{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := a.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Author{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Author{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeAuthorName(&author); err != nil {
+		return domain.Author{}, err
+	}
+
+	addedAuthor, err := a.authorRepository.AddAuthor(ctx, author)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	return addedAuthor, nil
+}
+
+func (a *AuthorUseCase) GetAuthors(ctx context.Context, filter domain.AuthorFilter, query listquery.Query) (listquery.Page[domain.Author], error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return listquery.Page[domain.Author]{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := a.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return listquery.Page[domain.Author]{}, sessionError(err)
+	}
+
+	query, err = query.Normalize()
+	if err != nil {
+		return listquery.Page[domain.Author]{}, err
+	}
+
+	authors, err := a.authorRepository.GetAuthors(ctx, filter, query)
+	if err != nil {
+		return listquery.Page[domain.Author]{}, err
+	}
+	return authors, nil
+}
+
+func (a *AuthorUseCase) GetAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Author{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := a.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Author{}, sessionError(err)
+	}
+
+	foundAuthor, err := a.authorRepository.GetAuthor(ctx, author)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	return foundAuthor, nil
+}
+
+// UpdateAuthor renames an author. The books credited to the author show the new name.
+func (a *AuthorUseCase) UpdateAuthor(ctx context.Context, author domain.Author) (domain.Author, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Author{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := a.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Author{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Author{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeAuthorName(&author); err != nil {
+		return domain.Author{}, err
+	}
+
+	updatedAuthor, err := a.authorRepository.UpdateAuthor(ctx, author)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	return updatedAuthor, nil
+}
+
+func (a *AuthorUseCase) DeleteAuthor(ctx context.Context, author domain.Author) error {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := a.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return errorhandler.ErrForbidden
+	}
+
+	return a.authorRepository.DeleteAuthor(ctx, author)
+}
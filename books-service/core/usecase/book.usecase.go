@@ -2,23 +2,198 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"library-management-api/books-service/adapter/cache"
 	"library-management-api/books-service/adapter/repository"
 	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/adapter/storage"
+	"library-management-api/books-service/configs"
 	"library-management-api/books-service/core/domain"
 	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/pkg/isbn"
 	"library-management-api/util/errorhandler"
+	"library-management-api/util/listquery"
+	"strings"
+	"time"
 )
 
 type BookUseCase struct {
-	bookRepository ports.BookRepository
-	authService    *auth.AuthService
+	bookRepository    ports.BookRepository
+	authorRepository  ports.AuthorRepository
+	copyRepository    ports.CopyRepository
+	loanRepository    ports.LoanRepository
+	subjectRepository ports.SubjectRepository
+	genreRepository   ports.GenreRepository
+	fileStorage       ports.FileStorage
+	authService       *auth.AuthService
+	loanPolicy        domain.LoanPolicy
+	holdShelf         holdShelf
+	fineLedger        fineLedger
+	suggestConfig     configs.Suggest
+	suggestions       *cache.Cache[suggestionKey, []domain.Suggestion]
+}
+
+// suggestionKey identifies a cached suggestion lookup.
+type suggestionKey struct {
+	prefix string
+	limit  int
 }
 
 func NewBookUseCase() *BookUseCase {
+	loanConfig := configs.C().Loan
+	suggestConfig := configs.C().Suggest
+
 	return &BookUseCase{
-		bookRepository: repository.NewBookRepository(),
-		authService:    auth.NewAuthService(),
+		bookRepository:    repository.NewBookRepository(),
+		authorRepository:  repository.NewAuthorRepository(),
+		copyRepository:    repository.NewCopyRepository(),
+		loanRepository:    repository.NewLoanRepository(),
+		subjectRepository: repository.NewSubjectRepository(),
+		genreRepository:   repository.NewGenreRepository(),
+		fileStorage:       storage.NewFileStorage(),
+		authService:       auth.NewAuthService(),
+		loanPolicy: domain.LoanPolicy{
+			Period:         loanConfig.Period,
+			MaxRenewals:    loanConfig.MaxRenewals,
+			MaxActiveLoans: loanConfig.MaxActiveLoans,
+		},
+		holdShelf:     newHoldShelf(),
+		fineLedger:    newFineLedger(),
+		suggestConfig: suggestConfig,
+		suggestions:   cache.New[suggestionKey, []domain.Suggestion](suggestConfig.CacheTTL, suggestConfig.CacheSize),
+	}
+}
+
+// normalizeBookList fills in the list defaults and rejects filters that can never match.
+func normalizeBookList(filter domain.BookFilter, query listquery.Query) (listquery.Query, error) {
+	if filter.YearFrom > 0 && filter.YearTo > 0 && filter.YearFrom > filter.YearTo {
+		return listquery.Query{}, fmt.Errorf("%w: year_from is after year_to", errorhandler.ErrInvalidListQuery)
+	}
+	return query.Normalize()
+}
+
+// normalizeISBN validates the ISBNs of the book and fills in the form that was left out, so
+// that both are stored normalized and the book can be found by either.
+func normalizeISBN(book *domain.Book) error {
+	var from10, from13 string
+	if book.ISBN10 != "" {
+		book.ISBN10 = isbn.Normalize(book.ISBN10)
+		converted, err := isbn.To13(book.ISBN10)
+		if err != nil {
+			return err
+		}
+		from10 = converted
+	}
+	if book.ISBN13 != "" {
+		book.ISBN13 = isbn.Normalize(book.ISBN13)
+		if err := isbn.Validate13(book.ISBN13); err != nil {
+			return err
+		}
+		from13 = book.ISBN13
+	}
+
+	switch {
+	case from10 != "" && from13 != "":
+		if from10 != from13 {
+			return errorhandler.ErrISBNMismatch
+		}
+	case from10 != "":
+		book.ISBN13 = from10
+	case from13 != "":
+		// 979 ISBNs have no ISBN-10 equivalent
+		if converted, ok, _ := isbn.To10(from13); ok {
+			book.ISBN10 = converted
+		}
+	}
+	return nil
+}
+
+// normalizeEdition validates the edition details of the book and stores its language in
+// lower case.
+func normalizeEdition(book *domain.Book) error {
+	if !book.Format.IsValid() {
+		return errorhandler.ErrInvalidBookFormat
+	}
+	if book.Series != nil {
+		book.Series.Title = strings.Join(strings.Fields(book.Series.Title), " ")
+		if book.Series.ID == 0 && book.Series.Title == "" {
+			book.Series = nil
+		}
+	}
+	if book.SeriesVolume > 0 && book.Series == nil {
+		return errorhandler.ErrSeriesRequired
 	}
+	book.Edition = strings.TrimSpace(book.Edition)
+	book.Language = strings.ToLower(strings.TrimSpace(book.Language))
+	return nil
+}
+
+// authorSeparator separates the names of co-authors in the author field of a book.
+const authorSeparator = ";"
+
+// splitAuthors credits a book given only by its author field with the authors named there,
+// separated by semicolons.
+func splitAuthors(book *domain.Book) error {
+	if len(book.Authors) == 0 {
+		for _, name := range strings.Split(book.Author, authorSeparator) {
+			if name = strings.Join(strings.Fields(name), " "); name != "" {
+				book.Authors = append(book.Authors, domain.Author{Name: name})
+			}
+		}
+	}
+	if len(book.Authors) == 0 {
+		return errorhandler.ErrBookAuthorRequired
+	}
+
+	names := make([]string, len(book.Authors))
+	for i, author := range book.Authors {
+		names[i] = author.Name
+	}
+	book.Author = strings.Join(names, authorSeparator+" ")
+	if runes := []rune(book.Author); len(runes) > 255 {
+		book.Author = string(runes[:255])
+	}
+	return nil
+}
+
+// resolveAuthors looks up the authors a book is credited with by ID, so that unknown authors are
+// rejected before anything is written, and then fills in the author field.
+func resolveAuthors(ctx context.Context, authorRepository ports.AuthorRepository, book *domain.Book) error {
+	for i, author := range book.Authors {
+		foundAuthor, err := authorRepository.GetAuthor(ctx, author)
+		if err != nil {
+			return err
+		}
+		book.Authors[i] = foundAuthor
+	}
+	return splitAuthors(book)
+}
+
+// resolveVocabulary checks the subject and genre of a book against the controlled vocabulary
+// and replaces them with the preferred terms, so that "sci-fi" is stored as "Science Fiction".
+func resolveVocabulary(ctx context.Context, subjectRepository ports.SubjectRepository, genreRepository ports.GenreRepository, book *domain.Book) error {
+	if book.Subject != "" {
+		foundSubject, err := subjectRepository.GetSubjectByName(ctx, normalizeTermName(book.Subject))
+		if err != nil {
+			if errors.Is(err, errorhandler.ErrSubjectNotFound) {
+				return errorhandler.ErrUnknownSubject
+			}
+			return err
+		}
+		book.Subject = foundSubject.Name
+	}
+	if book.Genre != "" {
+		foundGenre, err := genreRepository.GetGenreByName(ctx, normalizeTermName(book.Genre))
+		if err != nil {
+			if errors.Is(err, errorhandler.ErrGenreNotFound) {
+				return errorhandler.ErrUnknownGenre
+			}
+			return err
+		}
+		book.Genre = foundGenre.Name
+	}
+	return nil
 }
 
 func (b *BookUseCase) AddBook(ctx context.Context, book domain.Book) (domain.Book, error) {
@@ -32,7 +207,20 @@ func (b *BookUseCase) AddBook(ctx context.Context, book domain.Book) (domain.Boo
 	}
 	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.Book{}, errorhandler.ErrInvalidSession
+		return domain.Book{}, sessionError(err)
+	}
+
+	if err := normalizeISBN(&book); err != nil {
+		return domain.Book{}, err
+	}
+	if err := normalizeEdition(&book); err != nil {
+		return domain.Book{}, err
+	}
+	if err := resolveAuthors(ctx, b.authorRepository, &book); err != nil {
+		return domain.Book{}, err
+	}
+	if err := resolveVocabulary(ctx, b.subjectRepository, b.genreRepository, &book); err != nil {
+		return domain.Book{}, err
 	}
 
 	addedBook, err := b.bookRepository.AddBook(ctx, book)
@@ -42,10 +230,10 @@ func (b *BookUseCase) AddBook(ctx context.Context, book domain.Book) (domain.Boo
 	return addedBook, nil
 }
 
-func (b *BookUseCase) GetBooks(ctx context.Context) ([]domain.Book, error) {
+func (b *BookUseCase) GetBooks(ctx context.Context, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, errorhandler.ErrInvalidSession
 	}
 
 	verifyTokenReq := domain.Auth{
@@ -53,12 +241,17 @@ func (b *BookUseCase) GetBooks(ctx context.Context) ([]domain.Book, error) {
 	}
 	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, sessionError(err)
 	}
 
-	books, err := b.bookRepository.GetBooks(ctx)
+	query, err = normalizeBookList(filter, query)
 	if err != nil {
-		return []domain.Book{}, err
+		return listquery.Page[domain.Book]{}, err
+	}
+
+	books, err := b.bookRepository.GetBooks(ctx, filter, query)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
 	}
 	return books, nil
 }
@@ -74,7 +267,7 @@ func (b *BookUseCase) GetBook(ctx context.Context, book domain.Book) (domain.Boo
 	}
 	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.Book{}, errorhandler.ErrInvalidSession
+		return domain.Book{}, sessionError(err)
 	}
 
 	foundBook, err := b.bookRepository.GetBook(ctx, book)
@@ -84,6 +277,33 @@ func (b *BookUseCase) GetBook(ctx context.Context, book domain.Book) (domain.Boo
 	return foundBook, nil
 }
 
+func (b *BookUseCase) GetBookByISBN(ctx context.Context, code string) (domain.Book, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Book{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Book{}, sessionError(err)
+	}
+
+	// Either form is accepted; books are looked up by their ISBN-13
+	isbn13, err := isbn.Parse(code)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	foundBook, err := b.bookRepository.GetBookByISBN(ctx, domain.Book{ISBN13: isbn13})
+	if err != nil {
+		return domain.Book{}, err
+	}
+	return foundBook, nil
+}
+
 func (b *BookUseCase) UpdateBook(ctx context.Context, book domain.Book) (domain.Book, error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
@@ -95,7 +315,7 @@ func (b *BookUseCase) UpdateBook(ctx context.Context, book domain.Book) (domain.
 	}
 	verifyTokenRes, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.Book{}, errorhandler.ErrInvalidSession
+		return domain.Book{}, sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
@@ -103,6 +323,19 @@ func (b *BookUseCase) UpdateBook(ctx context.Context, book domain.Book) (domain.
 		return domain.Book{}, errorhandler.ErrForbidden
 	}
 
+	if err := normalizeISBN(&book); err != nil {
+		return domain.Book{}, err
+	}
+	if err := normalizeEdition(&book); err != nil {
+		return domain.Book{}, err
+	}
+	if err := resolveAuthors(ctx, b.authorRepository, &book); err != nil {
+		return domain.Book{}, err
+	}
+	if err := resolveVocabulary(ctx, b.subjectRepository, b.genreRepository, &book); err != nil {
+		return domain.Book{}, err
+	}
+
 	updatedBook, err := b.bookRepository.UpdateBook(ctx, book)
 	if err != nil {
 		return domain.Book{}, err
@@ -110,6 +343,47 @@ func (b *BookUseCase) UpdateBook(ctx context.Context, book domain.Book) (domain.
 	return updatedBook, nil
 }
 
+// UpdateBookTags replaces the tags of a book. Tags named for the first time are created.
+func (b *BookUseCase) UpdateBookTags(ctx context.Context, book domain.Book) (domain.Book, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Book{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := b.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Book{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Book{}, errorhandler.ErrForbidden
+	}
+
+	// Names differing only in case are the same tag
+	seen := make(map[string]bool)
+	var tags []domain.Tag
+	for _, tag := range book.Tags {
+		if err := normalizeTagName(&tag); err != nil {
+			return domain.Book{}, err
+		}
+		if key := strings.ToLower(tag.Name); !seen[key] {
+			seen[key] = true
+			tags = append(tags, tag)
+		}
+	}
+	book.Tags = tags
+
+	updatedBook, err := b.bookRepository.UpdateBookTags(ctx, book)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	return updatedBook, nil
+}
+
 func (b *BookUseCase) DeleteBook(ctx context.Context, book domain.Book) error {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
@@ -121,7 +395,7 @@ func (b *BookUseCase) DeleteBook(ctx context.Context, book domain.Book) error {
 	}
 	verifyTokenRes, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return errorhandler.ErrInvalidSession
+		return sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
@@ -129,17 +403,30 @@ func (b *BookUseCase) DeleteBook(ctx context.Context, book domain.Book) error {
 		return errorhandler.ErrForbidden
 	}
 
+	// The cover files of the book are removed once the book is gone
+	foundBook, err := b.bookRepository.GetBook(ctx, book)
+	if err != nil && !errors.Is(err, errorhandler.ErrBookNotFound) {
+		return err
+	}
+
 	err = b.bookRepository.DeleteBook(ctx, book)
 	if err != nil {
 		return err
 	}
+	if foundBook.Cover != nil {
+		deleteCoverFiles(ctx, b.fileStorage, *foundBook.Cover)
+	}
 	return nil
 }
 
-func (b *BookUseCase) BorrowBook(ctx context.Context, book domain.Book) (domain.Book, error) {
+// BorrowBook lends a copy of the book to the caller and opens a loan for it.
+// Copies on the hold shelf are only lent to the patron they are reserved for.
+// The copy changes hands atomically, so concurrent borrows of the last copy cannot both succeed,
+// and the loan limit and fine block are checked in the same transaction.
+func (b *BookUseCase) BorrowBook(ctx context.Context, book domain.Book) (domain.Loan, error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
-		return domain.Book{}, errorhandler.ErrInvalidSession
+		return domain.Loan{}, errorhandler.ErrInvalidSession
 	}
 
 	verifyTokenReq := domain.Auth{
@@ -147,33 +434,83 @@ func (b *BookUseCase) BorrowBook(ctx context.Context, book domain.Book) (domain.
 	}
 	verifyTokenRes, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.Book{}, errorhandler.ErrInvalidSession
+		return domain.Loan{}, sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
-	book.BorrowerID = claims.ID
 	foundBook, err := b.bookRepository.GetBook(ctx, book)
 	if err != nil {
-		return domain.Book{}, err
+		return domain.Loan{}, err
 	}
 
-	if !foundBook.Available {
-		return domain.Book{}, errorhandler.ErrBookAlreadyBorrowed
+	loan := domain.Loan{
+		BookID:     foundBook.ID,
+		UserID:     claims.ID,
+		DueAt:      b.loanPolicy.DueDate(time.Now()),
+		BorrowedBy: claims.ID,
+	}
+	borrowedLoan, err := b.bookRepository.BorrowCopy(ctx, loan, b.loanPolicy, b.fineLedger.finePolicy)
+	if err != nil {
+		if !errors.Is(err, errorhandler.ErrBookAlreadyBorrowed) {
+			return domain.Loan{}, err
+		}
+		// No copy could be taken; say whether the remaining ones are reserved for someone else.
+		copies, err := b.copyRepository.GetCopies(ctx, domain.Copy{BookID: foundBook.ID})
+		if err != nil {
+			return domain.Loan{}, err
+		}
+		for _, bookCopy := range copies {
+			if bookCopy.Status == domain.CopyStatusOnHold {
+				return domain.Loan{}, errorhandler.ErrBookOnHold
+			}
+		}
+		return domain.Loan{}, errorhandler.ErrBookAlreadyBorrowed
+	}
+	return borrowedLoan, nil
+}
+
+// ReturnBook closes the caller's loan of the book and charges any late fee. The returned copy goes to the hold shelf
+// when another patron is waiting for the book, otherwise back on the open shelf.
+func (b *BookUseCase) ReturnBook(ctx context.Context, book domain.Book) (domain.Loan, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Loan{}, errorhandler.ErrInvalidSession
 	}
-	foundBook.Available = false
-	foundBook.BorrowerID = book.BorrowerID
 
-	borrowedBook, err := b.bookRepository.UpdateBook(ctx, foundBook)
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.Book{}, err
+		return domain.Loan{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	foundBook, err := b.bookRepository.GetBook(ctx, book)
+	if err != nil {
+		return domain.Loan{}, err
+	}
+
+	now := time.Now()
+	loan := domain.Loan{
+		BookID:     foundBook.ID,
+		UserID:     claims.ID,
+		ReturnedAt: now,
+		ReturnedBy: claims.ID,
 	}
-	return borrowedBook, nil
+	returnedLoan, err := b.bookRepository.ReturnCopy(ctx, loan, b.holdShelf.readyHold(now), b.fineLedger.finePolicy)
+	if err != nil {
+		return domain.Loan{}, err
+	}
+	return returnedLoan, nil
 }
 
-func (b *BookUseCase) ReturnBook(ctx context.Context, book domain.Book) (domain.Book, error) {
+// RenewBook extends the caller's open loan of the book by another loan period.
+// Renewals are refused while other patrons are waiting in the hold queue.
+func (b *BookUseCase) RenewBook(ctx context.Context, book domain.Book) (domain.Loan, error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
-		return domain.Book{}, errorhandler.ErrInvalidSession
+		return domain.Loan{}, errorhandler.ErrInvalidSession
 	}
 
 	verifyTokenReq := domain.Auth{
@@ -181,37 +518,73 @@ func (b *BookUseCase) ReturnBook(ctx context.Context, book domain.Book) (domain.
 	}
 	verifyTokenRes, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.Book{}, errorhandler.ErrInvalidSession
+		return domain.Loan{}, sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
-	book.BorrowerID = claims.ID
 	foundBook, err := b.bookRepository.GetBook(ctx, book)
 	if err != nil {
-		return domain.Book{}, err
+		return domain.Loan{}, err
 	}
 
-	if foundBook.Available {
-		return domain.Book{}, errorhandler.ErrBookAlreadyAvailable
+	loan := domain.Loan{BookID: foundBook.ID, UserID: claims.ID}
+	renewedLoan, err := b.loanRepository.RenewLoan(ctx, loan, b.loanPolicy, time.Now())
+	if err != nil {
+		return domain.Loan{}, err
 	}
-	foundBook.Available = true
+	return renewedLoan, nil
+}
 
-	if foundBook.BorrowerID != book.BorrowerID {
-		return domain.Book{}, errorhandler.ErrBorrowerIDMismatch
+func (b *BookUseCase) SearchBooks(ctx context.Context, search domain.BookSearch, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.BookMatch], domain.BookFacets, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return listquery.Page[domain.BookMatch]{}, domain.BookFacets{}, errorhandler.ErrInvalidSession
 	}
-	foundBook.BorrowerID = 0
 
-	returnedBook, err := b.bookRepository.UpdateBook(ctx, foundBook)
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.Book{}, err
+		return listquery.Page[domain.BookMatch]{}, domain.BookFacets{}, sessionError(err)
+	}
+
+	// Check if at least one of the fields is provided
+	search.Query = strings.TrimSpace(search.Query)
+	search.Title = strings.TrimSpace(search.Title)
+	search.Author = strings.TrimSpace(search.Author)
+	search.Category = strings.TrimSpace(search.Category)
+	if search.Query == "" && search.Title == "" && search.Author == "" && search.Category == "" {
+		return listquery.Page[domain.BookMatch]{}, domain.BookFacets{}, errorhandler.ErrInvalidSearchQuery
+	}
+
+	// Searches are ranked best match first unless another order is asked for
+	if query.Sort == "" || query.Sort == "relevance" {
+		query.Sort = "relevance"
+		if query.Order == "" {
+			query.Order = listquery.Desc
+		}
+	}
+	query, err = normalizeBookList(filter, query)
+	if err != nil {
+		return listquery.Page[domain.BookMatch]{}, domain.BookFacets{}, err
+	}
+
+	books, err := b.bookRepository.SearchBooks(ctx, search, filter, query)
+	if err != nil {
+		return listquery.Page[domain.BookMatch]{}, domain.BookFacets{}, err
+	}
+	facets, err := b.bookRepository.FacetBooks(ctx, search, filter)
+	if err != nil {
+		return listquery.Page[domain.BookMatch]{}, domain.BookFacets{}, err
 	}
-	return returnedBook, nil
+	return books, facets, nil
 }
 
-func (b *BookUseCase) SearchBooks(ctx context.Context, book domain.Book) ([]domain.Book, error) {
+func (b *BookUseCase) CategoryBooks(ctx context.Context, book domain.Book, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, errorhandler.ErrInvalidSession
 	}
 
 	verifyTokenReq := domain.Auth{
@@ -219,25 +592,25 @@ func (b *BookUseCase) SearchBooks(ctx context.Context, book domain.Book) ([]doma
 	}
 	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, sessionError(err)
 	}
 
-	// Check if at least one of the fields is provided
-	if book.Title == "" && book.Author == "" && book.Category == "" {
-		return []domain.Book{}, errorhandler.ErrInvalidSearchQuery
+	query, err = normalizeBookList(filter, query)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
 	}
 
-	books, err := b.bookRepository.SearchBooks(ctx, book)
+	books, err := b.bookRepository.CategoryBooks(ctx, book, filter, query)
 	if err != nil {
-		return []domain.Book{}, err
+		return listquery.Page[domain.Book]{}, err
 	}
 	return books, nil
 }
 
-func (b *BookUseCase) CategoryBooks(ctx context.Context, book domain.Book) ([]domain.Book, error) {
+func (b *BookUseCase) AuthorBooks(ctx context.Context, author domain.Author, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, errorhandler.ErrInvalidSession
 	}
 
 	verifyTokenReq := domain.Auth{
@@ -245,20 +618,29 @@ func (b *BookUseCase) CategoryBooks(ctx context.Context, book domain.Book) ([]do
 	}
 	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, sessionError(err)
 	}
 
-	books, err := b.bookRepository.CategoryBooks(ctx, book)
+	query, err = normalizeBookList(filter, query)
 	if err != nil {
-		return []domain.Book{}, err
+		return listquery.Page[domain.Book]{}, err
+	}
+
+	if _, err := b.authorRepository.GetAuthor(ctx, author); err != nil {
+		return listquery.Page[domain.Book]{}, err
+	}
+
+	books, err := b.bookRepository.AuthorBooks(ctx, author, filter, query)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
 	}
 	return books, nil
 }
 
-func (b *BookUseCase) AvailableBooks(ctx context.Context) ([]domain.Book, error) {
+func (b *BookUseCase) AvailableBooks(ctx context.Context, filter domain.BookFilter, query listquery.Query) (listquery.Page[domain.Book], error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, errorhandler.ErrInvalidSession
 	}
 
 	verifyTokenReq := domain.Auth{
@@ -266,12 +648,66 @@ func (b *BookUseCase) AvailableBooks(ctx context.Context) ([]domain.Book, error)
 	}
 	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return []domain.Book{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.Book]{}, sessionError(err)
 	}
 
-	books, err := b.bookRepository.AvailableBooks(ctx)
+	query, err = normalizeBookList(filter, query)
 	if err != nil {
-		return []domain.Book{}, err
+		return listquery.Page[domain.Book]{}, err
+	}
+
+	books, err := b.bookRepository.AvailableBooks(ctx, filter, query)
+	if err != nil {
+		return listquery.Page[domain.Book]{}, err
 	}
 	return books, nil
 }
+
+// SuggestBooks completes the prefix to the most common matching titles and authors.
+// Lookups are cached briefly since the endpoint is called on every keystroke.
+func (b *BookUseCase) SuggestBooks(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return nil, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := b.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return nil, sessionError(err)
+	}
+
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" {
+		return nil, errorhandler.ErrEmptySuggestPrefix
+	}
+	if limit <= 0 {
+		limit = b.suggestConfig.DefaultLimit
+	}
+	if limit > b.suggestConfig.MaxLimit {
+		limit = b.suggestConfig.MaxLimit
+	}
+
+	key := suggestionKey{prefix: prefix, limit: limit}
+	if suggestions, ok := b.suggestions.Get(key); ok {
+		return suggestions, nil
+	}
+
+	suggestions, err := b.bookRepository.GetSuggestions(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	b.suggestions.Set(key, suggestions)
+	return suggestions, nil
+}
+
+// sessionError is the error of a use case whose token could not be verified. A token is only
+// reported as invalid when the auth service could be asked.
+func sessionError(err error) error {
+	if errors.Is(err, errorhandler.ErrAuthUnavailable) {
+		return errorhandler.ErrAuthUnavailable
+	}
+	return errorhandler.ErrInvalidSession
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+)
+
+type CopyUseCase struct {
+	bookRepository ports.BookRepository
+	copyRepository ports.CopyRepository
+	authService    *auth.AuthService
+}
+
+func NewCopyUseCase() *CopyUseCase {
+	return &CopyUseCase{
+		bookRepository: repository.NewBookRepository(),
+		copyRepository: repository.NewCopyRepository(),
+		authService:    auth.NewAuthService(),
+	}
+}
+
+// isShelfStatus reports whether a librarian may set the status by hand.
+// Borrowed and held copies only enter and leave those states through circulation.
+func isShelfStatus(status domain.CopyStatus) bool {
+	switch status {
+	case domain.CopyStatusAvailable, domain.CopyStatusMaintenance, domain.CopyStatusLost:
+		return true
+	}
+	return false
+}
+
+// AddCopy handles logic for registering a new physical copy of a book
+func (cu *CopyUseCase) AddCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Copy{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := cu.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Copy{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Copy{}, errorhandler.ErrForbidden
+	}
+
+	if bookCopy.Status == "" {
+		bookCopy.Status = domain.CopyStatusAvailable
+	}
+	if !isShelfStatus(bookCopy.Status) {
+		return domain.Copy{}, errorhandler.ErrInvalidCopyStatus
+	}
+
+	addedCopy, err := cu.copyRepository.AddCopy(ctx, bookCopy)
+	if err != nil {
+		return domain.Copy{}, err
+	}
+	return addedCopy, nil
+}
+
+// GetCopies handles logic for listing the copies of a book
+func (cu *CopyUseCase) GetCopies(ctx context.Context, bookCopy domain.Copy) ([]domain.Copy, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Copy{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := cu.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Copy{}, sessionError(err)
+	}
+
+	_, err = cu.bookRepository.GetBook(ctx, domain.Book{ID: bookCopy.BookID})
+	if err != nil {
+		return []domain.Copy{}, err
+	}
+
+	copies, err := cu.copyRepository.GetCopies(ctx, bookCopy)
+	if err != nil {
+		return []domain.Copy{}, err
+	}
+	return copies, nil
+}
+
+// UpdateCopy handles logic for changing the barcode, shelf location or status of a copy
+func (cu *CopyUseCase) UpdateCopy(ctx context.Context, bookCopy domain.Copy) (domain.Copy, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Copy{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := cu.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Copy{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Copy{}, errorhandler.ErrForbidden
+	}
+
+	foundCopy, err := cu.copyRepository.GetCopy(ctx, bookCopy)
+	if err != nil {
+		return domain.Copy{}, err
+	}
+
+	if bookCopy.Barcode != "" {
+		foundCopy.Barcode = bookCopy.Barcode
+	}
+	foundCopy.ShelfLocation = bookCopy.ShelfLocation
+	if bookCopy.Status != "" && bookCopy.Status != foundCopy.Status {
+		if foundCopy.Status == domain.CopyStatusBorrowed {
+			return domain.Copy{}, errorhandler.ErrCopyBorrowed
+		}
+		if foundCopy.Status == domain.CopyStatusOnHold {
+			return domain.Copy{}, errorhandler.ErrCopyOnHold
+		}
+		if !isShelfStatus(bookCopy.Status) {
+			return domain.Copy{}, errorhandler.ErrInvalidCopyStatus
+		}
+		foundCopy.Status = bookCopy.Status
+	}
+
+	updatedCopy, err := cu.copyRepository.UpdateCopy(ctx, foundCopy)
+	if err != nil {
+		return domain.Copy{}, err
+	}
+	return updatedCopy, nil
+}
+
+// DeleteCopy handles logic for withdrawing a copy from the collection
+func (cu *CopyUseCase) DeleteCopy(ctx context.Context, bookCopy domain.Copy) error {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := cu.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return errorhandler.ErrForbidden
+	}
+
+	foundCopy, err := cu.copyRepository.GetCopy(ctx, bookCopy)
+	if err != nil {
+		return err
+	}
+	if foundCopy.Status == domain.CopyStatusBorrowed {
+		return errorhandler.ErrCopyBorrowed
+	}
+	if foundCopy.Status == domain.CopyStatusOnHold {
+		return errorhandler.ErrCopyOnHold
+	}
+
+	err = cu.copyRepository.DeleteCopy(ctx, foundCopy)
+	if err != nil {
+		return err
+	}
+	return nil
+}
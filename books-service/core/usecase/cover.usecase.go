@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/adapter/storage"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/books-service/pkg/thumbnail"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// coverExtensions maps the media types accepted for cover images to the extension of their key.
+var coverExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// thumbnailQuality is the JPEG quality thumbnails are encoded with.
+const thumbnailQuality = 85
+
+type CoverUseCase struct {
+	bookRepository ports.BookRepository
+	fileStorage    ports.FileStorage
+	authService    *auth.AuthService
+	coverConfig    configs.Cover
+}
+
+func NewCoverUseCase() *CoverUseCase {
+	return &CoverUseCase{
+		bookRepository: repository.NewBookRepository(),
+		fileStorage:    storage.NewFileStorage(),
+		authService:    auth.NewAuthService(),
+		coverConfig:    configs.C().Cover,
+	}
+}
+
+// readCover reads an uploaded cover image and checks it before anything is stored. The media
+// type is sniffed from the content rather than trusted from the request, and the dimensions are
+// checked before the image is decoded.
+func readCover(r io.Reader, coverConfig configs.Cover) ([]byte, string, image.Image, error) {
+	data, err := io.ReadAll(io.LimitReader(r, coverConfig.MaxBytes+1))
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if int64(len(data)) > coverConfig.MaxBytes {
+		return nil, "", nil, errorhandler.ErrCoverTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := coverExtensions[contentType]; !ok {
+		return nil, "", nil, errorhandler.ErrUnsupportedCoverType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", nil, errorhandler.ErrInvalidCover
+	}
+	if config.Width*config.Height > coverConfig.MaxPixels {
+		return nil, "", nil, errorhandler.ErrCoverTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", nil, errorhandler.ErrInvalidCover
+	}
+	return data, contentType, img, nil
+}
+
+// coverKeys returns fresh storage keys for the cover of a book and its thumbnail. Every upload
+// gets new keys, so that a cached image is never served for a replaced cover.
+func coverKeys(book domain.Book, contentType string) (string, string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", "", err
+	}
+	name := hex.EncodeToString(random)
+	key := fmt.Sprintf("covers/%d/%s%s", book.ID, name, coverExtensions[contentType])
+	thumbnailKey := fmt.Sprintf("covers/%d/%s-thumbnail.jpg", book.ID, name)
+	return key, thumbnailKey, nil
+}
+
+// deleteCoverFiles removes the stored files of a cover that is no longer referenced. Failures
+// only leave unreferenced files behind, so they are logged rather than reported.
+func deleteCoverFiles(ctx context.Context, fileStorage ports.FileStorage, cover domain.Cover) {
+	for _, key := range []string{cover.Key, cover.ThumbnailKey} {
+		if err := fileStorage.Delete(ctx, key); err != nil {
+			log.Error().Err(err).Str("key", key).Msg("failed to delete cover file")
+		}
+	}
+}
+
+// UpdateBookCover stores an uploaded cover image together with a JPEG thumbnail and makes it the
+// cover of the book, replacing the previous one.
+func (cu *CoverUseCase) UpdateBookCover(ctx context.Context, book domain.Book, upload io.Reader) (domain.Book, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Book{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := cu.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Book{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Book{}, errorhandler.ErrForbidden
+	}
+
+	// Check that the book exists before storing anything for it
+	if _, err := cu.bookRepository.GetBook(ctx, book); err != nil {
+		return domain.Book{}, err
+	}
+
+	data, contentType, img, err := readCover(upload, cu.coverConfig)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	var thumbnailData bytes.Buffer
+	thumbnailImg := thumbnail.Scale(img, cu.coverConfig.ThumbnailWidth, cu.coverConfig.ThumbnailHeight)
+	if err := jpeg.Encode(&thumbnailData, thumbnailImg, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return domain.Book{}, err
+	}
+
+	key, thumbnailKey, err := coverKeys(book, contentType)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	cover := domain.Cover{
+		Key:          key,
+		ThumbnailKey: thumbnailKey,
+		ContentType:  contentType,
+		UpdatedAt:    time.Now(),
+	}
+	if err := cu.fileStorage.Put(ctx, cover.Key, cover.ContentType, data); err != nil {
+		return domain.Book{}, err
+	}
+	if err := cu.fileStorage.Put(ctx, cover.ThumbnailKey, "image/jpeg", thumbnailData.Bytes()); err != nil {
+		deleteCoverFiles(ctx, cu.fileStorage, cover)
+		return domain.Book{}, err
+	}
+
+	book.Cover = &cover
+	replacedCover, err := cu.bookRepository.ReplaceBookCover(ctx, book)
+	if err != nil {
+		deleteCoverFiles(ctx, cu.fileStorage, cover)
+		return domain.Book{}, err
+	}
+	if replacedCover != nil {
+		deleteCoverFiles(ctx, cu.fileStorage, *replacedCover)
+	}
+
+	updatedBook, err := cu.bookRepository.GetBook(ctx, book)
+	if err != nil {
+		return domain.Book{}, err
+	}
+	return updatedBook, nil
+}
+
+func (cu *CoverUseCase) DeleteBookCover(ctx context.Context, book domain.Book) error {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := cu.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return errorhandler.ErrForbidden
+	}
+
+	book.Cover = nil
+	replacedCover, err := cu.bookRepository.ReplaceBookCover(ctx, book)
+	if err != nil {
+		return err
+	}
+	if replacedCover == nil {
+		return errorhandler.ErrCoverNotFound
+	}
+	deleteCoverFiles(ctx, cu.fileStorage, *replacedCover)
+	return nil
+}
+
+// GetBookCover returns the cover image of a book or its thumbnail. Covers are shown in image
+// tags, which cannot send a token, so no session is required.
+func (cu *CoverUseCase) GetBookCover(ctx context.Context, book domain.Book, size domain.CoverSize) (domain.CoverImage, error) {
+	foundBook, err := cu.bookRepository.GetBook(ctx, book)
+	if err != nil {
+		return domain.CoverImage{}, err
+	}
+	if foundBook.Cover == nil {
+		return domain.CoverImage{}, errorhandler.ErrCoverNotFound
+	}
+
+	coverImage := domain.CoverImage{
+		Key:         foundBook.Cover.Key,
+		ContentType: foundBook.Cover.ContentType,
+		UpdatedAt:   foundBook.Cover.UpdatedAt,
+	}
+	if size == domain.CoverSizeThumbnail {
+		coverImage.Key = foundBook.Cover.ThumbnailKey
+		coverImage.ContentType = "image/jpeg"
+	}
+	coverImage.Data, err = cu.fileStorage.Get(ctx, coverImage.Key)
+	if err != nil {
+		if errors.Is(err, errorhandler.ErrFileNotFound) {
+			return domain.CoverImage{}, errorhandler.ErrCoverNotFound
+		}
+		return domain.CoverImage{}, err
+	}
+	return coverImage, nil
+}
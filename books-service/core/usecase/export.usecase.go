@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+)
+
+type ExportUseCase struct {
+	bookRepository ports.BookRepository
+	authService    *auth.AuthService
+}
+
+func NewExportUseCase() *ExportUseCase {
+	return &ExportUseCase{
+		bookRepository: repository.NewBookRepository(),
+		authService:    auth.NewAuthService(),
+	}
+}
+
+// ExportBooks passes the whole catalogue to fn one book at a time, so that callers can stream
+// it out without holding it in memory.
+func (e *ExportUseCase) ExportBooks(ctx context.Context, fn func(book domain.Book) error) error {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := e.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return errorhandler.ErrForbidden
+	}
+
+	return e.bookRepository.ExportBooks(ctx, fn)
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// fineLedger charges late returns according to the fine policy.
+type fineLedger struct {
+	fineRepository ports.FineRepository
+	finePolicy     domain.FinePolicy
+}
+
+func newFineLedger() fineLedger {
+	fineConfig := configs.C().Fine
+
+	return fineLedger{
+		fineRepository: repository.NewFineRepository(),
+		finePolicy: domain.FinePolicy{
+			GracePeriod:         fineConfig.GracePeriod,
+			DailyRateCents:      fineConfig.DailyRateCents,
+			MaxPerLoanCents:     fineConfig.MaxPerLoanCents,
+			BlockThresholdCents: fineConfig.BlockThresholdCents,
+		},
+	}
+}
+
+// accrue charges whatever the loan owes as of now beyond what was already charged for it.
+// The repository works the charge out under a lock on the loan, so the loan may be accrued as
+// often as needed, even by concurrent runs.
+func (f fineLedger) accrue(ctx context.Context, loan domain.Loan, now time.Time) error {
+	_, err := f.fineRepository.ChargeLoan(ctx, loan, f.finePolicy, now)
+	return err
+}
+
+type FineUseCase struct {
+	fineRepository ports.FineRepository
+	loanRepository ports.LoanRepository
+	authService    *auth.AuthService
+	fineLedger     fineLedger
+}
+
+func NewFineUseCase() *FineUseCase {
+	return &FineUseCase{
+		fineRepository: repository.NewFineRepository(),
+		loanRepository: repository.NewLoanRepository(),
+		authService:    auth.NewAuthService(),
+		fineLedger:     newFineLedger(),
+	}
+}
+
+// getBalance loads the balance of a patron together with the ledger entries behind it.
+func (f *FineUseCase) getBalance(ctx context.Context, userID uint) (domain.FineBalance, error) {
+	balance, err := f.fineRepository.GetBalance(ctx, domain.Fine{UserID: userID})
+	if err != nil {
+		return domain.FineBalance{}, err
+	}
+	balance.Entries, err = f.fineRepository.GetFines(ctx, domain.Fine{UserID: userID})
+	if err != nil {
+		return domain.FineBalance{}, err
+	}
+	return balance, nil
+}
+
+// GetMyFines handles logic for showing the caller's fine balance
+func (f *FineUseCase) GetMyFines(ctx context.Context) (domain.FineBalance, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.FineBalance{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := f.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.FineBalance{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	return f.getBalance(ctx, claims.ID)
+}
+
+// GetUserFines handles logic for showing the fine balance of a single patron
+func (f *FineUseCase) GetUserFines(ctx context.Context, fine domain.Fine) (domain.FineBalance, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.FineBalance{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := f.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.FineBalance{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if claims.ID != fine.UserID && !claims.IsAdmin {
+		return domain.FineBalance{}, errorhandler.ErrForbidden
+	}
+
+	return f.getBalance(ctx, fine.UserID)
+}
+
+// RecordPayment handles logic for recording a payment made by a patron
+func (f *FineUseCase) RecordPayment(ctx context.Context, fine domain.Fine) (domain.Fine, error) {
+	fine.Kind = domain.FineKindPayment
+	return f.settle(ctx, fine)
+}
+
+// WaiveFine handles logic for forgiving part or all of a patron's balance
+func (f *FineUseCase) WaiveFine(ctx context.Context, fine domain.Fine) (domain.Fine, error) {
+	fine.Kind = domain.FineKindWaiver
+	return f.settle(ctx, fine)
+}
+
+// settle records a payment or waiver against the outstanding balance, which it may not exceed.
+// Only admins may settle fines.
+func (f *FineUseCase) settle(ctx context.Context, fine domain.Fine) (domain.Fine, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Fine{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := f.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Fine{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Fine{}, errorhandler.ErrForbidden
+	}
+
+	if fine.AmountCents <= 0 {
+		return domain.Fine{}, errorhandler.ErrInvalidFineAmount
+	}
+
+	fine.LoanID = 0
+	fine.CreatedBy = claims.ID
+	addedFine, err := f.fineRepository.SettleFine(ctx, fine)
+	if err != nil {
+		return domain.Fine{}, err
+	}
+	return addedFine, nil
+}
+
+// AccrueFines charges every overdue loan for the days it has been late so far.
+// It runs as a background job. A loan that fails to be charged is logged and skipped.
+func (f *FineUseCase) AccrueFines(ctx context.Context) error {
+	now := time.Now()
+
+	loans, err := f.loanRepository.GetOverdueLoans(ctx, now.Add(-f.fineLedger.finePolicy.GracePeriod))
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, loan := range loans {
+		err = f.fineLedger.accrue(ctx, loan, now)
+		if err != nil {
+			// One loan that cannot be charged must not hold up the rest; the next run retries it.
+			log.Error().Err(err).Uint("loan_id", loan.ID).Msg("fine accrual failed for loan")
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d overdue loans could not be charged", failed, len(loans))
+	}
+	return nil
+}
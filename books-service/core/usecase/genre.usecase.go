@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"strings"
+)
+
+type GenreUseCase struct {
+	genreRepository ports.GenreRepository
+	authService     *auth.AuthService
+}
+
+func NewGenreUseCase() *GenreUseCase {
+	return &GenreUseCase{
+		genreRepository: repository.NewGenreRepository(),
+		authService:     auth.NewAuthService(),
+	}
+}
+
+// normalizeGenre cleans up the name and synonyms of a genre. Synonyms repeating the name or
+// another synonym are dropped.
+func normalizeGenre(genre *domain.Genre) error {
+	genre.Name = normalizeTermName(genre.Name)
+	if genre.Name == "" {
+		return errorhandler.ErrInvalidTermName
+	}
+
+	seen := map[string]bool{strings.ToLower(genre.Name): true}
+	var synonyms []string
+	for _, synonym := range genre.Synonyms {
+		synonym = normalizeTermName(synonym)
+		if synonym == "" {
+			return errorhandler.ErrInvalidTermName
+		}
+		if seen[strings.ToLower(synonym)] {
+			continue
+		}
+		seen[strings.ToLower(synonym)] = true
+		synonyms = append(synonyms, synonym)
+	}
+	genre.Synonyms = synonyms
+	return nil
+}
+
+func (g *GenreUseCase) AddGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Genre{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := g.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Genre{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Genre{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeGenre(&genre); err != nil {
+		return domain.Genre{}, err
+	}
+
+	addedGenre, err := g.genreRepository.AddGenre(ctx, genre)
+	if err != nil {
+		return domain.Genre{}, err
+	}
+	return addedGenre, nil
+}
+
+func (g *GenreUseCase) GetGenres(ctx context.Context) ([]domain.Genre, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Genre{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := g.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Genre{}, sessionError(err)
+	}
+
+	genres, err := g.genreRepository.GetGenres(ctx)
+	if err != nil {
+		return []domain.Genre{}, err
+	}
+	return genres, nil
+}
+
+// UpdateGenre replaces the name and synonyms of a genre. The books of the genre follow the new name.
+func (g *GenreUseCase) UpdateGenre(ctx context.Context, genre domain.Genre) (domain.Genre, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Genre{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := g.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Genre{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Genre{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeGenre(&genre); err != nil {
+		return domain.Genre{}, err
+	}
+
+	updatedGenre, err := g.genreRepository.UpdateGenre(ctx, genre)
+	if err != nil {
+		return domain.Genre{}, err
+	}
+	return updatedGenre, nil
+}
+
+func (g *GenreUseCase) DeleteGenre(ctx context.Context, genre domain.Genre) error {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := g.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return errorhandler.ErrForbidden
+	}
+
+	return g.genreRepository.DeleteGenre(ctx, genre)
+}
+
+// MergeGenre folds source into target: its books move to target and its names become synonyms of target.
+func (g *GenreUseCase) MergeGenre(ctx context.Context, source domain.Genre, target domain.Genre) (domain.Genre, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Genre{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := g.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Genre{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Genre{}, errorhandler.ErrForbidden
+	}
+
+	if source.ID == target.ID {
+		return domain.Genre{}, errorhandler.ErrMergeIntoSelf
+	}
+
+	mergedGenre, err := g.genreRepository.MergeGenre(ctx, source, target)
+	if err != nil {
+		return domain.Genre{}, err
+	}
+	return mergedGenre, nil
+}
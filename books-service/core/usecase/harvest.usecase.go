@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"time"
+)
+
+// HarvestUseCase serves the catalogue to metadata harvesters. Harvesting is anonymous: the
+// records describe the public catalogue and carry nothing about circulation.
+type HarvestUseCase struct {
+	bookRepository ports.BookRepository
+	oaiConfig      configs.OAI
+}
+
+func NewHarvestUseCase() *HarvestUseCase {
+	return &HarvestUseCase{
+		bookRepository: repository.NewBookRepository(),
+		oaiConfig:      configs.C().OAI,
+	}
+}
+
+// Identity describes the catalogue as configured.
+func (h *HarvestUseCase) Identity() domain.HarvestIdentity {
+	return domain.HarvestIdentity{
+		Name:       h.oaiConfig.RepositoryName,
+		BaseURL:    h.oaiConfig.BaseURL,
+		AdminEmail: h.oaiConfig.AdminEmail,
+		Identifier: h.oaiConfig.RepositoryIdentifier,
+	}
+}
+
+// EarliestDatestamp returns the oldest datestamp of any record. An empty catalogue has no
+// records yet, so anything it ever holds will be stamped from now on.
+func (h *HarvestUseCase) EarliestDatestamp(ctx context.Context) (time.Time, error) {
+	earliest, err := h.bookRepository.EarliestDatestamp(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if earliest.IsZero() {
+		return time.Now(), nil
+	}
+	return earliest, nil
+}
+
+// GetRecord returns the record of a book, or its tombstone once the book is deleted.
+func (h *HarvestUseCase) GetRecord(ctx context.Context, bookID uint) (domain.HarvestRecord, error) {
+	return h.bookRepository.GetHarvestRecord(ctx, domain.Book{ID: bookID})
+}
+
+// ListRecords returns one page of the records selected by the query, at most the configured
+// page size.
+func (h *HarvestUseCase) ListRecords(ctx context.Context, query domain.HarvestQuery) (domain.HarvestPage, error) {
+	query.Limit = h.oaiConfig.PageSize
+	records, err := h.bookRepository.HarvestBooks(ctx, query)
+	if err != nil {
+		return domain.HarvestPage{}, err
+	}
+	if len(records) > query.Limit {
+		return domain.HarvestPage{Records: records[:query.Limit], More: true}, nil
+	}
+	return domain.HarvestPage{Records: records}, nil
+}
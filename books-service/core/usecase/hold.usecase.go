@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"time"
+)
+
+// holdShelf routes copies that come back into circulation to the hold queue of their book.
+type holdShelf struct {
+	bookRepository ports.BookRepository
+	pickupWindow   time.Duration
+}
+
+func newHoldShelf() holdShelf {
+	return holdShelf{
+		bookRepository: repository.NewBookRepository(),
+		pickupWindow:   configs.C().Hold.PickupWindow,
+	}
+}
+
+// readyHold describes the pickup window of a hold that becomes ready at now.
+func (s holdShelf) readyHold(now time.Time) domain.Hold {
+	return domain.Hold{
+		ReadyAt:   now,
+		ExpiresAt: now.Add(s.pickupWindow),
+	}
+}
+
+// shelve reserves the copy for the next patron waiting on its book, or puts it back on the
+// open shelf when nobody is waiting. A copy that has already left bookCopy.Status through
+// another request is left alone.
+func (s holdShelf) shelve(ctx context.Context, bookCopy domain.Copy, now time.Time) error {
+	_, err := s.bookRepository.ShelveCopy(ctx, bookCopy, s.readyHold(now))
+	if err != nil && !errors.Is(err, errorhandler.ErrCopyNotFound) {
+		return err
+	}
+	return nil
+}
+
+type HoldUseCase struct {
+	bookRepository ports.BookRepository
+	copyRepository ports.CopyRepository
+	loanRepository ports.LoanRepository
+	holdRepository ports.HoldRepository
+	workRepository ports.WorkRepository
+	authService    *auth.AuthService
+	holdShelf      holdShelf
+}
+
+func NewHoldUseCase() *HoldUseCase {
+	return &HoldUseCase{
+		bookRepository: repository.NewBookRepository(),
+		copyRepository: repository.NewCopyRepository(),
+		loanRepository: repository.NewLoanRepository(),
+		holdRepository: repository.NewHoldRepository(),
+		workRepository: repository.NewWorkRepository(),
+		authService:    auth.NewAuthService(),
+		holdShelf:      newHoldShelf(),
+	}
+}
+
+// PlaceHold queues the caller for the next copy of a book that has none on the shelf.
+func (h *HoldUseCase) PlaceHold(ctx context.Context, hold domain.Hold) (domain.Hold, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Hold{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := h.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Hold{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	foundBook, err := h.bookRepository.GetBook(ctx, domain.Book{ID: hold.BookID})
+	if err != nil {
+		return domain.Hold{}, err
+	}
+
+	_, err = h.loanRepository.GetActiveLoan(ctx, domain.Loan{BookID: foundBook.ID, UserID: claims.ID})
+	if err == nil {
+		return domain.Hold{}, errorhandler.ErrHoldOnOwnLoan
+	}
+	if !errors.Is(err, errorhandler.ErrLoanNotFound) {
+		return domain.Hold{}, err
+	}
+
+	copies, err := h.copyRepository.GetCopies(ctx, domain.Copy{BookID: foundBook.ID})
+	if err != nil {
+		return domain.Hold{}, err
+	}
+	for _, bookCopy := range copies {
+		if bookCopy.Status == domain.CopyStatusAvailable {
+			return domain.Hold{}, errorhandler.ErrHoldNotNeeded
+		}
+	}
+
+	// A hold on any edition is only needed when no edition of the work is on the shelf. For a
+	// book that is not part of a work it is an ordinary hold.
+	anyEdition := hold.AnyEdition && foundBook.Work != nil
+	if anyEdition {
+		foundWork, err := h.workRepository.GetWork(ctx, *foundBook.Work)
+		if err != nil {
+			return domain.Hold{}, err
+		}
+		for _, edition := range foundWork.Editions {
+			if edition.AvailableCopies > 0 {
+				return domain.Hold{}, errorhandler.ErrHoldNotNeeded
+			}
+		}
+	}
+
+	newHold := domain.Hold{
+		BookID:     foundBook.ID,
+		UserID:     claims.ID,
+		Status:     domain.HoldStatusWaiting,
+		AnyEdition: anyEdition,
+	}
+	addedHold, err := h.holdRepository.AddHold(ctx, newHold)
+	if err != nil {
+		return domain.Hold{}, err
+	}
+	return addedHold, nil
+}
+
+// GetMyHolds lists every hold the caller has placed.
+func (h *HoldUseCase) GetMyHolds(ctx context.Context) ([]domain.Hold, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Hold{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := h.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Hold{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	holds, err := h.holdRepository.GetHolds(ctx, domain.Hold{UserID: claims.ID})
+	if err != nil {
+		return []domain.Hold{}, err
+	}
+	return holds, nil
+}
+
+// GetBookHolds lists the hold queue of a book in FIFO order. Only admins may see it.
+func (h *HoldUseCase) GetBookHolds(ctx context.Context, hold domain.Hold) ([]domain.Hold, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Hold{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := h.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Hold{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return []domain.Hold{}, errorhandler.ErrForbidden
+	}
+
+	foundBook, err := h.bookRepository.GetBook(ctx, domain.Book{ID: hold.BookID})
+	if err != nil {
+		return []domain.Hold{}, err
+	}
+
+	holds, err := h.holdRepository.GetHolds(ctx, domain.Hold{BookID: foundBook.ID})
+	if err != nil {
+		return []domain.Hold{}, err
+	}
+	return holds, nil
+}
+
+// CancelHold withdraws an open hold. Patrons may cancel their own holds and admins any hold.
+// A copy already set aside for the hold is passed on to the next patron in the queue.
+func (h *HoldUseCase) CancelHold(ctx context.Context, hold domain.Hold) (domain.Hold, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Hold{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := h.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Hold{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	foundHold, err := h.holdRepository.GetHold(ctx, hold)
+	if err != nil {
+		return domain.Hold{}, err
+	}
+	if foundHold.UserID != claims.ID && !claims.IsAdmin {
+		return domain.Hold{}, errorhandler.ErrForbidden
+	}
+	if !foundHold.IsOpen() {
+		return domain.Hold{}, errorhandler.ErrHoldNotOpen
+	}
+
+	foundHold.Status = domain.HoldStatusCancelled
+	cancelledHold, err := h.holdRepository.CloseHold(ctx, foundHold, h.holdShelf.readyHold(time.Now()))
+	if err != nil {
+		return domain.Hold{}, err
+	}
+	return cancelledHold, nil
+}
+
+// ProcessHolds expires holds whose pickup window has closed and hands copies that are
+// back on the open shelf to patrons still waiting for them. It runs as a background job.
+func (h *HoldUseCase) ProcessHolds(ctx context.Context) error {
+	now := time.Now()
+
+	expiredHolds, err := h.holdRepository.GetExpiredHolds(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, expiredHold := range expiredHolds {
+		expiredHold.Status = domain.HoldStatusExpired
+		_, err = h.holdRepository.CloseHold(ctx, expiredHold, h.holdShelf.readyHold(now))
+		if err != nil {
+			// The patron picked the copy up or cancelled since the hold was read.
+			if errors.Is(err, errorhandler.ErrHoldNotOpen) {
+				continue
+			}
+			return err
+		}
+	}
+
+	copies, err := h.copyRepository.GetHoldableCopies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, bookCopy := range copies {
+		err = h.holdShelf.shelve(ctx, bookCopy, now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+)
+
+type ImportUseCase struct {
+	bookRepository    ports.BookRepository
+	authorRepository  ports.AuthorRepository
+	subjectRepository ports.SubjectRepository
+	genreRepository   ports.GenreRepository
+	authService       *auth.AuthService
+	importConfig      configs.Import
+}
+
+func NewImportUseCase() *ImportUseCase {
+	return &ImportUseCase{
+		bookRepository:    repository.NewBookRepository(),
+		authorRepository:  repository.NewAuthorRepository(),
+		subjectRepository: repository.NewSubjectRepository(),
+		genreRepository:   repository.NewGenreRepository(),
+		authService:       auth.NewAuthService(),
+		importConfig:      configs.C().Import,
+	}
+}
+
+// Limits returns the limits of an import file as configured.
+func (i *ImportUseCase) Limits() domain.ImportLimits {
+	return domain.ImportLimits{
+		MaxRows:  i.importConfig.MaxRows,
+		MaxBytes: i.importConfig.MaxBytes,
+	}
+}
+
+// ImportBooks adds the rows of an import file to the catalogue in batches, one transaction
+// per batch. Rows that already failed to decode are reported as they are; the others are
+// validated like a single added book and skipped when their ISBN is already catalogued or
+// appears earlier in the file. A dry run validates and checks for duplicates without writing.
+func (i *ImportUseCase) ImportBooks(ctx context.Context, rows []domain.ImportRow, dryRun bool) (domain.ImportReport, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.ImportReport{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := i.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.ImportReport{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.ImportReport{}, errorhandler.ErrForbidden
+	}
+
+	if len(rows) > i.importConfig.MaxRows {
+		return domain.ImportReport{}, fmt.Errorf("%w: at most %d rows can be imported at once", errorhandler.ErrImportTooLarge, i.importConfig.MaxRows)
+	}
+
+	firstLine := make(map[string]uint)
+	for n := range rows {
+		row := &rows[n]
+		if row.Status != domain.ImportStatusPending {
+			continue
+		}
+		if err := normalizeISBN(&row.Book); err != nil {
+			row.Status = domain.ImportStatusFailed
+			row.Reason = err.Error()
+			continue
+		}
+		if err := normalizeEdition(&row.Book); err != nil {
+			row.Status = domain.ImportStatusFailed
+			row.Reason = err.Error()
+			continue
+		}
+		if err := resolveAuthors(ctx, i.authorRepository, &row.Book); err != nil {
+			if !errors.Is(err, errorhandler.ErrAuthorNotFound) && !errors.Is(err, errorhandler.ErrBookAuthorRequired) {
+				return domain.ImportReport{}, err
+			}
+			row.Status = domain.ImportStatusFailed
+			row.Reason = err.Error()
+			continue
+		}
+		if err := resolveVocabulary(ctx, i.subjectRepository, i.genreRepository, &row.Book); err != nil {
+			if !errors.Is(err, errorhandler.ErrUnknownSubject) && !errors.Is(err, errorhandler.ErrUnknownGenre) {
+				return domain.ImportReport{}, err
+			}
+			row.Status = domain.ImportStatusFailed
+			row.Reason = err.Error()
+			continue
+		}
+		if row.Book.ISBN13 == "" {
+			continue
+		}
+		if line, ok := firstLine[row.Book.ISBN13]; ok {
+			row.Status = domain.ImportStatusSkipped
+			row.Reason = fmt.Sprintf("same ISBN as line %d", line)
+			continue
+		}
+		firstLine[row.Book.ISBN13] = row.Line
+	}
+
+	for start := 0; start < len(rows); start += i.importConfig.BatchSize {
+		end := min(start+i.importConfig.BatchSize, len(rows))
+		batch := rows[start:end]
+
+		if dryRun {
+			err = i.checkBatch(ctx, batch)
+		} else {
+			batch, err = i.bookRepository.ImportBooks(ctx, batch)
+			copy(rows[start:end], batch)
+		}
+		if err != nil {
+			return domain.ImportReport{}, err
+		}
+	}
+
+	report := domain.ImportReport{
+		DryRun: dryRun,
+		Rows:   rows,
+	}
+	for _, row := range rows {
+		switch row.Status {
+		case domain.ImportStatusCreated:
+			report.Created++
+		case domain.ImportStatusSkipped:
+			report.Skipped++
+		case domain.ImportStatusFailed:
+			report.Failed++
+		}
+	}
+	return report, nil
+}
+
+// checkBatch settles the pending rows of a dry run: rows whose ISBN is already catalogued are
+// skipped and the rest would be created.
+func (i *ImportUseCase) checkBatch(ctx context.Context, batch []domain.ImportRow) error {
+	var isbns []string
+	for _, row := range batch {
+		if row.Status == domain.ImportStatusPending && row.Book.ISBN13 != "" {
+			isbns = append(isbns, row.Book.ISBN13)
+		}
+	}
+
+	catalogued := make(map[string]bool)
+	if len(isbns) > 0 {
+		books, err := i.bookRepository.GetBooksByISBN(ctx, isbns)
+		if err != nil {
+			return err
+		}
+		for _, book := range books {
+			catalogued[book.ISBN13] = true
+		}
+	}
+
+	for n := range batch {
+		row := &batch[n]
+		if row.Status != domain.ImportStatusPending {
+			continue
+		}
+		if catalogued[row.Book.ISBN13] {
+			row.Status = domain.ImportStatusSkipped
+			row.Reason = errorhandler.ErrDuplicateISBN.Error()
+			continue
+		}
+		row.Status = domain.ImportStatusCreated
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+)
+
+type LoanUseCase struct {
+	bookRepository ports.BookRepository
+	loanRepository ports.LoanRepository
+	authService    *auth.AuthService
+}
+
+func NewLoanUseCase() *LoanUseCase {
+	return &LoanUseCase{
+		bookRepository: repository.NewBookRepository(),
+		loanRepository: repository.NewLoanRepository(),
+		authService:    auth.NewAuthService(),
+	}
+}
+
+// GetLoans handles logic for listing the loan history of the whole library
+func (l *LoanUseCase) GetLoans(ctx context.Context) ([]domain.Loan, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Loan{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := l.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Loan{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return []domain.Loan{}, errorhandler.ErrForbidden
+	}
+
+	loans, err := l.loanRepository.GetLoans(ctx, domain.Loan{})
+	if err != nil {
+		return []domain.Loan{}, err
+	}
+	return loans, nil
+}
+
+// GetUserLoans handles logic for listing the loans of a single patron
+func (l *LoanUseCase) GetUserLoans(ctx context.Context, loan domain.Loan) ([]domain.Loan, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Loan{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := l.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Loan{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if claims.ID != loan.UserID && !claims.IsAdmin {
+		return []domain.Loan{}, errorhandler.ErrForbidden
+	}
+
+	loans, err := l.loanRepository.GetLoans(ctx, domain.Loan{UserID: loan.UserID})
+	if err != nil {
+		return []domain.Loan{}, err
+	}
+	return loans, nil
+}
+
+// GetBookLoans handles logic for listing the loan history of a book
+func (l *LoanUseCase) GetBookLoans(ctx context.Context, loan domain.Loan) ([]domain.Loan, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Loan{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := l.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Loan{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return []domain.Loan{}, errorhandler.ErrForbidden
+	}
+
+	_, err = l.bookRepository.GetBook(ctx, domain.Book{ID: loan.BookID})
+	if err != nil {
+		return []domain.Loan{}, err
+	}
+
+	loans, err := l.loanRepository.GetLoans(ctx, domain.Loan{BookID: loan.BookID})
+	if err != nil {
+		return []domain.Loan{}, err
+	}
+	return loans, nil
+}
+
+// GetMyLoans handles logic for listing the loans of the caller
+func (l *LoanUseCase) GetMyLoans(ctx context.Context) ([]domain.Loan, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Loan{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := l.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Loan{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	loans, err := l.loanRepository.GetLoans(ctx, domain.Loan{UserID: claims.ID})
+	if err != nil {
+		return []domain.Loan{}, err
+	}
+	return loans, nil
+}
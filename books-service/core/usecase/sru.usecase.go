@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/listquery"
+)
+
+// SruUseCase searches the catalogue on behalf of partner discovery tools. Like harvesting, it is
+// anonymous.
+type SruUseCase struct {
+	bookRepository ports.BookRepository
+	sruConfig      configs.SRU
+}
+
+func NewSruUseCase() *SruUseCase {
+	return &SruUseCase{
+		bookRepository: repository.NewBookRepository(),
+		sruConfig:      configs.C().SRU,
+	}
+}
+
+// SearchService describes the endpoint as configured.
+func (s *SruUseCase) SearchService() domain.SearchService {
+	return domain.SearchService{
+		BaseURL:        s.sruConfig.BaseURL,
+		Title:          s.sruConfig.DatabaseTitle,
+		DefaultRecords: s.sruConfig.DefaultRecords,
+		MaxRecords:     s.sruConfig.MaxRecords,
+	}
+}
+
+// SearchRetrieve returns the books matching the condition, in ID order, starting at offset.
+// The number of books is capped at the configured maximum.
+func (s *SruUseCase) SearchRetrieve(ctx context.Context, condition domain.BookCondition, offset int, limit int) (listquery.Page[domain.Book], error) {
+	if limit > s.sruConfig.MaxRecords {
+		limit = s.sruConfig.MaxRecords
+	}
+	return s.bookRepository.FindBooks(ctx, condition, offset, limit)
+}
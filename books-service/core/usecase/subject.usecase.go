@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"strings"
+)
+
+type SubjectUseCase struct {
+	subjectRepository ports.SubjectRepository
+	authService       *auth.AuthService
+}
+
+func NewSubjectUseCase() *SubjectUseCase {
+	return &SubjectUseCase{
+		subjectRepository: repository.NewSubjectRepository(),
+		authService:       auth.NewAuthService(),
+	}
+}
+
+// normalizeTermName collapses the whitespace of a subject or genre name.
+func normalizeTermName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// normalizeSubject cleans up the name of a subject and rejects a subject that is its own parent.
+func normalizeSubject(subject *domain.Subject) error {
+	subject.Name = normalizeTermName(subject.Name)
+	if subject.Name == "" {
+		return errorhandler.ErrInvalidTermName
+	}
+	if subject.ParentID != 0 && subject.ParentID == subject.ID {
+		return errorhandler.ErrSubjectCycle
+	}
+	return nil
+}
+
+func (s *SubjectUseCase) AddSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Subject{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := s.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Subject{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Subject{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeSubject(&subject); err != nil {
+		return domain.Subject{}, err
+	}
+
+	addedSubject, err := s.subjectRepository.AddSubject(ctx, subject)
+	if err != nil {
+		return domain.Subject{}, err
+	}
+	return addedSubject, nil
+}
+
+func (s *SubjectUseCase) GetSubjects(ctx context.Context) ([]domain.Subject, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Subject{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := s.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Subject{}, sessionError(err)
+	}
+
+	subjects, err := s.subjectRepository.GetSubjects(ctx)
+	if err != nil {
+		return []domain.Subject{}, err
+	}
+	return subjects, nil
+}
+
+// UpdateSubject renames or moves a subject. The books filed under the subject follow the new name.
+func (s *SubjectUseCase) UpdateSubject(ctx context.Context, subject domain.Subject) (domain.Subject, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Subject{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := s.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Subject{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Subject{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeSubject(&subject); err != nil {
+		return domain.Subject{}, err
+	}
+
+	updatedSubject, err := s.subjectRepository.UpdateSubject(ctx, subject)
+	if err != nil {
+		return domain.Subject{}, err
+	}
+	return updatedSubject, nil
+}
+
+func (s *SubjectUseCase) DeleteSubject(ctx context.Context, subject domain.Subject) error {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := s.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return errorhandler.ErrForbidden
+	}
+
+	return s.subjectRepository.DeleteSubject(ctx, subject)
+}
+
+// MergeSubject folds source into target: its books and narrower subjects are filed under target.
+func (s *SubjectUseCase) MergeSubject(ctx context.Context, source domain.Subject, target domain.Subject) (domain.Subject, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Subject{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := s.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Subject{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Subject{}, errorhandler.ErrForbidden
+	}
+
+	if source.ID == target.ID {
+		return domain.Subject{}, errorhandler.ErrMergeIntoSelf
+	}
+
+	mergedSubject, err := s.subjectRepository.MergeSubject(ctx, source, target)
+	if err != nil {
+		return domain.Subject{}, err
+	}
+	return mergedSubject, nil
+}
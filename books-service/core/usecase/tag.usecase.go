@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"strings"
+)
+
+type TagUseCase struct {
+	tagRepository ports.TagRepository
+	authService   *auth.AuthService
+}
+
+func NewTagUseCase() *TagUseCase {
+	return &TagUseCase{
+		tagRepository: repository.NewTagRepository(),
+		authService:   auth.NewAuthService(),
+	}
+}
+
+// normalizeTagName collapses the whitespace of a tag name.
+func normalizeTagName(tag *domain.Tag) error {
+	tag.Name = strings.Join(strings.Fields(tag.Name), " ")
+	if tag.Name == "" {
+		return errorhandler.ErrInvalidTagName
+	}
+	return nil
+}
+
+func (t *TagUseCase) AddTag(ctx context.Context, tag domain.Tag) (domain.Tag, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Tag{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := t.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Tag{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Tag{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeTagName(&tag); err != nil {
+		return domain.Tag{}, err
+	}
+
+	addedTag, err := t.tagRepository.AddTag(ctx, tag)
+	if err != nil {
+		return domain.Tag{}, err
+	}
+	return addedTag, nil
+}
+
+func (t *TagUseCase) GetTags(ctx context.Context) ([]domain.Tag, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return []domain.Tag{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := t.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return []domain.Tag{}, sessionError(err)
+	}
+
+	tags, err := t.tagRepository.GetTags(ctx)
+	if err != nil {
+		return []domain.Tag{}, err
+	}
+	return tags, nil
+}
+
+// UpdateTag renames a tag. The books carrying the tag show the new name.
+func (t *TagUseCase) UpdateTag(ctx context.Context, tag domain.Tag) (domain.Tag, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Tag{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := t.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Tag{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Tag{}, errorhandler.ErrForbidden
+	}
+
+	if err := normalizeTagName(&tag); err != nil {
+		return domain.Tag{}, err
+	}
+
+	updatedTag, err := t.tagRepository.UpdateTag(ctx, tag)
+	if err != nil {
+		return domain.Tag{}, err
+	}
+	return updatedTag, nil
+}
+
+func (t *TagUseCase) DeleteTag(ctx context.Context, tag domain.Tag) error {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := t.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return errorhandler.ErrForbidden
+	}
+
+	return t.tagRepository.DeleteTag(ctx, tag)
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"library-management-api/books-service/adapter/repository"
+	"library-management-api/books-service/adapter/service/auth"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/core/ports"
+	"library-management-api/util/errorhandler"
+	"strings"
+)
+
+type WorkUseCase struct {
+	workRepository ports.WorkRepository
+	authService    *auth.AuthService
+}
+
+func NewWorkUseCase() *WorkUseCase {
+	return &WorkUseCase{
+		workRepository: repository.NewWorkRepository(),
+		authService:    auth.NewAuthService(),
+	}
+}
+
+func (w *WorkUseCase) AddWork(ctx context.Context, work domain.Work) (domain.Work, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Work{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	verifyTokenRes, err := w.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Work{}, sessionError(err)
+	}
+	claims := verifyTokenRes.Claims
+
+	if !claims.IsAdmin {
+		return domain.Work{}, errorhandler.ErrForbidden
+	}
+
+	work.Title = strings.Join(strings.Fields(work.Title), " ")
+	if work.Title == "" {
+		return domain.Work{}, errorhandler.ErrWorkTitleRequired
+	}
+
+	addedWork, err := w.workRepository.AddWork(ctx, work)
+	if err != nil {
+		return domain.Work{}, err
+	}
+	return addedWork, nil
+}
+
+func (w *WorkUseCase) GetWork(ctx context.Context, work domain.Work) (domain.Work, error) {
+	contextToken, ok := ctx.Value("token").(string)
+	if !ok {
+		return domain.Work{}, errorhandler.ErrInvalidSession
+	}
+
+	verifyTokenReq := domain.Auth{
+		AccessToken: contextToken,
+	}
+	_, err := w.authService.VerifyToken(ctx, verifyTokenReq)
+	if err != nil {
+		return domain.Work{}, sessionError(err)
+	}
+
+	foundWork, err := w.workRepository.GetWork(ctx, work)
+	if err != nil {
+		return domain.Work{}, err
+	}
+	return foundWork, nil
+}
@@ -1 +1,38 @@
 package grpc
+
+import (
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	grpcController "library-management-api/books-service/api/grpc"
+	"library-management-api/books-service/configs"
+	"library-management-api/pkg/proto/books"
+	"library-management-api/util/grpcconn"
+	"net"
+)
+
+func RunGRPC() {
+	lis, err := net.Listen("tcp", configs.C().GRPC.Address)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to listen")
+	}
+
+	srv := grpc.NewServer(grpcconn.ServerOptions(configs.C().GRPC.UnaryTimeout, configs.C().GRPC.StreamIdleTimeout)...)
+	books.RegisterBooksServiceServer(srv, grpcController.NewBookController())
+	books.RegisterAuthorsServiceServer(srv, grpcController.NewAuthorController())
+	books.RegisterWorksServiceServer(srv, grpcController.NewWorkController())
+	books.RegisterTagsServiceServer(srv, grpcController.NewTagController())
+	books.RegisterSubjectsServiceServer(srv, grpcController.NewSubjectController())
+	books.RegisterGenresServiceServer(srv, grpcController.NewGenreController())
+	books.RegisterCopiesServiceServer(srv, grpcController.NewCopyController())
+	books.RegisterLoansServiceServer(srv, grpcController.NewLoanController())
+	books.RegisterHoldsServiceServer(srv, grpcController.NewHoldController())
+	books.RegisterFinesServiceServer(srv, grpcController.NewFineController())
+	books.RegisterCoversServiceServer(srv, grpcController.NewCoverController())
+	books.RegisterCatalogueServiceServer(srv, grpcController.NewCatalogueController())
+	books.RegisterProtocolServiceServer(srv, grpcController.NewProtocolController())
+
+	log.Info().Msgf("server started at %s", lis.Addr().String())
+	if err = srv.Serve(lis); err != nil {
+		log.Fatal().Err(err).Msg("failed to serve")
+	}
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"library-management-api/books-service/configs"
+	"library-management-api/books-service/core/usecase"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RunJobs starts the background jobs of the books service.
+// The jobs run for the lifetime of the process.
+func RunJobs() {
+	go runHoldSweep(configs.C().Hold.SweepInterval)
+	go runFineAccrual(configs.C().Fine.ScanInterval)
+}
+
+// runHoldSweep periodically expires uncollected holds and fills waiting ones.
+func runHoldSweep(interval time.Duration) {
+	holdUseCase := usecase.NewHoldUseCase()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		err := holdUseCase.ProcessHolds(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("hold sweep failed")
+		}
+	}
+}
+
+// runFineAccrual periodically charges overdue loans for the days they have been late.
+func runFineAccrual(interval time.Duration) {
+	fineUseCase := usecase.NewFineUseCase()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		err := fineUseCase.AccrueFines(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("fine accrual failed")
+		}
+	}
+}
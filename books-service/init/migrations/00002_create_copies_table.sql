@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE copies (
+    id SERIAL PRIMARY KEY,
+    book_id INT NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    barcode VARCHAR(64) NOT NULL UNIQUE,
+    shelf_location VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'available',
+    borrower_id INT,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX copies_book_id_idx ON copies (book_id);
+
+-- Every existing book row becomes the first copy of its title.
+INSERT INTO copies (book_id, barcode, status, borrower_id, created_at)
+SELECT id,
+       'B' || LPAD(id::text, 8, '0'),
+       CASE WHEN available THEN 'available' ELSE 'borrowed' END,
+       borrower_id,
+       created_at
+FROM books;
+
+ALTER TABLE books DROP COLUMN available;
+ALTER TABLE books DROP COLUMN borrower_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE books ADD COLUMN available BOOLEAN DEFAULT TRUE;
+ALTER TABLE books ADD COLUMN borrower_id INT;
+
+UPDATE books b
+SET available = NOT EXISTS (SELECT 1 FROM copies c WHERE c.book_id = b.id AND c.status = 'borrowed'),
+    borrower_id = (SELECT c.borrower_id FROM copies c WHERE c.book_id = b.id AND c.status = 'borrowed' LIMIT 1);
+
+DROP TABLE IF EXISTS copies;
+-- +goose StatementEnd
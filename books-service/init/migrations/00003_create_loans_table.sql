@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Loans are circulation history: a book that has ever been lent cannot be deleted.
+CREATE TABLE loans (
+    id SERIAL PRIMARY KEY,
+    copy_id INT REFERENCES copies (id) ON DELETE SET NULL,
+    book_id INT NOT NULL REFERENCES books (id) ON DELETE RESTRICT,
+    user_id INT NOT NULL,
+    borrowed_at timestamptz NOT NULL DEFAULT NOW(),
+    due_at timestamptz,
+    returned_at timestamptz,
+    borrowed_by INT NOT NULL,
+    returned_by INT
+);
+
+CREATE INDEX loans_user_id_idx ON loans (user_id);
+CREATE INDEX loans_book_id_idx ON loans (book_id);
+-- A copy can only be out on one loan at a time.
+CREATE UNIQUE INDEX loans_open_copy_id_idx ON loans (copy_id) WHERE returned_at IS NULL;
+
+-- Copies that are out right now get an open loan so they can be returned.
+INSERT INTO loans (copy_id, book_id, user_id, borrowed_by)
+SELECT id, book_id, borrower_id, borrower_id
+FROM copies
+WHERE status = 'borrowed' AND borrower_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS loans;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE holds (
+    id SERIAL PRIMARY KEY,
+    book_id INT NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+    copy_id INT REFERENCES copies (id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    ready_at timestamptz,
+    expires_at timestamptz
+);
+
+CREATE INDEX holds_book_id_status_idx ON holds (book_id, status, created_at);
+CREATE INDEX holds_user_id_idx ON holds (user_id);
+-- A patron can only queue once per book.
+CREATE UNIQUE INDEX holds_open_book_user_idx ON holds (book_id, user_id) WHERE status IN ('waiting', 'ready');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE copies SET status = 'available' WHERE status = 'on_hold';
+DROP TABLE IF EXISTS holds;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Append-only ledger; a patron's balance is charges minus payments and waivers.
+CREATE TABLE fines (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    loan_id INT REFERENCES loans (id) ON DELETE SET NULL,
+    kind VARCHAR(20) NOT NULL,
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    note TEXT NOT NULL DEFAULT '',
+    created_by INT,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX fines_user_id_idx ON fines (user_id);
+CREATE INDEX fines_loan_id_idx ON fines (loan_id) WHERE kind = 'charge';
+CREATE INDEX loans_open_due_at_idx ON loans (due_at) WHERE returned_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS loans_open_due_at_idx;
+DROP TABLE IF EXISTS fines;
+-- +goose StatementEnd
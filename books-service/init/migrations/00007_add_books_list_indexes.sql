@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyset pagination orders by the sort column and breaks ties on id.
+CREATE INDEX books_title_id_idx ON books (title, id);
+CREATE INDEX books_author_id_idx ON books (author, id);
+CREATE INDEX books_published_year_id_idx ON books (published_year, id);
+CREATE INDEX books_created_at_id_idx ON books (created_at, id);
+CREATE INDEX books_genre_idx ON books (genre);
+CREATE INDEX books_subject_idx ON books (subject);
+CREATE INDEX copies_book_id_status_idx ON copies (book_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS copies_book_id_status_idx;
+DROP INDEX IF EXISTS books_subject_idx;
+DROP INDEX IF EXISTS books_genre_idx;
+DROP INDEX IF EXISTS books_created_at_id_idx;
+DROP INDEX IF EXISTS books_published_year_id_idx;
+DROP INDEX IF EXISTS books_author_id_idx;
+DROP INDEX IF EXISTS books_title_id_idx;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Weighted so that title matches rank above author matches, and both above subject and genre.
+ALTER TABLE books ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector('english', COALESCE(author, '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE(subject, '')), 'C') ||
+    setweight(to_tsvector('english', COALESCE(genre, '')), 'C')
+) STORED;
+
+CREATE INDEX books_search_vector_idx ON books USING GIN (search_vector);
+CREATE INDEX books_title_trgm_idx ON books USING GIN (title gin_trgm_ops);
+CREATE INDEX books_author_trgm_idx ON books USING GIN (author gin_trgm_ops);
+CREATE INDEX books_category_trgm_idx ON books USING GIN (category gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS books_category_trgm_idx;
+DROP INDEX IF EXISTS books_author_trgm_idx;
+DROP INDEX IF EXISTS books_title_trgm_idx;
+DROP INDEX IF EXISTS books_search_vector_idx;
+ALTER TABLE books DROP COLUMN IF EXISTS search_vector;
+DROP EXTENSION IF EXISTS pg_trgm;
+-- +goose StatementEnd
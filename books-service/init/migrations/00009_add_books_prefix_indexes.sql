@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Serve case-insensitive prefix lookups (lower(column) LIKE 'abc%') for suggestions.
+CREATE INDEX books_title_prefix_idx ON books (lower(title) text_pattern_ops);
+CREATE INDEX books_author_prefix_idx ON books (lower(author) text_pattern_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS books_author_prefix_idx;
+DROP INDEX IF EXISTS books_title_prefix_idx;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Both forms are stored normalized (digits only). Every book with an ISBN has an ISBN-13, so
+-- uniqueness is enforced on that column alone.
+ALTER TABLE books ADD COLUMN isbn_10 VARCHAR(10);
+ALTER TABLE books ADD COLUMN isbn_13 VARCHAR(13);
+ALTER TABLE books ADD CONSTRAINT books_isbn_13_key UNIQUE (isbn_13);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE books DROP CONSTRAINT IF EXISTS books_isbn_13_key;
+ALTER TABLE books DROP COLUMN IF EXISTS isbn_13;
+ALTER TABLE books DROP COLUMN IF EXISTS isbn_10;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- OAI-PMH harvesters select records by the time they last changed, so every book carries the
+-- time of its last update and every deleted book leaves a tombstone behind. Both are maintained
+-- by triggers so that no write path can forget them.
+ALTER TABLE books ADD COLUMN updated_at timestamptz;
+UPDATE books SET updated_at = created_at;
+ALTER TABLE books ALTER COLUMN updated_at SET DEFAULT NOW();
+ALTER TABLE books ALTER COLUMN updated_at SET NOT NULL;
+CREATE INDEX books_updated_at_idx ON books (updated_at, id);
+
+CREATE TABLE book_deletions (
+    book_id INT PRIMARY KEY,
+    deleted_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX book_deletions_deleted_at_idx ON book_deletions (deleted_at, book_id);
+
+CREATE FUNCTION books_touch_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at := NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Only changes to the described metadata count as updates; harvesters have no use for the rest.
+CREATE TRIGGER books_touch_updated_at BEFORE UPDATE ON books
+    FOR EACH ROW WHEN (
+        (OLD.title, OLD.author, OLD.category, OLD.subject, OLD.genre, OLD.published_year, OLD.isbn_10, OLD.isbn_13)
+        IS DISTINCT FROM
+        (NEW.title, NEW.author, NEW.category, NEW.subject, NEW.genre, NEW.published_year, NEW.isbn_10, NEW.isbn_13)
+    )
+    EXECUTE FUNCTION books_touch_updated_at();
+
+CREATE FUNCTION books_record_deletion() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO book_deletions (book_id) VALUES (OLD.id)
+        ON CONFLICT (book_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER books_record_deletion AFTER DELETE ON books
+    FOR EACH ROW EXECUTE FUNCTION books_record_deletion();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS books_record_deletion ON books;
+DROP FUNCTION IF EXISTS books_record_deletion();
+DROP TRIGGER IF EXISTS books_touch_updated_at ON books;
+DROP FUNCTION IF EXISTS books_touch_updated_at();
+DROP TABLE IF EXISTS book_deletions;
+DROP INDEX IF EXISTS books_updated_at_idx;
+ALTER TABLE books DROP COLUMN IF EXISTS updated_at;
+-- +goose StatementEnd
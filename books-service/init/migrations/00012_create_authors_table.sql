@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+-- author_name_key reduces a personal name to the key that identifies its author, so that
+-- "Tolkien, J.R.R." and "J. R. R. Tolkien" are recognized as the same person: inverted names are
+-- turned around, case is folded and punctuation dropped.
+CREATE FUNCTION author_name_key(name text) RETURNS text AS $$
+    SELECT btrim(regexp_replace(lower(
+        CASE WHEN position(',' IN name) > 0
+            THEN split_part(name, ',', 2) || ' ' || split_part(name, ',', 1)
+            ELSE name
+        END), '[^[:alnum:]]+', ' ', 'g'))
+$$ LANGUAGE sql IMMUTABLE STRICT;
+
+CREATE TABLE authors (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    name_key TEXT GENERATED ALWAYS AS (author_name_key(name)) STORED,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    CONSTRAINT authors_name_key_key UNIQUE (name_key),
+    CONSTRAINT authors_name_key_check CHECK (name_key <> '')
+);
+CREATE INDEX authors_name_trgm_idx ON authors USING GIN (name gin_trgm_ops);
+
+-- Position keeps the authors of a book in the order they are credited.
+CREATE TABLE book_authors (
+    book_id INT NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    author_id INT NOT NULL REFERENCES authors (id),
+    position INT NOT NULL,
+    PRIMARY KEY (book_id, author_id)
+);
+CREATE INDEX book_authors_author_id_idx ON book_authors (author_id, book_id);
+
+-- Co-authors used to share the author column, separated by semicolons. Of the spellings of a
+-- name, the most common one becomes the name of the author.
+INSERT INTO authors (name)
+SELECT DISTINCT ON (author_name_key(name)) name
+FROM (
+    SELECT btrim(name) AS name, COUNT(*) AS uses
+    FROM books, unnest(string_to_array(author, ';')) AS name
+    WHERE author_name_key(btrim(name)) <> ''
+    GROUP BY btrim(name)
+) spellings
+ORDER BY author_name_key(name), uses DESC, name;
+
+INSERT INTO book_authors (book_id, author_id, position)
+SELECT b.id, a.id, MIN(credit.position)
+FROM books b
+CROSS JOIN LATERAL unnest(string_to_array(b.author, ';')) WITH ORDINALITY AS credit (name, position)
+JOIN authors a ON a.name_key = author_name_key(btrim(credit.name))
+GROUP BY b.id, a.id;
+
+-- The author column is kept as the credited names, rewritten to the canonical spellings.
+UPDATE books b SET author = credits.names
+FROM (
+    SELECT ba.book_id, left(string_agg(a.name, '; ' ORDER BY ba.position), 255) AS names
+    FROM book_authors ba
+    JOIN authors a ON a.id = ba.author_id
+    GROUP BY ba.book_id
+) credits
+WHERE credits.book_id = b.id AND b.author IS DISTINCT FROM credits.names;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS book_authors;
+DROP TABLE IF EXISTS authors;
+DROP FUNCTION IF EXISTS author_name_key(text);
+-- +goose StatementEnd
@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A work groups the editions of the same text: printings, formats and translations. Books that
+-- are not grouped have no work and are their only edition.
+CREATE TABLE works (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- Series are found by title, ignoring case, when a book is catalogued.
+CREATE TABLE series (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX series_title_key ON series (lower(title));
+
+ALTER TABLE books
+    ADD COLUMN work_id INT REFERENCES works (id) ON DELETE SET NULL,
+    ADD COLUMN edition VARCHAR(100),
+    ADD COLUMN language VARCHAR(35),
+    ADD COLUMN format VARCHAR(20),
+    ADD COLUMN series_id INT REFERENCES series (id) ON DELETE SET NULL,
+    ADD COLUMN series_volume INT,
+    ADD CONSTRAINT books_format_check CHECK (format IN ('hardcover', 'paperback', 'ebook', 'audiobook')),
+    ADD CONSTRAINT books_series_volume_check CHECK (series_volume IS NULL OR (series_volume > 0 AND series_id IS NOT NULL));
+CREATE INDEX books_work_id_idx ON books (work_id, published_year, id);
+CREATE INDEX books_series_id_idx ON books (series_id, series_volume);
+
+-- An any edition hold is served by a copy of any book of the work of its book.
+ALTER TABLE holds ADD COLUMN any_edition BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The edition, language and format are described metadata too.
+DROP TRIGGER books_touch_updated_at ON books;
+CREATE TRIGGER books_touch_updated_at BEFORE UPDATE ON books
+    FOR EACH ROW WHEN (
+        (OLD.title, OLD.author, OLD.category, OLD.subject, OLD.genre, OLD.published_year, OLD.isbn_10, OLD.isbn_13, OLD.edition, OLD.language, OLD.format)
+        IS DISTINCT FROM
+        (NEW.title, NEW.author, NEW.category, NEW.subject, NEW.genre, NEW.published_year, NEW.isbn_10, NEW.isbn_13, NEW.edition, NEW.language, NEW.format)
+    )
+    EXECUTE FUNCTION books_touch_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS books_touch_updated_at ON books;
+CREATE TRIGGER books_touch_updated_at BEFORE UPDATE ON books
+    FOR EACH ROW WHEN (
+        (OLD.title, OLD.author, OLD.category, OLD.subject, OLD.genre, OLD.published_year, OLD.isbn_10, OLD.isbn_13)
+        IS DISTINCT FROM
+        (NEW.title, NEW.author, NEW.category, NEW.subject, NEW.genre, NEW.published_year, NEW.isbn_10, NEW.isbn_13)
+    )
+    EXECUTE FUNCTION books_touch_updated_at();
+ALTER TABLE holds DROP COLUMN IF EXISTS any_edition;
+ALTER TABLE books
+    DROP COLUMN IF EXISTS work_id,
+    DROP COLUMN IF EXISTS edition,
+    DROP COLUMN IF EXISTS language,
+    DROP COLUMN IF EXISTS format,
+    DROP COLUMN IF EXISTS series_id,
+    DROP COLUMN IF EXISTS series_volume;
+DROP TABLE IF EXISTS series;
+DROP TABLE IF EXISTS works;
+-- +goose StatementEnd
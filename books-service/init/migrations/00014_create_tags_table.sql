@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Tags are free-form labels librarians attach to books besides their category, subject and
+-- genre. Names are unique ignoring case.
+CREATE TABLE tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX tags_name_key ON tags (lower(name));
+
+CREATE TABLE book_tags (
+    book_id INT NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    tag_id INT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (book_id, tag_id)
+);
+CREATE INDEX book_tags_tag_id_idx ON book_tags (tag_id, book_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS book_tags;
+DROP TABLE IF EXISTS tags;
+-- +goose StatementEnd
@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Subject headings form a hierarchy: a heading with a parent narrows it. Books keep the name of
+-- their subject and genre, which must be the preferred name of a term of the vocabulary.
+CREATE TABLE subjects (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    parent_id INT REFERENCES subjects (id),
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX subjects_name_key ON subjects (lower(name));
+CREATE INDEX subjects_parent_id_idx ON subjects (parent_id);
+
+-- Synonyms are alternative names that resolve to a genre, such as "Sci-Fi" for
+-- "Science Fiction". A name is either a genre or a synonym, never both.
+CREATE TABLE genres (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX genres_name_key ON genres (lower(name));
+
+CREATE TABLE genre_synonyms (
+    genre_id INT NOT NULL REFERENCES genres (id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL
+);
+CREATE UNIQUE INDEX genre_synonyms_name_key ON genre_synonyms (lower(name));
+CREATE INDEX genre_synonyms_genre_id_idx ON genre_synonyms (genre_id);
+
+-- The vocabulary starts out as the values already in use. Of the spellings of a value that only
+-- differ in case, the most common one becomes the name of the term.
+INSERT INTO subjects (name)
+SELECT DISTINCT ON (lower(subject)) subject
+FROM (SELECT btrim(subject) AS subject, COUNT(*) AS uses FROM books WHERE btrim(subject) <> '' GROUP BY btrim(subject)) spellings
+ORDER BY lower(subject), uses DESC, subject;
+
+INSERT INTO genres (name)
+SELECT DISTINCT ON (lower(genre)) genre
+FROM (SELECT btrim(genre) AS genre, COUNT(*) AS uses FROM books WHERE btrim(genre) <> '' GROUP BY btrim(genre)) spellings
+ORDER BY lower(genre), uses DESC, genre;
+
+UPDATE books b SET subject = s.name
+FROM subjects s
+WHERE lower(btrim(b.subject)) = lower(s.name) AND b.subject <> s.name;
+
+UPDATE books b SET genre = g.name
+FROM genres g
+WHERE lower(btrim(b.genre)) = lower(g.name) AND b.genre <> g.name;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS genre_synonyms;
+DROP TABLE IF EXISTS genres;
+DROP TABLE IF EXISTS subjects;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The cover image of a book and its thumbnail are kept in file storage under the given keys;
+-- the columns are either all set or all null.
+ALTER TABLE books
+    ADD COLUMN cover_key VARCHAR(255),
+    ADD COLUMN cover_thumbnail_key VARCHAR(255),
+    ADD COLUMN cover_content_type VARCHAR(50),
+    ADD COLUMN cover_updated_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE books
+    DROP COLUMN IF EXISTS cover_key,
+    DROP COLUMN IF EXISTS cover_thumbnail_key,
+    DROP COLUMN IF EXISTS cover_content_type,
+    DROP COLUMN IF EXISTS cover_updated_at;
+-- +goose StatementEnd
@@ -0,0 +1,282 @@
+// Package cql parses queries in the Contextual Query Language used by SRU. It covers the
+// search clause and boolean grammar of CQL 1.2, with relation and boolean modifiers; prefix
+// assignments and sortBy are not supported. Interpreting indexes and relations is left to the
+// caller.
+package cql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Node is a node of a parsed query, either a *Clause or a *Boolean.
+type Node interface {
+	node()
+}
+
+// Clause is a search clause. A clause given as a bare term has the index cql.serverChoice and
+// the relation "=". Term is kept as written, with backslash escapes and the * and ? masking
+// characters left in place.
+type Clause struct {
+	Index     string
+	Relation  string
+	Modifiers []Modifier
+	Term      string
+}
+
+// Boolean combines two queries with AND, OR, NOT or PROX. Op is upper case.
+type Boolean struct {
+	Op        string
+	Modifiers []Modifier
+	Left      Node
+	Right     Node
+}
+
+// Modifier is a relation or boolean modifier such as /relevant or /distance<3. Comparison and
+// Value are empty when the modifier has no value.
+type Modifier struct {
+	Name       string
+	Comparison string
+	Value      string
+}
+
+func (*Clause) node()  {}
+func (*Boolean) node() {}
+
+// ServerChoice is the index of clauses given as a bare term.
+const ServerChoice = "cql.serverChoice"
+
+// SyntaxError reports a query that does not follow the grammar.
+type SyntaxError struct {
+	Pos     int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("cql: %s at position %d", e.Message, e.Pos)
+}
+
+// Parse parses a query.
+func Parse(query string) (Node, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	node, err := p.query()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return node, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenComparison
+	tokenLParen
+	tokenRParen
+	tokenSlash
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// comparisons are the symbolic relations, longest first so that they are matched greedily.
+var comparisons = []string{"==", "<>", "<=", ">=", "=", "<", ">"}
+
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(query) {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokenLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokenRParen, ")", i})
+			i++
+		case c == '/':
+			tokens = append(tokens, token{tokenSlash, "/", i})
+			i++
+		case c == '"':
+			start := i
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(query) {
+					return nil, &SyntaxError{Pos: start, Message: "unterminated quoted term"}
+				}
+				if query[i] == '\\' && i+1 < len(query) {
+					b.WriteString(query[i : i+2])
+					i += 2
+					continue
+				}
+				if query[i] == '"' {
+					i++
+					break
+				}
+				b.WriteByte(query[i])
+				i++
+			}
+			tokens = append(tokens, token{tokenString, b.String(), start})
+		case c == '=' || c == '<' || c == '>':
+			for _, comparison := range comparisons {
+				if strings.HasPrefix(query[i:], comparison) {
+					tokens = append(tokens, token{tokenComparison, comparison, i})
+					i += len(comparison)
+					break
+				}
+			}
+		default:
+			start := i
+			for i < len(query) && !strings.ContainsRune(" \t\n\r()/\"=<>", rune(query[i])) {
+				if query[i] == '\\' && i+1 < len(query) {
+					i++
+				}
+				i++
+			}
+			tokens = append(tokens, token{tokenWord, query[start:i], start})
+		}
+	}
+	return append(tokens, token{tokenEOF, "", len(query)}), nil
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) peekAt(n int) token {
+	if p.i+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.i+n]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	if t.kind == tokenEOF {
+		return &SyntaxError{Pos: t.pos, Message: "unexpected end of query"}
+	}
+	return &SyntaxError{Pos: t.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// isBoolean reports whether the token is a boolean operator. Booleans are case-insensitive.
+func isBoolean(t token) bool {
+	if t.kind != tokenWord {
+		return false
+	}
+	switch strings.ToUpper(t.text) {
+	case "AND", "OR", "NOT", "PROX":
+		return true
+	}
+	return false
+}
+
+// query parses clauses joined by booleans, which all bind equally tight and associate to the left.
+func (p *parser) query() (Node, error) {
+	left, err := p.clause()
+	if err != nil {
+		return nil, err
+	}
+	for isBoolean(p.peek()) {
+		op := strings.ToUpper(p.next().text)
+		modifiers, err := p.modifiers()
+		if err != nil {
+			return nil, err
+		}
+		right, err := p.clause()
+		if err != nil {
+			return nil, err
+		}
+		left = &Boolean{Op: op, Modifiers: modifiers, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) clause() (Node, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tokenLParen:
+		p.next()
+		node, err := p.query()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenRParen {
+			return nil, p.errorf(t, "expected ) but found %q", t.text)
+		}
+		return node, nil
+	case t.kind == tokenString || t.kind == tokenWord && !isBoolean(t):
+	default:
+		return nil, p.errorf(t, "expected a search term but found %q", t.text)
+	}
+
+	// A term followed by a relation is an index; otherwise the term stands alone
+	relation := p.peekAt(1)
+	hasRelation := relation.kind == tokenComparison || relation.kind == tokenWord && !isBoolean(relation)
+	if !hasRelation {
+		p.next()
+		return &Clause{Index: ServerChoice, Relation: "=", Term: t.text}, nil
+	}
+	if t.kind != tokenWord {
+		return nil, p.errorf(t, "an index cannot be quoted")
+	}
+	p.next()
+	p.next()
+	modifiers, err := p.modifiers()
+	if err != nil {
+		return nil, err
+	}
+	term := p.next()
+	if term.kind != tokenWord && term.kind != tokenString {
+		return nil, p.errorf(term, "expected a search term but found %q", term.text)
+	}
+	return &Clause{Index: t.text, Relation: strings.ToLower(relation.text), Modifiers: modifiers, Term: term.text}, nil
+}
+
+func (p *parser) modifiers() ([]Modifier, error) {
+	var modifiers []Modifier
+	for p.peek().kind == tokenSlash {
+		p.next()
+		name := p.next()
+		if name.kind != tokenWord {
+			return nil, p.errorf(name, "expected a modifier but found %q", name.text)
+		}
+		modifier := Modifier{Name: name.text}
+		if p.peek().kind == tokenComparison {
+			modifier.Comparison = p.next().text
+			value := p.next()
+			if value.kind != tokenWord && value.kind != tokenString {
+				return nil, p.errorf(value, "expected a modifier value but found %q", value.text)
+			}
+			modifier.Value = value.text
+		}
+		modifiers = append(modifiers, modifier)
+	}
+	return modifiers, nil
+}
@@ -0,0 +1,109 @@
+package cql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// render writes a parsed query in a fully parenthesized form, so that tests can compare trees
+// as strings. Clauses are written as index relation "term", modifiers as /name, /name<value.
+func render(n Node) string {
+	modifiers := func(ms []Modifier) string {
+		s := ""
+		for _, m := range ms {
+			s += "/" + m.Name + m.Comparison + m.Value
+		}
+		return s
+	}
+	switch n := n.(type) {
+	case *Clause:
+		return fmt.Sprintf("%s %s%s %q", n.Index, n.Relation, modifiers(n.Modifiers), n.Term)
+	case *Boolean:
+		return fmt.Sprintf("(%s %s%s %s)", render(n.Left), n.Op, modifiers(n.Modifiers), render(n.Right))
+	}
+	return fmt.Sprintf("%T", n)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{name: "bare term", query: "dune", want: `cql.serverChoice = "dune"`},
+		{name: "index and relation", query: "dc.title = dune", want: `dc.title = "dune"`},
+		{name: "no spaces around comparison", query: "title=dune", want: `title = "dune"`},
+		{name: "exact relation", query: "title == dune", want: `title == "dune"`},
+		{name: "comparison relations", query: "date >= 1990", want: `date >= "1990"`},
+		{name: "not equal", query: "date <> 1990", want: `date <> "1990"`},
+		{name: "named relation lower cased", query: "title ANY dune", want: `title any "dune"`},
+		{name: "prefixed named relation", query: "title cql.all dune", want: `title cql.all "dune"`},
+		{name: "any word can be a relation", query: "dune messiah children", want: `dune messiah "children"`},
+
+		{name: "quoted term", query: `"the left hand of darkness"`, want: `cql.serverChoice = "the left hand of darkness"`},
+		{name: "quoted term after relation", query: `dc.creator = "le guin"`, want: `dc.creator = "le guin"`},
+		{name: "escaped quote kept", query: `title = "say \"hi\""`, want: `title = "say \\\"hi\\\""`},
+		{name: "quoted boolean is a term", query: `"and"`, want: `cql.serverChoice = "and"`},
+		{name: "masking kept", query: "title = dun* and tit?e", want: `(title = "dun*" AND cql.serverChoice = "tit?e")`},
+		{name: "escaped special character in a word", query: `title = a\(b`, want: `title = "a\\(b"`},
+
+		{name: "booleans are case insensitive", query: "a and b Or c", want: `((cql.serverChoice = "a" AND cql.serverChoice = "b") OR cql.serverChoice = "c")`},
+		{name: "booleans bind equally from the left", query: "a or b and c", want: `((cql.serverChoice = "a" OR cql.serverChoice = "b") AND cql.serverChoice = "c")`},
+		{name: "not is binary", query: "a not b", want: `(cql.serverChoice = "a" NOT cql.serverChoice = "b")`},
+		{name: "parentheses group", query: "a and (b or c)", want: `(cql.serverChoice = "a" AND (cql.serverChoice = "b" OR cql.serverChoice = "c"))`},
+		{name: "redundant parentheses", query: "((title = dune))", want: `title = "dune"`},
+		{name: "clauses with indexes", query: `title = dune and dc.creator any "herbert frank"`, want: `(title = "dune" AND dc.creator any "herbert frank")`},
+
+		{name: "relation modifier", query: "title =/relevant dune", want: `title =/relevant "dune"`},
+		{name: "relation modifiers with value", query: "title any/relevant/cql.string=x dune", want: `title any/relevant/cql.string=x "dune"`},
+		{name: "boolean modifier", query: "a prox/distance<=2/unit=word b", want: `(cql.serverChoice = "a" PROX/distance<=2/unit=word cql.serverChoice = "b")`},
+		{name: "quoted modifier value", query: `title =/locale="en us" dune`, want: `title =/locale=en us "dune"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.query, err)
+			}
+			if got := render(node); got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantPos int
+	}{
+		{name: "empty", query: "", wantPos: 0},
+		{name: "only spaces", query: "   ", wantPos: 3},
+		{name: "unterminated quote", query: `title = "dune`, wantPos: 8},
+		{name: "missing term", query: "title =", wantPos: 7},
+		{name: "dangling boolean", query: "dune and", wantPos: 8},
+		{name: "leading boolean", query: "and dune", wantPos: 0},
+		{name: "unclosed parenthesis", query: "(a or b", wantPos: 7},
+		{name: "unopened parenthesis", query: "a or b)", wantPos: 6},
+		{name: "empty parentheses", query: "()", wantPos: 1},
+		{name: "quoted index", query: `"title" = dune`, wantPos: 0},
+		{name: "term after clause", query: "dune messiah children more", wantPos: 22},
+		{name: "modifier without name", query: "title =/ = dune", wantPos: 9},
+		{name: "modifier without value", query: "title =/locale=) dune", wantPos: 15},
+		{name: "boolean modifier without name", query: "a and/(b)", wantPos: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.query)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse(%q) = %v, %v, want a SyntaxError", tt.query, node, err)
+			}
+			if syntaxErr.Pos != tt.wantPos {
+				t.Errorf("Parse(%q) error at position %d, want %d (%v)", tt.query, syntaxErr.Pos, tt.wantPos, err)
+			}
+		})
+	}
+}
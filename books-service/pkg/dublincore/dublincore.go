@@ -0,0 +1,116 @@
+// Package dublincore describes books as simple Dublin Core records in the oai_dc XML schema
+// used by OAI-PMH and SRU.
+package dublincore
+
+import (
+	"encoding/xml"
+	"io"
+	"library-management-api/books-service/core/domain"
+	"strconv"
+)
+
+const (
+	// NamespaceOAIDC is the namespace of the oai_dc container element.
+	NamespaceOAIDC = "http://www.openarchives.org/OAI/2.0/oai_dc/"
+	// NamespaceDC is the namespace of the Dublin Core elements.
+	NamespaceDC = "http://purl.org/dc/elements/1.1/"
+	// SchemaLocation locates the oai_dc schema.
+	SchemaLocation = NamespaceOAIDC + " http://www.openarchives.org/OAI/2.0/oai_dc.xsd"
+
+	namespaceXSI = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// Record is an oai_dc record. Every element may repeat and is omitted when empty.
+type Record struct {
+	XMLName        xml.Name `xml:"oai_dc:dc"`
+	NamespaceOAIDC string   `xml:"xmlns:oai_dc,attr"`
+	NamespaceDC    string   `xml:"xmlns:dc,attr"`
+	NamespaceXSI   string   `xml:"xmlns:xsi,attr"`
+	SchemaLocation string   `xml:"xsi:schemaLocation,attr"`
+	Titles         []string `xml:"dc:title"`
+	Creators       []string `xml:"dc:creator"`
+	Subjects       []string `xml:"dc:subject"`
+	Types          []string `xml:"dc:type"`
+	Dates          []string `xml:"dc:date"`
+	Identifiers    []string `xml:"dc:identifier"`
+}
+
+// FromBook describes the book as a Dublin Core record. Each author becomes a creator, the
+// subject, genre and category become subjects and the ISBNs URN identifiers.
+func FromBook(book domain.Book) Record {
+	record := Record{
+		NamespaceOAIDC: NamespaceOAIDC,
+		NamespaceDC:    NamespaceDC,
+		NamespaceXSI:   namespaceXSI,
+		SchemaLocation: SchemaLocation,
+		Types:          []string{"Text"},
+	}
+	record.Titles = appendNonEmpty(record.Titles, book.Title)
+	for _, author := range book.Authors {
+		record.Creators = appendNonEmpty(record.Creators, author.Name)
+	}
+	if len(record.Creators) == 0 {
+		record.Creators = appendNonEmpty(record.Creators, book.Author)
+	}
+	record.Subjects = appendNonEmpty(record.Subjects, book.Subject, book.Genre, book.Category)
+	if book.PublishedYear > 0 {
+		record.Dates = append(record.Dates, strconv.FormatUint(uint64(book.PublishedYear), 10))
+	}
+	if book.ISBN13 != "" {
+		record.Identifiers = append(record.Identifiers, "urn:isbn:"+book.ISBN13)
+	}
+	if book.ISBN10 != "" {
+		record.Identifiers = append(record.Identifiers, "urn:isbn:"+book.ISBN10)
+	}
+	return record
+}
+
+func appendNonEmpty(values []string, candidates ...string) []string {
+	for _, v := range candidates {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// Encoder writes records as the children of a single collection element.
+type Encoder struct {
+	w       io.Writer
+	e       *xml.Encoder
+	started bool
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w, e: xml.NewEncoder(w)}
+}
+
+func (e *Encoder) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return err
+	}
+	return e.e.EncodeToken(xml.StartElement{Name: xml.Name{Local: "collection"}})
+}
+
+// Encode writes a record.
+func (e *Encoder) Encode(record Record) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	return e.e.Encode(record)
+}
+
+// Close ends the collection, which is written even when empty.
+func (e *Encoder) Close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	if err := e.e.EncodeToken(xml.EndElement{Name: xml.Name{Local: "collection"}}); err != nil {
+		return err
+	}
+	return e.e.Flush()
+}
@@ -0,0 +1,122 @@
+// Package isbn validates and converts International Standard Book Numbers.
+// ISBNs are handled in their normalized form: digits only, with an upper case X as the
+// check character of an ISBN-10 when it stands for ten.
+package isbn
+
+import (
+	"fmt"
+	"library-management-api/util/errorhandler"
+	"strings"
+)
+
+// bookland is the GS1 prefix under which every ISBN-10 has an ISBN-13 equivalent.
+const bookland = "978"
+
+// Normalize strips the hyphens and spaces ISBNs are usually printed with.
+func Normalize(s string) string {
+	s = strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(s))
+	return strings.ToUpper(s)
+}
+
+// Parse normalizes an ISBN-10 or ISBN-13 and returns its ISBN-13 form.
+func Parse(s string) (string, error) {
+	s = Normalize(s)
+	switch len(s) {
+	case 10:
+		return To13(s)
+	case 13:
+		if err := Validate13(s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	return "", fmt.Errorf("%w: %q must have 10 or 13 digits", errorhandler.ErrInvalidISBN, s)
+}
+
+// Validate10 checks the length, digits and check character of a normalized ISBN-10.
+func Validate10(s string) error {
+	if len(s) != 10 {
+		return fmt.Errorf("%w: %q is not a 10 digit ISBN", errorhandler.ErrInvalidISBN, s)
+	}
+	if !isDigits(s[:9]) || !(isDigits(s[9:]) || s[9] == 'X') {
+		return fmt.Errorf("%w: %q contains invalid characters", errorhandler.ErrInvalidISBN, s)
+	}
+	if s[9:] != checkDigit10(s[:9]) {
+		return fmt.Errorf("%w: checksum of %q does not match", errorhandler.ErrInvalidISBN, s)
+	}
+	return nil
+}
+
+// Validate13 checks the length, digits, prefix and check digit of a normalized ISBN-13.
+func Validate13(s string) error {
+	if len(s) != 13 {
+		return fmt.Errorf("%w: %q is not a 13 digit ISBN", errorhandler.ErrInvalidISBN, s)
+	}
+	if !isDigits(s) {
+		return fmt.Errorf("%w: %q contains invalid characters", errorhandler.ErrInvalidISBN, s)
+	}
+	if s[:3] != "978" && s[:3] != "979" {
+		return fmt.Errorf("%w: %q must start with 978 or 979", errorhandler.ErrInvalidISBN, s)
+	}
+	if s[12:] != checkDigit13(s[:12]) {
+		return fmt.Errorf("%w: checksum of %q does not match", errorhandler.ErrInvalidISBN, s)
+	}
+	return nil
+}
+
+// To13 converts a normalized ISBN-10 to its ISBN-13.
+func To13(s string) (string, error) {
+	if err := Validate10(s); err != nil {
+		return "", err
+	}
+	body := bookland + s[:9]
+	return body + checkDigit13(body), nil
+}
+
+// To10 converts a normalized ISBN-13 to its ISBN-10. It reports false for ISBN-13s in the
+// 979 range, which have no ISBN-10.
+func To10(s string) (string, bool, error) {
+	if err := Validate13(s); err != nil {
+		return "", false, err
+	}
+	if s[:3] != bookland {
+		return "", false, nil
+	}
+	body := s[3:12]
+	return body + checkDigit10(body), true, nil
+}
+
+// checkDigit10 computes the check character of the first nine digits of an ISBN-10.
+func checkDigit10(body string) string {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(body[i]-'0')
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return "X"
+	}
+	return string(rune('0' + check))
+}
+
+// checkDigit13 computes the check digit of the first twelve digits of an ISBN-13.
+func checkDigit13(body string) string {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(body[i]-'0')
+	}
+	return string(rune('0' + (10-sum%10)%10))
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
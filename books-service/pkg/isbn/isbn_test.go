@@ -0,0 +1,115 @@
+package isbn
+
+import (
+	"errors"
+	"library-management-api/util/errorhandler"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0-306-40615-2", "0306406152"},
+		{" 978 0 306 40615 7 ", "9780306406157"},
+		{"0-8044-2957-x", "080442957X"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "isbn-10", in: "0306406152", want: "9780306406157"},
+		{name: "isbn-10 with hyphens", in: "0-306-40615-2", want: "9780306406157"},
+		{name: "isbn-10 with check X", in: "0-8044-2957-X", want: "9780804429573"},
+		{name: "isbn-10 with lower case x", in: "080442957x", want: "9780804429573"},
+		{name: "isbn-13", in: "9780306406157", want: "9780306406157"},
+		{name: "isbn-13 with hyphens and spaces", in: "978-0 306-40615 7", want: "9780306406157"},
+		{name: "979 isbn-13", in: "979-10-90636-07-1", want: "9791090636071"},
+		{name: "isbn-10 wrong check digit", in: "0306406153", wantErr: true},
+		{name: "isbn-10 X in the body", in: "03064X6152", wantErr: true},
+		{name: "isbn-10 X where a digit is due", in: "030640615X", wantErr: true},
+		{name: "isbn-13 wrong check digit", in: "9780306406158", wantErr: true},
+		{name: "isbn-13 with X", in: "978030640615X", wantErr: true},
+		{name: "isbn-13 outside bookland", in: "9770306406155", wantErr: true},
+		{name: "too short", in: "030640615", wantErr: true},
+		{name: "between lengths", in: "978030640615", wantErr: true},
+		{name: "too long", in: "97803064061570", wantErr: true},
+		{name: "empty", in: "", wantErr: true},
+		{name: "letters", in: "ABCDEFGHIJ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, errorhandler.ErrInvalidISBN) {
+					t.Fatalf("Parse(%q) = %q, %v, want ErrInvalidISBN", tt.in, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTo10(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantOK  bool
+		wantErr bool
+	}{
+		{name: "978", in: "9780306406157", want: "0306406152", wantOK: true},
+		{name: "978 with check X", in: "9780804429573", want: "080442957X", wantOK: true},
+		{name: "979 has no isbn-10", in: "9791090636071", wantOK: false},
+		{name: "invalid isbn-13", in: "9780306406158", wantErr: true},
+		{name: "isbn-10 given", in: "0306406152", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := To10(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, errorhandler.ErrInvalidISBN) {
+					t.Fatalf("To10(%q) error = %v, want ErrInvalidISBN", tt.in, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("To10(%q) error = %v", tt.in, err)
+			}
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("To10(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTo13RoundTrip(t *testing.T) {
+	for _, isbn10 := range []string{"0306406152", "080442957X", "123456789X"} {
+		isbn13, err := To13(isbn10)
+		if err != nil {
+			t.Fatalf("To13(%q) error = %v", isbn10, err)
+		}
+		back, ok, err := To10(isbn13)
+		if err != nil || !ok || back != isbn10 {
+			t.Errorf("To10(To13(%q)) = %q, %v, %v", isbn10, back, ok, err)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package marc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+const (
+	leaderLength        = 24
+	directoryEntryWidth = 12
+	subfieldDelimiter   = 0x1F
+	fieldTerminator     = 0x1E
+	recordTerminator    = 0x1D
+	// maxRecordLength is the largest length the five digit record length can express.
+	maxRecordLength = 99999
+)
+
+type isoDecoder struct {
+	r *bufio.Reader
+}
+
+// NewISO2709Decoder reads records in the binary exchange format.
+func NewISO2709Decoder(r io.Reader) Decoder {
+	return &isoDecoder{r: bufio.NewReader(r)}
+}
+
+// Decode implements Decoder. Records are delimited by the record terminator, so a malformed
+// record does not stop the ones after it from being read.
+func (d *isoDecoder) Decode() (Record, error) {
+	// Line breaks between records are a common artifact of file transfers
+	if err := d.skipLineBreaks(); err != nil {
+		return Record{}, err
+	}
+	raw, tooLong, err := d.readRecord()
+	if err == io.EOF && !tooLong && len(bytes.TrimSpace(raw)) == 0 {
+		return Record{}, io.EOF
+	}
+	if err != nil && err != io.EOF {
+		return Record{}, err
+	}
+	if tooLong {
+		return Record{}, errRecord("record is longer than %d bytes", maxRecordLength)
+	}
+	if err == io.EOF {
+		return Record{}, errRecord("record is not terminated")
+	}
+	return parseISO2709(raw[:len(raw)-1])
+}
+
+func (d *isoDecoder) skipLineBreaks() error {
+	for {
+		c, err := d.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if c != '\r' && c != '\n' {
+			return d.r.UnreadByte()
+		}
+	}
+}
+
+// readRecord reads up to and including the next record terminator. No record can be longer
+// than its leader is able to tell, so the bytes of a longer one are skipped rather than kept.
+func (d *isoDecoder) readRecord() ([]byte, bool, error) {
+	var raw []byte
+	tooLong := false
+	for {
+		chunk, err := d.r.ReadSlice(recordTerminator)
+		if len(raw)+len(chunk) > maxRecordLength {
+			raw, tooLong = nil, true
+		}
+		if !tooLong {
+			raw = append(raw, chunk...)
+		}
+		if err != bufio.ErrBufferFull {
+			return raw, tooLong, err
+		}
+	}
+}
+
+// parseISO2709 parses a record without its terminator. Every number read from the leader and
+// the directory is checked against the record before it is used to slice it.
+func parseISO2709(raw []byte) (Record, error) {
+	if len(raw) < leaderLength {
+		return Record{}, errRecord("record is shorter than its leader")
+	}
+	record := Record{Leader: string(raw[:leaderLength])}
+	length, ok := parseDigits(raw[0:5])
+	if !ok || length > len(raw)+1 {
+		return Record{}, errRecord("invalid record length %q", raw[0:5])
+	}
+	base, ok := parseDigits(raw[12:17])
+	if !ok || base <= leaderLength || base > len(raw) || base >= length {
+		return Record{}, errRecord("invalid base address of data %q", raw[12:17])
+	}
+
+	directory := raw[leaderLength : base-1]
+	if raw[base-1] != fieldTerminator || len(directory)%directoryEntryWidth != 0 {
+		return Record{}, errRecord("malformed directory")
+	}
+	data := raw[base:]
+	for entry := directory; len(entry) > 0; entry = entry[directoryEntryWidth:] {
+		tag := string(entry[:3])
+		length, ok := parseDigits(entry[3:7])
+		if !ok || length < 1 {
+			return Record{}, errRecord("field %s has an invalid length", tag)
+		}
+		start, ok := parseDigits(entry[7:12])
+		if !ok || start > len(data)-length {
+			return Record{}, errRecord("field %s lies outside the record", tag)
+		}
+		value := bytes.TrimSuffix(data[start:start+length], []byte{fieldTerminator})
+
+		if isControlTag(tag) {
+			record.ControlFields = append(record.ControlFields, ControlField{Tag: tag, Value: string(value)})
+			continue
+		}
+		if len(value) < 2 {
+			return Record{}, errRecord("field %s has no indicators", tag)
+		}
+		f := DataField{Tag: tag, Ind1: string(value[0]), Ind2: string(value[1])}
+		for _, sub := range bytes.Split(value[2:], []byte{subfieldDelimiter})[1:] {
+			if len(sub) == 0 {
+				continue
+			}
+			f.Subfields = append(f.Subfields, Subfield{Code: string(sub[0]), Value: string(sub[1:])})
+		}
+		record.DataFields = append(record.DataFields, f)
+	}
+	return record, nil
+}
+
+// parseDigits parses the unsigned decimal numbers of the leader and directory. Unlike
+// strconv.Atoi it accepts digits only, so signs cannot sneak negative offsets in.
+func parseDigits(b []byte) (int, bool) {
+	n := 0
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, len(b) > 0
+}
+
+type isoEncoder struct {
+	w *bufio.Writer
+}
+
+// NewISO2709Encoder writes records in the binary exchange format.
+func NewISO2709Encoder(w io.Writer) Encoder {
+	return &isoEncoder{w: bufio.NewWriter(w)}
+}
+
+// Encode implements Encoder. The record length and base address of the leader are computed
+// from the fields; the rest of the leader is written as given.
+func (e *isoEncoder) Encode(record Record) error {
+	if err := record.validate(); err != nil {
+		return err
+	}
+
+	var directory, data bytes.Buffer
+	addField := func(tag string, value []byte) {
+		fmt.Fprintf(&directory, "%s%04d%05d", tag, len(value)+1, data.Len())
+		data.Write(value)
+		data.WriteByte(fieldTerminator)
+	}
+	for _, f := range record.ControlFields {
+		addField(f.Tag, []byte(f.Value))
+	}
+	for _, f := range record.DataFields {
+		var value bytes.Buffer
+		value.WriteString(indicator(f.Ind1) + indicator(f.Ind2))
+		for _, s := range f.Subfields {
+			value.WriteByte(subfieldDelimiter)
+			value.WriteString(s.Code + s.Value)
+		}
+		addField(f.Tag, value.Bytes())
+	}
+	directory.WriteByte(fieldTerminator)
+
+	base := leaderLength + directory.Len()
+	length := base + data.Len() + 1
+	if length > maxRecordLength {
+		return errRecord("record is longer than %d bytes", maxRecordLength)
+	}
+
+	header := fmt.Sprintf("%05d%s%05d%s", length, record.Leader[5:12], base, record.Leader[17:])
+	if _, err := e.w.WriteString(header); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(directory.Bytes()); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(data.Bytes()); err != nil {
+		return err
+	}
+	return e.w.WriteByte(recordTerminator)
+}
+
+// Close implements Encoder.
+func (e *isoEncoder) Close() error {
+	return e.w.Flush()
+}
@@ -0,0 +1,138 @@
+package marc
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+// encodeISO2709 returns a small valid record in the binary format, without its terminator.
+func encodeISO2709(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := NewISO2709Encoder(&buf)
+	record := Record{
+		Leader:        "00000nam a2200000 i 4500",
+		ControlFields: []ControlField{{Tag: "001", Value: "42"}},
+		DataFields:    []DataField{field("245", "1", "0", "a", "Dune")},
+	}
+	if err := enc.Encode(record); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{recordTerminator})
+}
+
+// patch returns a copy of raw with s written at offset.
+func patch(raw []byte, offset int, s string) []byte {
+	out := append([]byte(nil), raw...)
+	copy(out[offset:], s)
+	return out
+}
+
+func TestParseISO2709(t *testing.T) {
+	valid := encodeISO2709(t)
+
+	record, err := parseISO2709(valid)
+	if err != nil {
+		t.Fatalf("valid record: %v", err)
+	}
+	if got := record.Subfield("245", "a"); got != "Dune" {
+		t.Errorf("245 $a = %q, want %q", got, "Dune")
+	}
+
+	// The first directory entry starts right after the leader: tag, 4 digit length, 5 digit start.
+	const entry = leaderLength
+	tests := []struct {
+		name string
+		raw  []byte
+	}{
+		{"empty", nil},
+		{"shorter than leader", valid[:10]},
+		{"truncated directory", valid[:leaderLength+5]},
+		{"truncated data", valid[:len(valid)-4]},
+		{"non-numeric record length", patch(valid, 0, "00a12")},
+		{"record length beyond record", patch(valid, 0, "99999")},
+		{"negative base address", patch(valid, 12, "-0001")},
+		{"base address inside leader", patch(valid, 12, "00010")},
+		{"base address beyond record", patch(valid, 12, "99999")},
+		{"directory not terminated", patch(valid, 12, "00037")},
+		{"zero field length", patch(valid, entry+3, "0000")},
+		{"negative field length", patch(valid, entry+3, "-001")},
+		{"signed field length", patch(valid, entry+3, "+003")},
+		{"negative field start", patch(valid, entry+7, "-0001")},
+		{"field start beyond data", patch(valid, entry+7, "99999")},
+		{"field end beyond data", patch(valid, entry+3, "9999")},
+		{"non-numeric field start", patch(valid, entry+7, "0x001")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseISO2709(tt.raw)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !IsRecordError(err) {
+				t.Errorf("error %v is not a record error", err)
+			}
+		})
+	}
+}
+
+func TestISO2709DecoderSkipsMalformedRecords(t *testing.T) {
+	valid := encodeISO2709(t)
+	broken := patch(valid, leaderLength+7, "-0001")
+
+	var input bytes.Buffer
+	for _, raw := range [][]byte{broken, valid} {
+		input.Write(raw)
+		input.WriteByte(recordTerminator)
+	}
+	input.WriteString("00100nam")
+
+	dec := NewISO2709Decoder(&input)
+	if _, err := dec.Decode(); !IsRecordError(err) {
+		t.Fatalf("first record: got %v, want a record error", err)
+	}
+	record, err := dec.Decode()
+	if err != nil {
+		t.Fatalf("second record: %v", err)
+	}
+	if got := record.Subfield("245", "a"); got != "Dune" {
+		t.Errorf("245 $a = %q, want %q", got, "Dune")
+	}
+	if _, err := dec.Decode(); err == nil || !strings.Contains(err.Error(), "not terminated") {
+		t.Fatalf("unterminated record: got %v", err)
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("end: got %v, want io.EOF", err)
+	}
+}
+
+func TestISO2709DecoderSkipsOverlongRecords(t *testing.T) {
+	valid := encodeISO2709(t)
+
+	var input bytes.Buffer
+	input.Write(bytes.Repeat([]byte("x"), 4*maxRecordLength))
+	input.WriteByte(recordTerminator)
+	input.WriteString("\r\n")
+	input.Write(valid)
+	input.WriteByte(recordTerminator)
+
+	dec := NewISO2709Decoder(&input)
+	if _, err := dec.Decode(); err == nil || !strings.Contains(err.Error(), "longer than") {
+		t.Fatalf("overlong record: got %v, want a record error", err)
+	}
+	record, err := dec.Decode()
+	if err != nil {
+		t.Fatalf("second record: %v", err)
+	}
+	if got := record.Subfield("245", "a"); got != "Dune" {
+		t.Errorf("245 $a = %q, want %q", got, "Dune")
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("end: got %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,312 @@
+// Package marc reads and writes MARC 21 bibliographic records, in binary ISO 2709 and in
+// MARCXML, and maps them to and from books.
+package marc
+
+import (
+	"errors"
+	"fmt"
+	"library-management-api/books-service/core/domain"
+	"library-management-api/books-service/pkg/isbn"
+	"library-management-api/util/errorhandler"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Format is a serialization of MARC records.
+type Format string
+
+const (
+	FormatISO2709 Format = "iso2709"
+	FormatMARCXML Format = "marcxml"
+)
+
+// Record is a MARC record: a leader followed by control fields (tags 001 to 009) and data fields.
+type Record struct {
+	Leader        string
+	ControlFields []ControlField
+	DataFields    []DataField
+}
+
+type ControlField struct {
+	Tag   string
+	Value string
+}
+
+// DataField is a variable data field. Indicators are single characters, blank when unused.
+type DataField struct {
+	Tag       string
+	Ind1      string
+	Ind2      string
+	Subfields []Subfield
+}
+
+// Subfield is a coded value of a data field. Code is a single character.
+type Subfield struct {
+	Code  string
+	Value string
+}
+
+// Decoder reads records one at a time and returns io.EOF after the last one.
+// A record that cannot be parsed is reported with an error wrapping
+// errorhandler.ErrInvalidMarcRecord; decoding may continue with the next record.
+type Decoder interface {
+	Decode() (Record, error)
+}
+
+// Encoder writes records one at a time. Close completes the output and must be called once
+// every record is written.
+type Encoder interface {
+	Encode(record Record) error
+	Close() error
+}
+
+// ParseFormat maps a format name to a Format.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(name)) {
+	case FormatISO2709:
+		return FormatISO2709, nil
+	case FormatMARCXML:
+		return FormatMARCXML, nil
+	}
+	return "", fmt.Errorf("%w: %q", errorhandler.ErrInvalidMarcFormat, name)
+}
+
+// leader is the leader of the records produced by FromBook. The record length and base address
+// of data (positions 0-4 and 12-16) are filled in when the record is encoded in ISO 2709.
+//
+//	05 n  new record
+//	06 a  language material
+//	07 m  monograph
+//	09 a  UCS/Unicode
+//	10-11 two indicators, two character subfield codes
+//	17-19 full level, ISBD punctuation omitted
+//	20-23 entry map
+const leader = "00000nam a2200000 c 4500"
+
+// FromBook describes the book as a MARC record:
+//
+//	001 book ID
+//	008 date entered and publication year
+//	020 $a ISBN-13, then ISBN-10
+//	084 $a category
+//	100 $a first author
+//	245 $a title
+//	264 $c publication year
+//	650 $a subject
+//	655 $a genre
+//	700 $a further authors
+func FromBook(book domain.Book) Record {
+	record := Record{Leader: leader}
+	if book.ID > 0 {
+		record.ControlFields = append(record.ControlFields, ControlField{Tag: "001", Value: strconv.FormatUint(uint64(book.ID), 10)})
+	}
+	record.ControlFields = append(record.ControlFields, ControlField{Tag: "008", Value: fixedLengthData(book)})
+
+	for _, number := range []string{book.ISBN13, book.ISBN10} {
+		if number != "" {
+			record.DataFields = append(record.DataFields, field("020", " ", " ", "a", number))
+		}
+	}
+	if book.Category != "" {
+		record.DataFields = append(record.DataFields, field("084", " ", " ", "a", book.Category))
+	}
+	authors := authorNames(book)
+	if len(authors) > 0 {
+		record.DataFields = append(record.DataFields, field("100", "1", " ", "a", authors[0]))
+	}
+	record.DataFields = append(record.DataFields, field("245", "1", "0", "a", book.Title))
+	if book.PublishedYear > 0 {
+		record.DataFields = append(record.DataFields, field("264", " ", "1", "c", strconv.FormatUint(uint64(book.PublishedYear), 10)))
+	}
+	if book.Subject != "" {
+		record.DataFields = append(record.DataFields, field("650", " ", "4", "a", book.Subject))
+	}
+	if book.Genre != "" {
+		record.DataFields = append(record.DataFields, field("655", " ", "4", "a", book.Genre))
+	}
+	for _, author := range authors[min(1, len(authors)):] {
+		record.DataFields = append(record.DataFields, field("700", "1", " ", "a", author))
+	}
+	return record
+}
+
+// authorNames lists the authors of a book, falling back to its author field when the book
+// carries no credits.
+func authorNames(book domain.Book) []string {
+	var names []string
+	for _, author := range book.Authors {
+		names = append(names, author.Name)
+	}
+	if len(names) == 0 && book.Author != "" {
+		names = append(names, book.Author)
+	}
+	return names
+}
+
+func field(tag, ind1, ind2, code, value string) DataField {
+	return DataField{Tag: tag, Ind1: ind1, Ind2: ind2, Subfields: []Subfield{{Code: code, Value: value}}}
+}
+
+// fixedLengthData builds the 40 character 008 field of a single-date monograph.
+func fixedLengthData(book domain.Book) string {
+	entered := "      "
+	if !book.CreatedAt.IsZero() {
+		entered = book.CreatedAt.Format("060102")
+	}
+	date1 := "    "
+	if book.PublishedYear > 0 && book.PublishedYear <= 9999 {
+		date1 = fmt.Sprintf("%04d", book.PublishedYear)
+	}
+	return entered + "s" + date1 + "    " + "xx " + strings.Repeat(" ", 17) + "und" + " " + "d"
+}
+
+// yearPattern finds the publication year in statements such as "c1937." or "[1954?]".
+var yearPattern = regexp.MustCompile(`\d{4}`)
+
+// ToBook reads a book from a MARC record, the inverse of FromBook. Titles, authors and terms
+// lose their trailing ISBD punctuation. The publication year falls back to field 260 and the
+// category to the subject when the record lacks them.
+func ToBook(record Record) domain.Book {
+	var book domain.Book
+
+	for _, f := range record.fields("020") {
+		// The number may be followed by a qualifier, as in "0261102214 (pbk.)"
+		number, _, _ := strings.Cut(strings.TrimSpace(f.Subfield("a")), " ")
+		number = isbn.Normalize(number)
+		switch {
+		case len(number) == 13 && book.ISBN13 == "":
+			book.ISBN13 = number
+		case len(number) == 10 && book.ISBN10 == "":
+			book.ISBN10 = number
+		}
+	}
+
+	var names []string
+	for _, f := range append(record.fields("100"), record.fields("700")...) {
+		if name := trimISBD(f.Subfield("a")); name != "" {
+			book.Authors = append(book.Authors, domain.Author{Name: name})
+			names = append(names, name)
+		}
+	}
+	book.Author = strings.Join(names, "; ")
+	book.Title = trimISBD(record.Subfield("245", "a"))
+	if remainder := trimISBD(record.Subfield("245", "b")); remainder != "" {
+		book.Title += ": " + remainder
+	}
+
+	published := record.Subfield("264", "c")
+	if published == "" {
+		published = record.Subfield("260", "c")
+	}
+	if year, err := strconv.ParseUint(yearPattern.FindString(published), 10, 32); err == nil {
+		book.PublishedYear = uint(year)
+	}
+
+	book.Subject = trimISBD(record.Subfield("650", "a"))
+	book.Genre = trimISBD(record.Subfield("655", "a"))
+	book.Category = trimISBD(record.Subfield("084", "a"))
+	if book.Category == "" {
+		book.Category = book.Subject
+	}
+	return book
+}
+
+// trimISBD strips the punctuation cataloguers end MARC values with. A full stop closing an
+// initial, as in "Tolkien, J. R. R.", is kept.
+func trimISBD(s string) string {
+	s = strings.TrimRight(strings.TrimSpace(s), " /:;,=")
+	if n := len(s); n > 0 && s[n-1] == '.' && !endsWithInitial(s[:n-1]) {
+		s = strings.TrimRight(s[:n-1], " /:;,=")
+	}
+	return s
+}
+
+// endsWithInitial reports whether s ends with a single capital letter standing on its own.
+func endsWithInitial(s string) bool {
+	n := len(s)
+	if n == 0 || s[n-1] < 'A' || s[n-1] > 'Z' {
+		return false
+	}
+	return n == 1 || s[n-2] == ' ' || s[n-2] == '.'
+}
+
+// fields returns the data fields with the tag, in record order.
+func (r Record) fields(tag string) []DataField {
+	var res []DataField
+	for _, f := range r.DataFields {
+		if f.Tag == tag {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// Subfield returns the first subfield with the code in the first data field with the tag.
+func (r Record) Subfield(tag, code string) string {
+	for _, f := range r.DataFields {
+		if f.Tag == tag {
+			return f.Subfield(code)
+		}
+	}
+	return ""
+}
+
+// Subfield returns the value of the first subfield with the code.
+func (f DataField) Subfield(code string) string {
+	for _, s := range f.Subfields {
+		if s.Code == code {
+			return s.Value
+		}
+	}
+	return ""
+}
+
+// validate checks the parts of the record that the encodings depend on.
+func (r Record) validate() error {
+	if len(r.Leader) != leaderLength {
+		return fmt.Errorf("%w: leader must be %d characters", errorhandler.ErrInvalidMarcRecord, leaderLength)
+	}
+	for _, f := range r.ControlFields {
+		if !isControlTag(f.Tag) {
+			return fmt.Errorf("%w: %q is not a control field tag", errorhandler.ErrInvalidMarcRecord, f.Tag)
+		}
+	}
+	for _, f := range r.DataFields {
+		if len(f.Tag) != 3 || isControlTag(f.Tag) {
+			return fmt.Errorf("%w: %q is not a data field tag", errorhandler.ErrInvalidMarcRecord, f.Tag)
+		}
+		if len(indicator(f.Ind1)) != 1 || len(indicator(f.Ind2)) != 1 {
+			return fmt.Errorf("%w: field %s has an invalid indicator", errorhandler.ErrInvalidMarcRecord, f.Tag)
+		}
+		for _, s := range f.Subfields {
+			if len(s.Code) != 1 {
+				return fmt.Errorf("%w: field %s has an invalid subfield code", errorhandler.ErrInvalidMarcRecord, f.Tag)
+			}
+		}
+	}
+	return nil
+}
+
+// isControlTag reports whether the tag belongs to a control field.
+func isControlTag(tag string) bool {
+	return len(tag) == 3 && strings.HasPrefix(tag, "00")
+}
+
+// indicator treats a missing indicator as blank.
+func indicator(s string) string {
+	if s == "" {
+		return " "
+	}
+	return s
+}
+
+// errRecord wraps a parse failure of a single record.
+func errRecord(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errorhandler.ErrInvalidMarcRecord, fmt.Sprintf(format, args...))
+}
+
+// IsRecordError reports whether err only concerns a single record, so decoding may go on.
+func IsRecordError(err error) bool {
+	return errors.Is(err, errorhandler.ErrInvalidMarcRecord)
+}
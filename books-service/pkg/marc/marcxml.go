@@ -0,0 +1,136 @@
+package marc
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// Namespace is the MARCXML namespace.
+const Namespace = "http://www.loc.gov/MARC21/slim"
+
+type xmlRecord struct {
+	XMLName       xml.Name          `xml:"record"`
+	Leader        string            `xml:"leader"`
+	ControlFields []xmlControlField `xml:"controlfield"`
+	DataFields    []xmlDataField    `xml:"datafield"`
+}
+
+type xmlControlField struct {
+	Tag   string `xml:"tag,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlDataField struct {
+	Tag       string        `xml:"tag,attr"`
+	Ind1      string        `xml:"ind1,attr"`
+	Ind2      string        `xml:"ind2,attr"`
+	Subfields []xmlSubfield `xml:"subfield"`
+}
+
+type xmlSubfield struct {
+	Code  string `xml:"code,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlDecoder struct {
+	d *xml.Decoder
+}
+
+// NewMARCXMLDecoder reads the record elements of a MARCXML document, whether they are wrapped
+// in a collection or the document is a single record.
+func NewMARCXMLDecoder(r io.Reader) Decoder {
+	return &xmlDecoder{d: xml.NewDecoder(r)}
+}
+
+// Decode implements Decoder. Syntax errors in the document end decoding.
+func (d *xmlDecoder) Decode() (Record, error) {
+	for {
+		token, err := d.d.Token()
+		if err != nil {
+			return Record{}, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "record" {
+			continue
+		}
+
+		var x xmlRecord
+		if err := d.d.DecodeElement(&x, &start); err != nil {
+			return Record{}, err
+		}
+		record := Record{Leader: x.Leader}
+		for _, f := range x.ControlFields {
+			record.ControlFields = append(record.ControlFields, ControlField{Tag: f.Tag, Value: f.Value})
+		}
+		for _, f := range x.DataFields {
+			df := DataField{Tag: f.Tag, Ind1: indicator(f.Ind1), Ind2: indicator(f.Ind2)}
+			for _, s := range f.Subfields {
+				df.Subfields = append(df.Subfields, Subfield{Code: s.Code, Value: s.Value})
+			}
+			record.DataFields = append(record.DataFields, df)
+		}
+		if err := record.validate(); err != nil {
+			return Record{}, err
+		}
+		return record, nil
+	}
+}
+
+type xmlEncoder struct {
+	w       io.Writer
+	e       *xml.Encoder
+	started bool
+}
+
+// NewMARCXMLEncoder writes records as a MARCXML collection.
+func NewMARCXMLEncoder(w io.Writer) Encoder {
+	return &xmlEncoder{w: w, e: xml.NewEncoder(w)}
+}
+
+func (e *xmlEncoder) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return err
+	}
+	return e.e.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "collection"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: Namespace}},
+	})
+}
+
+// Encode implements Encoder.
+func (e *xmlEncoder) Encode(record Record) error {
+	if err := record.validate(); err != nil {
+		return err
+	}
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	x := xmlRecord{Leader: record.Leader}
+	for _, f := range record.ControlFields {
+		x.ControlFields = append(x.ControlFields, xmlControlField{Tag: f.Tag, Value: f.Value})
+	}
+	for _, f := range record.DataFields {
+		xf := xmlDataField{Tag: f.Tag, Ind1: indicator(f.Ind1), Ind2: indicator(f.Ind2)}
+		for _, s := range f.Subfields {
+			xf.Subfields = append(xf.Subfields, xmlSubfield{Code: s.Code, Value: s.Value})
+		}
+		x.DataFields = append(x.DataFields, xf)
+	}
+	return e.e.Encode(x)
+}
+
+// Close implements Encoder. It closes the collection, which is written even when empty.
+func (e *xmlEncoder) Close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	if err := e.e.EncodeToken(xml.EndElement{Name: xml.Name{Local: "collection"}}); err != nil {
+		return err
+	}
+	return e.e.Flush()
+}
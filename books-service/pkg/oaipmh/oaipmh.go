@@ -0,0 +1,216 @@
+// Package oaipmh implements the wire format of the Open Archives Initiative Protocol for
+// Metadata Harvesting 2.0: request validation, datestamps, identifiers, resumption tokens and
+// the XML responses.
+package oaipmh
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"library-management-api/books-service/pkg/dublincore"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Namespace is the namespace of OAI-PMH responses.
+	Namespace = "http://www.openarchives.org/OAI/2.0/"
+	// SchemaLocation locates the OAI-PMH response schema.
+	SchemaLocation = Namespace + " http://www.openarchives.org/OAI/2.0/OAI-PMH.xsd"
+	// ProtocolVersion is the protocol version implemented.
+	ProtocolVersion = "2.0"
+	// Granularity is the finest datestamp granularity supported, in the notation of Identify.
+	Granularity = "YYYY-MM-DDThh:mm:ssZ"
+
+	namespaceXSI = "http://www.w3.org/2001/XMLSchema-instance"
+
+	layoutSeconds = "2006-01-02T15:04:05Z"
+	layoutDay     = "2006-01-02"
+)
+
+// Deleted record support, as declared by Identify.
+const (
+	DeletedRecordNo         = "no"
+	DeletedRecordTransient  = "transient"
+	DeletedRecordPersistent = "persistent"
+)
+
+// MetadataFormat is a metadata format records can be disseminated in.
+type MetadataFormat struct {
+	Prefix    string `xml:"metadataPrefix"`
+	Schema    string `xml:"schema"`
+	Namespace string `xml:"metadataNamespace"`
+}
+
+// FormatOAIDC is simple Dublin Core, which every repository has to support.
+var FormatOAIDC = MetadataFormat{
+	Prefix:    "oai_dc",
+	Schema:    "http://www.openarchives.org/OAI/2.0/oai_dc.xsd",
+	Namespace: dublincore.NamespaceOAIDC,
+}
+
+// FormatDatestamp formats a datestamp at the granularity of seconds, in UTC.
+func FormatDatestamp(t time.Time) string {
+	return t.UTC().Format(layoutSeconds)
+}
+
+// parseDatestamp parses a datestamp given at the granularity of days or seconds and returns it
+// together with the length of the period it names.
+func parseDatestamp(s string) (time.Time, time.Duration, bool) {
+	if t, err := time.Parse(layoutSeconds, s); err == nil {
+		return t, time.Second, true
+	}
+	if t, err := time.Parse(layoutDay, s); err == nil {
+		return t, 24 * time.Hour, true
+	}
+	return time.Time{}, 0, false
+}
+
+// Identifier returns the OAI identifier of a book, oai:<repository identifier>:book/<id>.
+func Identifier(repositoryID string, bookID uint) string {
+	return "oai:" + repositoryID + ":book/" + strconv.FormatUint(uint64(bookID), 10)
+}
+
+// ParseIdentifier returns the book an identifier made by Identifier refers to. Identifiers of
+// other repositories are rejected.
+func ParseIdentifier(repositoryID string, identifier string) (uint, bool) {
+	local, ok := strings.CutPrefix(identifier, "oai:"+repositoryID+":book/")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(local, 10, 0)
+	if err != nil || id == 0 {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// Response is an OAI-PMH response. Exactly one of the verb elements is set, unless the request
+// failed, in which case Errors lists why.
+type Response struct {
+	XMLName             xml.Name             `xml:"OAI-PMH"`
+	Namespace           string               `xml:"xmlns,attr"`
+	NamespaceXSI        string               `xml:"xmlns:xsi,attr"`
+	SchemaLocation      string               `xml:"xsi:schemaLocation,attr"`
+	ResponseDate        string               `xml:"responseDate"`
+	Request             Request              `xml:"request"`
+	Errors              []*Error             `xml:"error"`
+	Identify            *Identify            `xml:"Identify"`
+	ListMetadataFormats *ListMetadataFormats `xml:"ListMetadataFormats"`
+	ListIdentifiers     *ListIdentifiers     `xml:"ListIdentifiers"`
+	ListRecords         *ListRecords         `xml:"ListRecords"`
+	GetRecord           *GetRecord           `xml:"GetRecord"`
+}
+
+// NewResponse starts the response to a request.
+func NewResponse(req Request, now time.Time) *Response {
+	return &Response{
+		Namespace:      Namespace,
+		NamespaceXSI:   namespaceXSI,
+		SchemaLocation: SchemaLocation,
+		ResponseDate:   FormatDatestamp(now),
+		Request:        req,
+	}
+}
+
+// Fail reports a protocol error. As the protocol requires, the arguments of the request are no
+// longer echoed when they are the cause of the error.
+func (r *Response) Fail(err *Error) {
+	if err.Code == ErrBadVerb || err.Code == ErrBadArgument {
+		r.Request = Request{BaseURL: r.Request.BaseURL}
+	}
+	r.Errors = append(r.Errors, err)
+}
+
+// Encode writes the response as an XML document.
+func (r *Response) Encode(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(w).Encode(r)
+}
+
+// Identify describes the repository.
+type Identify struct {
+	RepositoryName    string   `xml:"repositoryName"`
+	BaseURL           string   `xml:"baseURL"`
+	ProtocolVersion   string   `xml:"protocolVersion"`
+	AdminEmails       []string `xml:"adminEmail"`
+	EarliestDatestamp string   `xml:"earliestDatestamp"`
+	DeletedRecord     string   `xml:"deletedRecord"`
+	Granularity       string   `xml:"granularity"`
+}
+
+type ListMetadataFormats struct {
+	Formats []MetadataFormat `xml:"metadataFormat"`
+}
+
+// ListIdentifiers lists record headers. A nil ResumptionToken ends a complete list, while an
+// empty one ends a list that was delivered in several parts.
+type ListIdentifiers struct {
+	Headers         []Header         `xml:"header"`
+	ResumptionToken *ResumptionToken `xml:"resumptionToken"`
+}
+
+// ListRecords lists records, with the same resumption rules as ListIdentifiers.
+type ListRecords struct {
+	Records         []Record         `xml:"record"`
+	ResumptionToken *ResumptionToken `xml:"resumptionToken"`
+}
+
+type GetRecord struct {
+	Record Record `xml:"record"`
+}
+
+// Header identifies a record. Status is "deleted" for the record of a deleted item.
+type Header struct {
+	Status     string `xml:"status,attr,omitempty"`
+	Identifier string `xml:"identifier"`
+	Datestamp  string `xml:"datestamp"`
+}
+
+// Record is a header and, unless the record is deleted, its metadata.
+type Record struct {
+	Header   Header    `xml:"header"`
+	Metadata *Metadata `xml:"metadata"`
+}
+
+type Metadata struct {
+	DC dublincore.Record
+}
+
+// ResumptionToken carries the token that fetches the next part of an incomplete list.
+type ResumptionToken struct {
+	Token string `xml:",chardata"`
+}
+
+// Error is a protocol error. It is reported inside the response document, which is otherwise
+// a successful HTTP response.
+type Error struct {
+	Code    ErrorCode `xml:"code,attr"`
+	Message string    `xml:",chardata"`
+}
+
+func (e *Error) Error() string {
+	return string(e.Code) + ": " + e.Message
+}
+
+// ErrorCode is one of the error conditions defined by the protocol.
+type ErrorCode string
+
+const (
+	ErrBadArgument             ErrorCode = "badArgument"
+	ErrBadResumptionToken      ErrorCode = "badResumptionToken"
+	ErrBadVerb                 ErrorCode = "badVerb"
+	ErrCannotDisseminateFormat ErrorCode = "cannotDisseminateFormat"
+	ErrIDDoesNotExist          ErrorCode = "idDoesNotExist"
+	ErrNoRecordsMatch          ErrorCode = "noRecordsMatch"
+	ErrNoMetadataFormats       ErrorCode = "noMetadataFormats"
+	ErrNoSetHierarchy          ErrorCode = "noSetHierarchy"
+)
+
+// Errorf builds a protocol error.
+func Errorf(code ErrorCode, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,186 @@
+package oaipmh
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// Verb is a request type of the protocol.
+type Verb string
+
+const (
+	VerbIdentify            Verb = "Identify"
+	VerbListMetadataFormats Verb = "ListMetadataFormats"
+	VerbListSets            Verb = "ListSets"
+	VerbListIdentifiers     Verb = "ListIdentifiers"
+	VerbListRecords         Verb = "ListRecords"
+	VerbGetRecord           Verb = "GetRecord"
+)
+
+// argument rules of a verb. An exclusive argument may only be given on its own.
+type verbArguments struct {
+	required  []string
+	optional  []string
+	exclusive string
+}
+
+var verbs = map[Verb]verbArguments{
+	VerbIdentify:            {},
+	VerbListMetadataFormats: {optional: []string{"identifier"}},
+	VerbListSets:            {exclusive: "resumptionToken"},
+	VerbListIdentifiers:     {required: []string{"metadataPrefix"}, optional: []string{"from", "until", "set"}, exclusive: "resumptionToken"},
+	VerbListRecords:         {required: []string{"metadataPrefix"}, optional: []string{"from", "until", "set"}, exclusive: "resumptionToken"},
+	VerbGetRecord:           {required: []string{"identifier", "metadataPrefix"}},
+}
+
+// Request is a protocol request, with its arguments as given by the harvester. It is echoed in
+// the response, where BaseURL has to be filled in.
+type Request struct {
+	Verb            Verb   `xml:"verb,attr,omitempty"`
+	Identifier      string `xml:"identifier,attr,omitempty"`
+	MetadataPrefix  string `xml:"metadataPrefix,attr,omitempty"`
+	From            string `xml:"from,attr,omitempty"`
+	Until           string `xml:"until,attr,omitempty"`
+	Set             string `xml:"set,attr,omitempty"`
+	ResumptionToken string `xml:"resumptionToken,attr,omitempty"`
+	BaseURL         string `xml:",chardata"`
+
+	// Selection is what a list request asks for, read from the arguments or the resumption token.
+	Selection Selection `xml:"-"`
+}
+
+// Selection is the part of a list a harvester asks for. Until is exclusive: it lies just past
+// the day or second the harvester named. After and AfterID resume a list after the last record
+// of the previous part.
+type Selection struct {
+	MetadataPrefix string
+	From           time.Time
+	Until          time.Time
+	After          time.Time
+	AfterID        uint
+
+	from, until string
+}
+
+// ParseRequest validates the arguments of a request. The request is returned even when it is
+// invalid, so that it can be echoed in the error response.
+func ParseRequest(values url.Values) (Request, *Error) {
+	req := Request{
+		Verb:            Verb(values.Get("verb")),
+		Identifier:      values.Get("identifier"),
+		MetadataPrefix:  values.Get("metadataPrefix"),
+		From:            values.Get("from"),
+		Until:           values.Get("until"),
+		Set:             values.Get("set"),
+		ResumptionToken: values.Get("resumptionToken"),
+	}
+
+	rules, ok := verbs[req.Verb]
+	if !ok || len(values["verb"]) != 1 {
+		return req, Errorf(ErrBadVerb, "verb must be one of Identify, ListMetadataFormats, ListSets, ListIdentifiers, ListRecords or GetRecord")
+	}
+	allowed := map[string]bool{"verb": true}
+	for _, name := range append(append([]string(nil), rules.required...), rules.optional...) {
+		allowed[name] = true
+	}
+	for name, v := range values {
+		if name != rules.exclusive && !allowed[name] {
+			return req, Errorf(ErrBadArgument, "%s is not an argument of %s", name, req.Verb)
+		}
+		if len(v) != 1 {
+			return req, Errorf(ErrBadArgument, "%s is repeated", name)
+		}
+	}
+
+	if rules.exclusive != "" && values.Has(rules.exclusive) {
+		if len(values) != 2 {
+			return req, Errorf(ErrBadArgument, "%s cannot be combined with other arguments", rules.exclusive)
+		}
+		if req.Verb == VerbListSets {
+			return req, nil
+		}
+		selection, err := decodeResumptionToken(req.ResumptionToken)
+		if err != nil {
+			return req, err
+		}
+		req.Selection = selection
+		return req, nil
+	}
+	for _, name := range rules.required {
+		if !values.Has(name) {
+			return req, Errorf(ErrBadArgument, "%s is required by %s", name, req.Verb)
+		}
+	}
+
+	selection, err := newSelection(req.MetadataPrefix, req.From, req.Until)
+	if err != nil {
+		return req, err
+	}
+	req.Selection = selection
+	return req, nil
+}
+
+// newSelection parses the from and until arguments, which must share their granularity.
+func newSelection(metadataPrefix string, from string, until string) (Selection, *Error) {
+	s := Selection{MetadataPrefix: metadataPrefix, from: from, until: until}
+	var fromGranularity, untilGranularity time.Duration
+	if from != "" {
+		t, granularity, ok := parseDatestamp(from)
+		if !ok {
+			return Selection{}, Errorf(ErrBadArgument, "from must be a date or a UTC time, as in 2006-01-02 or 2006-01-02T15:04:05Z")
+		}
+		s.From, fromGranularity = t, granularity
+	}
+	if until != "" {
+		t, granularity, ok := parseDatestamp(until)
+		if !ok {
+			return Selection{}, Errorf(ErrBadArgument, "until must be a date or a UTC time, as in 2006-01-02 or 2006-01-02T15:04:05Z")
+		}
+		s.Until, untilGranularity = t.Add(granularity), granularity
+	}
+	if from != "" && until != "" {
+		if fromGranularity != untilGranularity {
+			return Selection{}, Errorf(ErrBadArgument, "from and until must have the same granularity")
+		}
+		if !s.From.Before(s.Until) {
+			return Selection{}, Errorf(ErrBadArgument, "from cannot be after until")
+		}
+	}
+	return s, nil
+}
+
+// token is the content of a resumption token. The range is kept as given so that it is parsed
+// exactly as in the first request.
+type token struct {
+	MetadataPrefix string    `json:"p"`
+	From           string    `json:"f,omitempty"`
+	Until          string    `json:"u,omitempty"`
+	After          time.Time `json:"a"`
+	AfterID        uint      `json:"id"`
+}
+
+// Resume returns the resumption token that continues the list after the given record. Tokens
+// locate the record rather than an offset, so they stay valid while the catalogue changes.
+func (s Selection) Resume(datestamp time.Time, id uint) string {
+	raw, _ := json.Marshal(token{MetadataPrefix: s.MetadataPrefix, From: s.from, Until: s.until, After: datestamp, AfterID: id})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeResumptionToken(s string) (Selection, *Error) {
+	var t token
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Selection{}, Errorf(ErrBadResumptionToken, "malformed resumption token")
+	}
+	if err := json.Unmarshal(raw, &t); err != nil || t.MetadataPrefix == "" || t.After.IsZero() {
+		return Selection{}, Errorf(ErrBadResumptionToken, "malformed resumption token")
+	}
+	selection, perr := newSelection(t.MetadataPrefix, t.From, t.Until)
+	if perr != nil {
+		return Selection{}, Errorf(ErrBadResumptionToken, "malformed resumption token")
+	}
+	selection.After, selection.AfterID = t.After, t.AfterID
+	return selection, nil
+}
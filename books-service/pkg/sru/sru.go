@@ -0,0 +1,250 @@
+// Package sru implements the XML responses of SRU 2.0 (Search/Retrieve via URL): the
+// searchRetrieve and explain envelopes and diagnostics.
+package sru
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"library-management-api/books-service/pkg/dublincore"
+)
+
+const (
+	// Version is the protocol version implemented.
+	Version = "2.0"
+	// NamespaceResponse is the namespace of SRU 2.0 responses.
+	NamespaceResponse = "http://docs.oasis-open.org/ns/search-ws/sruResponse"
+	// NamespaceDiagnostic is the namespace of SRU diagnostics.
+	NamespaceDiagnostic = "http://docs.oasis-open.org/ns/search-ws/diagnostic"
+	// NamespaceExplain is the namespace of ZeeRex explain records.
+	NamespaceExplain = "http://explain.z3950.org/dtd/2.0/"
+
+	// SchemaDC identifies Dublin Core records.
+	SchemaDC = "info:srw/schema/1/dc-v1.1"
+	// SchemaDCShort is the short name of SchemaDC.
+	SchemaDCShort = "dc"
+
+	// EscapingXML embeds records as XML, EscapingString as escaped text.
+	EscapingXML    = "xml"
+	EscapingString = "string"
+)
+
+// Diagnostic is an SRU diagnostic. The numbers are those of the SRU diagnostics list,
+// info:srw/diagnostic/1/.
+type Diagnostic struct {
+	URI     string `xml:"diag:uri"`
+	Details string `xml:"diag:details,omitempty"`
+	Message string `xml:"diag:message"`
+}
+
+func (d *Diagnostic) Error() string {
+	return d.Message + ": " + d.Details
+}
+
+// Diagnostics used by the endpoint.
+const (
+	DiagGeneralSystemError           = 1
+	DiagUnsupportedParameterValue    = 6
+	DiagMandatoryParameterNotGiven   = 7
+	DiagQuerySyntaxError             = 10
+	DiagUnsupportedIndex             = 16
+	DiagUnsupportedRelation          = 19
+	DiagUnsupportedRelationModifier  = 20
+	DiagEmptyTermUnsupported         = 27
+	DiagUnsupportedBooleanOperator   = 37
+	DiagUnsupportedBooleanModifier   = 46
+	DiagFirstRecordOutOfRange        = 61
+	DiagUnknownSchemaForRetrieval    = 66
+	DiagUnsupportedRecordXMLEscaping = 71
+)
+
+var diagnosticMessages = map[int]string{
+	DiagGeneralSystemError:           "General system error",
+	DiagUnsupportedParameterValue:    "Unsupported parameter value",
+	DiagMandatoryParameterNotGiven:   "Mandatory parameter not supplied",
+	DiagQuerySyntaxError:             "Query syntax error",
+	DiagUnsupportedIndex:             "Unsupported index",
+	DiagUnsupportedRelation:          "Unsupported relation",
+	DiagUnsupportedRelationModifier:  "Unsupported relation modifier",
+	DiagEmptyTermUnsupported:         "Empty term unsupported",
+	DiagUnsupportedBooleanOperator:   "Unsupported boolean operator",
+	DiagUnsupportedBooleanModifier:   "Unsupported boolean modifier",
+	DiagFirstRecordOutOfRange:        "First record position out of range",
+	DiagUnknownSchemaForRetrieval:    "Unknown schema for retrieval",
+	DiagUnsupportedRecordXMLEscaping: "Unsupported record XML escaping",
+}
+
+// NewDiagnostic builds the diagnostic with the given number. Details carries what the
+// diagnostic is about, such as the offending index or parameter.
+func NewDiagnostic(number int, details string) *Diagnostic {
+	return &Diagnostic{
+		URI:     fmt.Sprintf("info:srw/diagnostic/1/%d", number),
+		Details: details,
+		Message: diagnosticMessages[number],
+	}
+}
+
+// Diagnostics wraps diagnostics in their namespace.
+type Diagnostics struct {
+	Namespace   string        `xml:"xmlns:diag,attr"`
+	Diagnostics []*Diagnostic `xml:"diag:diagnostic"`
+}
+
+// SearchRetrieveResponse is the response to a search. NextRecordPosition is zero when no records
+// follow.
+type SearchRetrieveResponse struct {
+	XMLName            xml.Name     `xml:"sruResponse:searchRetrieveResponse"`
+	Namespace          string       `xml:"xmlns:sruResponse,attr"`
+	Version            string       `xml:"sruResponse:version"`
+	NumberOfRecords    int          `xml:"sruResponse:numberOfRecords"`
+	Records            *Records     `xml:"sruResponse:records"`
+	NextRecordPosition int          `xml:"sruResponse:nextRecordPosition,omitempty"`
+	Diagnostics        *Diagnostics `xml:"sruResponse:diagnostics"`
+}
+
+// NewSearchRetrieveResponse starts an empty search response.
+func NewSearchRetrieveResponse() *SearchRetrieveResponse {
+	return &SearchRetrieveResponse{
+		Namespace: NamespaceResponse,
+		Version:   Version,
+	}
+}
+
+// Fail reports a diagnostic. Every diagnostic the endpoint reports is fatal, so no records are
+// returned along with it.
+func (r *SearchRetrieveResponse) Fail(diagnostic *Diagnostic) {
+	r.Records = nil
+	r.NextRecordPosition = 0
+	if r.Diagnostics == nil {
+		r.Diagnostics = &Diagnostics{Namespace: NamespaceDiagnostic}
+	}
+	r.Diagnostics.Diagnostics = append(r.Diagnostics.Diagnostics, diagnostic)
+}
+
+// Records holds the records of a search response. It is left out when there are none.
+type Records struct {
+	Records []Record `xml:"sruResponse:record"`
+}
+
+// Record is a record of a search response, at a 1-based position in the result set.
+type Record struct {
+	Schema      string     `xml:"sruResponse:recordSchema"`
+	XMLEscaping string     `xml:"sruResponse:recordXMLEscaping"`
+	Data        RecordData `xml:"sruResponse:recordData"`
+	Position    int        `xml:"sruResponse:recordPosition"`
+}
+
+// RecordData holds a record either as XML or, escaped, as text.
+type RecordData struct {
+	DC      *dublincore.Record
+	Explain *Explain
+	Text    string `xml:",chardata"`
+}
+
+// NewDCRecord embeds a Dublin Core record with the given escaping.
+func NewDCRecord(record dublincore.Record, escaping string, position int) (Record, error) {
+	res := Record{Schema: SchemaDC, XMLEscaping: escaping, Position: position}
+	if escaping == EscapingString {
+		text, err := xml.Marshal(record)
+		if err != nil {
+			return Record{}, err
+		}
+		res.Data.Text = string(text)
+	} else {
+		res.Data.DC = &record
+	}
+	return res, nil
+}
+
+// ExplainResponse describes the endpoint in a ZeeRex record.
+type ExplainResponse struct {
+	XMLName   xml.Name `xml:"sruResponse:explainResponse"`
+	Namespace string   `xml:"xmlns:sruResponse,attr"`
+	Version   string   `xml:"sruResponse:version"`
+	Record    Record   `xml:"sruResponse:record"`
+}
+
+// NewExplainResponse wraps the explain record.
+func NewExplainResponse(explain Explain) *ExplainResponse {
+	explain.Namespace = NamespaceExplain
+	return &ExplainResponse{
+		Namespace: NamespaceResponse,
+		Version:   Version,
+		Record: Record{
+			Schema:      NamespaceExplain,
+			XMLEscaping: EscapingXML,
+			Data:        RecordData{Explain: &explain},
+			Position:    1,
+		},
+	}
+}
+
+// Explain is a ZeeRex record.
+type Explain struct {
+	XMLName      xml.Name     `xml:"zr:explain"`
+	Namespace    string       `xml:"xmlns:zr,attr"`
+	ServerInfo   ServerInfo   `xml:"zr:serverInfo"`
+	DatabaseInfo DatabaseInfo `xml:"zr:databaseInfo"`
+	IndexInfo    IndexInfo    `xml:"zr:indexInfo"`
+	SchemaInfo   []Schema     `xml:"zr:schemaInfo>zr:schema"`
+	ConfigInfo   ConfigInfo   `xml:"zr:configInfo"`
+}
+
+type ServerInfo struct {
+	Protocol string `xml:"protocol,attr"`
+	Version  string `xml:"version,attr"`
+	Host     string `xml:"zr:host"`
+	Port     string `xml:"zr:port"`
+	Database string `xml:"zr:database"`
+}
+
+type DatabaseInfo struct {
+	Title string `xml:"zr:title"`
+}
+
+// IndexInfo lists the context sets and the indexes that can be searched.
+type IndexInfo struct {
+	Sets    []ContextSet `xml:"zr:set"`
+	Indexes []Index      `xml:"zr:index"`
+}
+
+type ContextSet struct {
+	Name       string `xml:"name,attr"`
+	Identifier string `xml:"identifier,attr"`
+}
+
+// Index is a searchable index, named in a context set.
+type Index struct {
+	Title string    `xml:"zr:title"`
+	Names []IndexID `xml:"zr:map>zr:name"`
+}
+
+type IndexID struct {
+	Set  string `xml:"set,attr"`
+	Name string `xml:",chardata"`
+}
+
+type Schema struct {
+	Identifier string `xml:"identifier,attr"`
+	Name       string `xml:"name,attr"`
+	Title      string `xml:"zr:title"`
+}
+
+// ConfigInfo holds the defaults and limits of the endpoint.
+type ConfigInfo struct {
+	Defaults []Setting `xml:"zr:default"`
+	Settings []Setting `xml:"zr:setting"`
+}
+
+type Setting struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Encode writes a response as an XML document.
+func Encode(w io.Writer, response any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	return xml.NewEncoder(w).Encode(response)
+}
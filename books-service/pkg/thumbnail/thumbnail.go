@@ -0,0 +1,76 @@
+// Package thumbnail scales images down to thumbnails. Every thumbnail pixel is the average of
+// the source pixels it covers, which keeps fine detail such as cover lettering legible at small
+// sizes where nearest-neighbour sampling would alias.
+package thumbnail
+
+import (
+	"image"
+	"image/color"
+)
+
+// Fit returns the size of a width by height image scaled down to fit in maxWidth by maxHeight,
+// keeping its aspect ratio. Images that already fit keep their size.
+func Fit(width, height, maxWidth, maxHeight int) (int, int) {
+	if width <= maxWidth && height <= maxHeight {
+		return width, height
+	}
+	// Compare width/maxWidth with height/maxHeight without dividing
+	if width*maxHeight >= height*maxWidth {
+		return maxWidth, max(1, height*maxWidth/width)
+	}
+	return max(1, width*maxHeight/height), maxHeight
+}
+
+// Scale returns src scaled down to fit in maxWidth by maxHeight. Transparent areas are laid
+// over white, so that the result can be stored in formats without an alpha channel.
+func Scale(src image.Image, maxWidth, maxHeight int) *image.RGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	width, height := Fit(srcWidth, srcHeight, maxWidth, maxHeight)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if width == 0 || height == 0 {
+		return dst
+	}
+
+	at := pixelReader(src)
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcHeight/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcWidth/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcWidth/width)
+
+			var r, g, b, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := at(sx, sy)
+					// The colour is premultiplied, so adding the missing alpha lays it over white
+					back := uint64(0xffff - c.A)
+					r += uint64(c.R) + back
+					g += uint64(c.G) + back
+					b += uint64(c.B) + back
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return dst
+}
+
+// pixelReader returns a function reading the pixels of img, avoiding the allocation of
+// image.Image.At for the image types of the standard library.
+func pixelReader(img image.Image) func(x, y int) color.RGBA64 {
+	if fast, ok := img.(image.RGBA64Image); ok {
+		return fast.RGBA64At
+	}
+	return func(x, y int) color.RGBA64 {
+		r, g, b, a := img.At(x, y).RGBA()
+		return color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: uint16(a)}
+	}
+}
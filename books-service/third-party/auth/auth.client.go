@@ -2,11 +2,12 @@ package auth
 
 import (
 	"context"
-	"google.golang.org/grpc/credentials/insecure"
+	"library-management-api/books-service/configs"
 	"library-management-api/pkg/proto/auth"
+	"library-management-api/util/errorhandler"
+	"library-management-api/util/grpcconn"
 
 	"github.com/rs/zerolog/log"
-	"google.golang.org/grpc"
 )
 
 // Client interface for AuthService
@@ -17,13 +18,14 @@ type IClient interface {
 
 // Client struct for managing connection
 type Client struct {
-	c auth.AuthServiceClient // gRPC client
+	c      auth.AuthServiceClient // gRPC client
+	caller *grpcconn.Caller       // timeouts, retries and circuit breaking of the calls
 }
 
 // NewClient creates a new gRPC client for AuthService
 func NewClient() (IClient, error) {
-	// Establish gRPC connection with the server
-	conn, err := grpc.Dial("localhost:8081", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Share the long-lived connection to the server
+	conn, err := grpcconn.Conn("auth-service", configs.C().Peers.Auth)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to create grpc client")
 		return nil, err
@@ -31,24 +33,44 @@ func NewClient() (IClient, error) {
 	client := auth.NewAuthServiceClient(conn)
 
 	return &Client{
-		c: client,
+		c:      client,
+		caller: grpcconn.CallerFor("auth-service", configs.C().Peers.Auth.Policy),
 	}, nil
 }
 
+// HashedPassword and VerifyToken have no side effects on the auth service, so both are retried
+// as idempotent calls.
 func (c *Client) HashedPassword(ctx context.Context, req HashedPasswordReq) (HashedPasswordRes, error) {
-	res, err := c.c.HashedPassword(ctx, MapDtoHashedPasswordReqToPbHashedPasswordReq(req))
+	var res *auth.HashedPasswordRes
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.c.HashedPassword(ctx, MapDtoHashedPasswordReqToPbHashedPasswordReq(req))
+		return err
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("failed to call HashedPassword")
-		return HashedPasswordRes{}, err
+		return HashedPasswordRes{}, mapError(err)
 	}
 	return MapPbHashedPasswordResToDtoHashedPasswordRes(res), nil
 }
 
 func (c *Client) VerifyToken(ctx context.Context, req VerifyTokenReq) (VerifyTokenRes, error) {
-	res, err := c.c.VerifyToken(ctx, MapDtoVerifyTokenReqToPbVerifyTokenReq(req))
+	var res *auth.VerifyTokenRes
+	err := c.caller.Call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.c.VerifyToken(ctx, MapDtoVerifyTokenReqToPbVerifyTokenReq(req))
+		return err
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("failed to call VerifyToken")
-		return VerifyTokenRes{}, err
+		return VerifyTokenRes{}, mapError(err)
 	}
 	return MapPbVerifyTokenResToDtoVerifyTokenRes(res), nil
 }
+
+// mapError reports an auth service that cannot be reached as ErrAuthUnavailable, so callers can
+// tell it apart from a rejected token.
+func mapError(err error) error {
+	if grpcconn.Unreachable(err) {
+		return errorhandler.ErrAuthUnavailable
+	}
+	return err
+}
@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+type AuthorRes struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	BookCount uint      `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AuthorListRes struct {
+	Data       []AuthorRes `json:"data"`
+	Total      int         `json:"total"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
+
+type AddAuthorReq struct {
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+type GetAuthorsReq struct {
+	Limit  int    `form:"limit"`
+	Page   int    `form:"page"`
+	Cursor string `form:"cursor"`
+	Sort   string `form:"sort"`
+	Order  string `form:"order"`
+	Name   string `form:"name"`
+}
+
+type GetAuthorReq struct {
+	ID uint
+}
+
+type UpdateAuthorReq struct {
+	ID   uint
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+type DeleteAuthorReq struct {
+	ID uint
+}
+
+type AuthorBooksReq struct {
+	ID uint
+	ListBooksReq
+}
@@ -0,0 +1,222 @@
+// Package dto holds the requests and responses of the HTTP API. The api-gateway binds and
+// renders them, and the books service writes and reads its catalogue files with them, so that
+// an export can be imported again.
+package dto
+
+import "time"
+
+type BookRes struct {
+	ID              uint            `json:"id"`
+	Title           string          `json:"title"`
+	Author          string          `json:"author"`
+	Authors         []BookAuthorRes `json:"authors"`
+	Tags            []BookTagRes    `json:"tags"`
+	Category        string          `json:"category"`
+	Subject         string          `json:"subject"`
+	Genre           string          `json:"genre"`
+	PublishedYear   uint            `json:"published_year"`
+	ISBN10          string          `json:"isbn_10,omitempty"`
+	ISBN13          string          `json:"isbn_13,omitempty"`
+	Work            *BookWorkRes    `json:"work,omitempty"`
+	Edition         string          `json:"edition,omitempty"`
+	Language        string          `json:"language,omitempty"`
+	Format          string          `json:"format,omitempty"`
+	Series          *BookSeriesRes  `json:"series,omitempty"`
+	CoverURL        string          `json:"cover_url,omitempty"`
+	CoverThumbURL   string          `json:"cover_thumbnail_url,omitempty"`
+	TotalCopies     uint            `json:"total_copies"`
+	AvailableCopies uint            `json:"available_copies"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// BookTagRes is a tag of a book.
+type BookTagRes struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// BookWorkRes is the work a book is an edition of.
+type BookWorkRes struct {
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+}
+
+// BookSeriesRes is the series a book belongs to, with its volume number when it has one.
+type BookSeriesRes struct {
+	ID     uint   `json:"id"`
+	Title  string `json:"title"`
+	Volume uint   `json:"volume,omitempty"`
+}
+
+// BookSeriesReq places a book in an existing series by ID, or in the series with the given
+// title, which is created when it is new.
+type BookSeriesReq struct {
+	ID     uint   `json:"id"`
+	Title  string `json:"title" binding:"required_without=ID,max=255"`
+	Volume uint   `json:"volume"`
+}
+
+// BookAuthorRes is an author credited with a book.
+type BookAuthorRes struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// AddBookReq credits the book either with existing authors by AuthorIDs, in order, or with the
+// authors named in Author, separated by semicolons, who are created when they are new.
+type AddBookReq struct {
+	Title         string         `json:"title" binding:"required,max=255"`
+	Author        string         `json:"author" binding:"required_without=AuthorIDs,max=255"`
+	AuthorIDs     []uint         `json:"author_ids"`
+	Category      string         `json:"category" binding:"required,max=100"`
+	Subject       string         `json:"subject" binding:"required,max=100"`
+	Genre         string         `json:"genre" binding:"required,max=100"`
+	PublishedYear uint           `json:"published_year" binding:"required"`
+	ISBN10        string         `json:"isbn_10"`
+	ISBN13        string         `json:"isbn_13"`
+	WorkID        uint           `json:"work_id"`
+	Edition       string         `json:"edition" binding:"max=100"`
+	Language      string         `json:"language" binding:"max=35"`
+	Format        string         `json:"format"`
+	Series        *BookSeriesReq `json:"series"`
+}
+
+// ListBooksReq holds the paging, sorting and filter parameters shared by the book list endpoints.
+type ListBooksReq struct {
+	Limit     int      `form:"limit"`
+	Page      int      `form:"page"`
+	Cursor    string   `form:"cursor"`
+	Sort      string   `form:"sort"`
+	Order     string   `form:"order"`
+	YearFrom  uint     `form:"year_from"`
+	YearTo    uint     `form:"year_to"`
+	Available *bool    `form:"available"`
+	Author    string   `form:"author"`
+	Category  string   `form:"category"`
+	Subject   string   `form:"subject"`
+	Genre     string   `form:"genre"`
+	Tags      []string `form:"tag"`
+}
+
+type BookListRes struct {
+	Data       []BookRes `json:"data"`
+	Total      int       `json:"total"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+// BookMatchRes is a book found by a search, with its relevance score and highlighted text.
+type BookMatchRes struct {
+	BookRes
+	Score     float64 `json:"score"`
+	Highlight string  `json:"highlight"`
+}
+
+type BookMatchListRes struct {
+	Data       []BookMatchRes `json:"data"`
+	Total      int            `json:"total"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	Facets     BookFacetsRes  `json:"facets"`
+}
+
+// FacetRes is a value of a facet with the number of matching books having it.
+type FacetRes struct {
+	Value string `json:"value"`
+	Count uint   `json:"count"`
+}
+
+// BookFacetsRes breaks the books of a search result down by field, most common value first.
+// Each value narrows the search when passed back as the filter of the same name; decades are
+// narrowed with year_from and year_to, and availability with available.
+type BookFacetsRes struct {
+	Genre        []FacetRes `json:"genre"`
+	Subject      []FacetRes `json:"subject"`
+	Tag          []FacetRes `json:"tag"`
+	Decade       []FacetRes `json:"decade"`
+	Availability []FacetRes `json:"availability"`
+}
+
+type GetBooksReq struct {
+	ListBooksReq
+}
+
+type GetBookReq struct {
+	ID uint
+}
+
+type GetBookByISBNReq struct {
+	ISBN string
+}
+
+type UpdateBookReq struct {
+	ID            uint
+	Title         string         `json:"title"`
+	Author        string         `json:"author"`
+	AuthorIDs     []uint         `json:"author_ids"`
+	Category      string         `json:"category"`
+	Subject       string         `json:"subject"`
+	Genre         string         `json:"genre"`
+	PublishedYear uint           `json:"published_year"`
+	ISBN10        string         `json:"isbn_10"`
+	ISBN13        string         `json:"isbn_13"`
+	WorkID        uint           `json:"work_id"`
+	Edition       string         `json:"edition" binding:"max=100"`
+	Language      string         `json:"language" binding:"max=35"`
+	Format        string         `json:"format"`
+	Series        *BookSeriesReq `json:"series"`
+}
+
+// UpdateBookTagsReq replaces the tags of a book. Tags named for the first time are created.
+type UpdateBookTagsReq struct {
+	ID   uint
+	Tags []string `json:"tags" binding:"required,dive,max=50"`
+}
+
+type DeleteBookReq struct {
+	ID uint
+}
+
+type BorrowBookReq struct {
+	ID uint
+}
+
+type ReturnBookReq struct {
+	ID uint
+}
+
+type RenewBookReq struct {
+	ID uint
+}
+
+type SearchBooksReq struct {
+	Query    string
+	Title    string
+	Author   string
+	Category string
+	ListBooksReq
+}
+
+type CategoryBooksReq struct {
+	CategoryType  string
+	CategoryValue string
+	ListBooksReq
+}
+
+type AvailableBooksReq struct {
+	ListBooksReq
+}
+
+type SuggestBooksReq struct {
+	Prefix string `form:"prefix"`
+	Limit  int    `form:"limit"`
+}
+
+type SuggestionRes struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+	Count uint   `json:"count"`
+}
+
+type SuggestionListRes struct {
+	Data []SuggestionRes `json:"data"`
+}
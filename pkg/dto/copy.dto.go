@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+type CopyRes struct {
+	ID            uint      `json:"id"`
+	BookID        uint      `json:"book_id"`
+	Barcode       string    `json:"barcode"`
+	ShelfLocation string    `json:"shelf_location"`
+	Status        string    `json:"status"`
+	BorrowerID    uint      `json:"borrower_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type AddCopyReq struct {
+	BookID        uint
+	Barcode       string `json:"barcode" binding:"required"`
+	ShelfLocation string `json:"shelf_location"`
+	Status        string `json:"status"`
+}
+
+type GetCopiesReq struct {
+	BookID uint
+}
+
+type UpdateCopyReq struct {
+	ID            uint
+	Barcode       string `json:"barcode"`
+	ShelfLocation string `json:"shelf_location"`
+	Status        string `json:"status"`
+}
+
+type DeleteCopyReq struct {
+	ID uint
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+)
+
+type UpdateBookCoverReq struct {
+	ID uint
+}
+
+type DeleteBookCoverReq struct {
+	ID uint
+}
+
+type GetBookCoverReq struct {
+	ID   uint
+	Size string `form:"size" binding:"omitempty,oneof=original thumbnail"`
+}
+
+// CoverURL returns the URL the cover of a book, uploaded at updatedAt, is served at in the given
+// size. The time of the upload is part of the URL, so that caches drop a replaced cover.
+func CoverURL(bookID uint, updatedAt time.Time, size string) string {
+	if size == "thumbnail" {
+		return fmt.Sprintf("/books/%d/cover?size=thumbnail&v=%d", bookID, updatedAt.Unix())
+	}
+	return fmt.Sprintf("/books/%d/cover?v=%d", bookID, updatedAt.Unix())
+}
@@ -0,0 +1,9 @@
+package dto
+
+type ExportBooksReq struct {
+	Format string `form:"format"`
+}
+
+type ExportMarcReq struct {
+	Format string `form:"format"`
+}
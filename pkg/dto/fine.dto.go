@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+type FineRes struct {
+	ID          uint      `json:"id"`
+	UserID      uint      `json:"user_id"`
+	LoanID      uint      `json:"loan_id,omitempty"`
+	Kind        string    `json:"kind"`
+	AmountCents int64     `json:"amount_cents"`
+	Note        string    `json:"note"`
+	CreatedBy   uint      `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type FineBalanceRes struct {
+	UserID           uint      `json:"user_id"`
+	ChargedCents     int64     `json:"charged_cents"`
+	PaidCents        int64     `json:"paid_cents"`
+	WaivedCents      int64     `json:"waived_cents"`
+	OutstandingCents int64     `json:"outstanding_cents"`
+	Entries          []FineRes `json:"entries"`
+}
+
+type GetMyFinesReq struct{}
+
+type GetUserFinesReq struct {
+	UserID uint
+}
+
+type SettleFineReq struct {
+	UserID      uint
+	AmountCents int64  `json:"amount_cents" binding:"required"`
+	Note        string `json:"note"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+type GenreRes struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Synonyms  []string  `json:"synonyms"`
+	BookCount uint      `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AddGenreReq struct {
+	Name     string   `json:"name" binding:"required,max=100"`
+	Synonyms []string `json:"synonyms" binding:"dive,max=100"`
+}
+
+// UpdateGenreReq renames a genre and replaces its synonyms.
+type UpdateGenreReq struct {
+	ID       uint
+	Name     string   `json:"name" binding:"required,max=100"`
+	Synonyms []string `json:"synonyms" binding:"dive,max=100"`
+}
+
+type DeleteGenreReq struct {
+	ID uint
+}
+
+type MergeGenreReq struct {
+	ID     uint
+	IntoID uint `json:"into_id" binding:"required"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+type HoldRes struct {
+	ID     uint `json:"id"`
+	BookID uint `json:"book_id"`
+	UserID uint `json:"user_id"`
+	CopyID uint `json:"copy_id,omitempty"`
+	// AnyEdition holds are served by a copy of any edition of the work of the book.
+	AnyEdition bool       `json:"any_edition"`
+	Status     string     `json:"status"`
+	Position   uint       `json:"position,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReadyAt    *time.Time `json:"ready_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+type PlaceHoldReq struct {
+	BookID     uint
+	AnyEdition bool `form:"any_edition"`
+}
+
+type GetMyHoldsReq struct{}
+
+type GetBookHoldsReq struct {
+	BookID uint
+}
+
+type CancelHoldReq struct {
+	ID uint
+}
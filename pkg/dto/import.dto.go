@@ -0,0 +1,28 @@
+package dto
+
+type ImportBooksReq struct {
+	Format string `form:"format"`
+	DryRun bool   `form:"dry_run"`
+}
+
+type ImportMarcReq struct {
+	Format string `form:"format"`
+	DryRun bool   `form:"dry_run"`
+}
+
+type ImportRowRes struct {
+	Line   uint   `json:"line"`
+	Status string `json:"status"`
+	BookID uint   `json:"book_id,omitempty"`
+	Title  string `json:"title,omitempty"`
+	ISBN13 string `json:"isbn_13,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type ImportReportRes struct {
+	DryRun  bool           `json:"dry_run"`
+	Created uint           `json:"created"`
+	Skipped uint           `json:"skipped"`
+	Failed  uint           `json:"failed"`
+	Rows    []ImportRowRes `json:"rows"`
+}
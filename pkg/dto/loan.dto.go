@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type LoanRes struct {
+	ID         uint       `json:"id"`
+	CopyID     uint       `json:"copy_id"`
+	BookID     uint       `json:"book_id"`
+	UserID     uint       `json:"user_id"`
+	BorrowedAt time.Time  `json:"borrowed_at"`
+	DueAt      *time.Time `json:"due_at"`
+	ReturnedAt *time.Time `json:"returned_at"`
+	BorrowedBy uint       `json:"borrowed_by"`
+	ReturnedBy uint       `json:"returned_by,omitempty"`
+	Renewals   uint       `json:"renewals"`
+}
+
+type GetLoansReq struct{}
+
+type GetUserLoansReq struct {
+	UserID uint
+}
+
+type GetBookLoansReq struct {
+	BookID uint
+}
+
+type GetMyLoansReq struct{}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+type SubjectRes struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	ParentID  uint      `json:"parent_id,omitempty"`
+	BookCount uint      `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AddSubjectReq struct {
+	Name     string `json:"name" binding:"required,max=100"`
+	ParentID uint   `json:"parent_id"`
+}
+
+// UpdateSubjectReq renames a subject and moves it under parent_id, or to the top when it is left out.
+type UpdateSubjectReq struct {
+	ID       uint
+	Name     string `json:"name" binding:"required,max=100"`
+	ParentID uint   `json:"parent_id"`
+}
+
+type DeleteSubjectReq struct {
+	ID uint
+}
+
+type MergeSubjectReq struct {
+	ID     uint
+	IntoID uint `json:"into_id" binding:"required"`
+}
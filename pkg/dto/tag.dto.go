@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type TagRes struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	BookCount uint      `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AddTagReq struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+type UpdateTagReq struct {
+	ID   uint
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+type DeleteTagReq struct {
+	ID uint
+}
@@ -1,4 +1,4 @@
-package http
+package dto
 
 import "time"
 
@@ -17,7 +17,20 @@ type UserRes struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
-type GetUsersReq struct{}
+type GetUsersReq struct {
+	Limit   int    `form:"limit"`
+	Page    int    `form:"page"`
+	Cursor  string `form:"cursor"`
+	Sort    string `form:"sort"`
+	Order   string `form:"order"`
+	IsAdmin *bool  `form:"is_admin"`
+}
+
+type UserListRes struct {
+	Data       []UserRes `json:"data"`
+	Total      int       `json:"total"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
 
 type GetUserReq struct {
 	ID uint
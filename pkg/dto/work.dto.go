@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// WorkRes is a work with its editions. The copy counts add up the copies of every edition.
+type WorkRes struct {
+	ID              uint      `json:"id"`
+	Title           string    `json:"title"`
+	Editions        []BookRes `json:"editions"`
+	TotalCopies     uint      `json:"total_copies"`
+	AvailableCopies uint      `json:"available_copies"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type AddWorkReq struct {
+	Title string `json:"title" binding:"required,max=255"`
+}
+
+type GetWorkReq struct {
+	ID uint
+}
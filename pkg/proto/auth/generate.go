@@ -0,0 +1,3 @@
+package auth
+
+//go:generate make -C ../../.. proto-auth
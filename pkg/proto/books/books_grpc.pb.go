@@ -0,0 +1,3203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: books.proto
+
+package books
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BooksService_AddBook_FullMethodName         = "/books.BooksService/AddBook"
+	BooksService_GetBook_FullMethodName         = "/books.BooksService/GetBook"
+	BooksService_GetBookByISBN_FullMethodName   = "/books.BooksService/GetBookByISBN"
+	BooksService_ListBooks_FullMethodName       = "/books.BooksService/ListBooks"
+	BooksService_UpdateBook_FullMethodName      = "/books.BooksService/UpdateBook"
+	BooksService_UpdateBookTags_FullMethodName  = "/books.BooksService/UpdateBookTags"
+	BooksService_DeleteBook_FullMethodName      = "/books.BooksService/DeleteBook"
+	BooksService_SearchBooks_FullMethodName     = "/books.BooksService/SearchBooks"
+	BooksService_CategoryBooks_FullMethodName   = "/books.BooksService/CategoryBooks"
+	BooksService_AvailableBooks_FullMethodName  = "/books.BooksService/AvailableBooks"
+	BooksService_SuggestBooks_FullMethodName    = "/books.BooksService/SuggestBooks"
+	BooksService_BorrowBook_FullMethodName      = "/books.BooksService/BorrowBook"
+	BooksService_ReturnBook_FullMethodName      = "/books.BooksService/ReturnBook"
+	BooksService_RenewBook_FullMethodName       = "/books.BooksService/RenewBook"
+	BooksService_GetAvailability_FullMethodName = "/books.BooksService/GetAvailability"
+)
+
+// BooksServiceClient is the client API for BooksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BooksServiceClient interface {
+	AddBook(ctx context.Context, in *AddBookReq, opts ...grpc.CallOption) (*Book, error)
+	GetBook(ctx context.Context, in *GetBookReq, opts ...grpc.CallOption) (*Book, error)
+	GetBookByISBN(ctx context.Context, in *GetBookByISBNReq, opts ...grpc.CallOption) (*Book, error)
+	ListBooks(ctx context.Context, in *ListBooksReq, opts ...grpc.CallOption) (*BookPage, error)
+	UpdateBook(ctx context.Context, in *UpdateBookReq, opts ...grpc.CallOption) (*Book, error)
+	UpdateBookTags(ctx context.Context, in *UpdateBookTagsReq, opts ...grpc.CallOption) (*Book, error)
+	DeleteBook(ctx context.Context, in *DeleteBookReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SearchBooks(ctx context.Context, in *SearchBooksReq, opts ...grpc.CallOption) (*SearchBooksRes, error)
+	CategoryBooks(ctx context.Context, in *CategoryBooksReq, opts ...grpc.CallOption) (*BookPage, error)
+	AvailableBooks(ctx context.Context, in *ListBooksReq, opts ...grpc.CallOption) (*BookPage, error)
+	SuggestBooks(ctx context.Context, in *SuggestBooksReq, opts ...grpc.CallOption) (*SuggestBooksRes, error)
+	BorrowBook(ctx context.Context, in *BorrowBookReq, opts ...grpc.CallOption) (*Loan, error)
+	ReturnBook(ctx context.Context, in *ReturnBookReq, opts ...grpc.CallOption) (*Loan, error)
+	RenewBook(ctx context.Context, in *RenewBookReq, opts ...grpc.CallOption) (*Loan, error)
+	GetAvailability(ctx context.Context, in *GetAvailabilityReq, opts ...grpc.CallOption) (*Availability, error)
+}
+
+type booksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBooksServiceClient(cc grpc.ClientConnInterface) BooksServiceClient {
+	return &booksServiceClient{cc}
+}
+
+func (c *booksServiceClient) AddBook(ctx context.Context, in *AddBookReq, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BooksService_AddBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) GetBook(ctx context.Context, in *GetBookReq, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BooksService_GetBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) GetBookByISBN(ctx context.Context, in *GetBookByISBNReq, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BooksService_GetBookByISBN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) ListBooks(ctx context.Context, in *ListBooksReq, opts ...grpc.CallOption) (*BookPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookPage)
+	err := c.cc.Invoke(ctx, BooksService_ListBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) UpdateBook(ctx context.Context, in *UpdateBookReq, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BooksService_UpdateBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) UpdateBookTags(ctx context.Context, in *UpdateBookTagsReq, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BooksService_UpdateBookTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) DeleteBook(ctx context.Context, in *DeleteBookReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BooksService_DeleteBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) SearchBooks(ctx context.Context, in *SearchBooksReq, opts ...grpc.CallOption) (*SearchBooksRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBooksRes)
+	err := c.cc.Invoke(ctx, BooksService_SearchBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) CategoryBooks(ctx context.Context, in *CategoryBooksReq, opts ...grpc.CallOption) (*BookPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookPage)
+	err := c.cc.Invoke(ctx, BooksService_CategoryBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) AvailableBooks(ctx context.Context, in *ListBooksReq, opts ...grpc.CallOption) (*BookPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookPage)
+	err := c.cc.Invoke(ctx, BooksService_AvailableBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) SuggestBooks(ctx context.Context, in *SuggestBooksReq, opts ...grpc.CallOption) (*SuggestBooksRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestBooksRes)
+	err := c.cc.Invoke(ctx, BooksService_SuggestBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) BorrowBook(ctx context.Context, in *BorrowBookReq, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, BooksService_BorrowBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) ReturnBook(ctx context.Context, in *ReturnBookReq, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, BooksService_ReturnBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) RenewBook(ctx context.Context, in *RenewBookReq, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, BooksService_RenewBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *booksServiceClient) GetAvailability(ctx context.Context, in *GetAvailabilityReq, opts ...grpc.CallOption) (*Availability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Availability)
+	err := c.cc.Invoke(ctx, BooksService_GetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BooksServiceServer is the server API for BooksService service.
+// All implementations must embed UnimplementedBooksServiceServer
+// for forward compatibility.
+type BooksServiceServer interface {
+	AddBook(context.Context, *AddBookReq) (*Book, error)
+	GetBook(context.Context, *GetBookReq) (*Book, error)
+	GetBookByISBN(context.Context, *GetBookByISBNReq) (*Book, error)
+	ListBooks(context.Context, *ListBooksReq) (*BookPage, error)
+	UpdateBook(context.Context, *UpdateBookReq) (*Book, error)
+	UpdateBookTags(context.Context, *UpdateBookTagsReq) (*Book, error)
+	DeleteBook(context.Context, *DeleteBookReq) (*emptypb.Empty, error)
+	SearchBooks(context.Context, *SearchBooksReq) (*SearchBooksRes, error)
+	CategoryBooks(context.Context, *CategoryBooksReq) (*BookPage, error)
+	AvailableBooks(context.Context, *ListBooksReq) (*BookPage, error)
+	SuggestBooks(context.Context, *SuggestBooksReq) (*SuggestBooksRes, error)
+	BorrowBook(context.Context, *BorrowBookReq) (*Loan, error)
+	ReturnBook(context.Context, *ReturnBookReq) (*Loan, error)
+	RenewBook(context.Context, *RenewBookReq) (*Loan, error)
+	GetAvailability(context.Context, *GetAvailabilityReq) (*Availability, error)
+	mustEmbedUnimplementedBooksServiceServer()
+}
+
+// UnimplementedBooksServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBooksServiceServer struct{}
+
+func (UnimplementedBooksServiceServer) AddBook(context.Context, *AddBookReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBook not implemented")
+}
+func (UnimplementedBooksServiceServer) GetBook(context.Context, *GetBookReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBook not implemented")
+}
+func (UnimplementedBooksServiceServer) GetBookByISBN(context.Context, *GetBookByISBNReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookByISBN not implemented")
+}
+func (UnimplementedBooksServiceServer) ListBooks(context.Context, *ListBooksReq) (*BookPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBooks not implemented")
+}
+func (UnimplementedBooksServiceServer) UpdateBook(context.Context, *UpdateBookReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBook not implemented")
+}
+func (UnimplementedBooksServiceServer) UpdateBookTags(context.Context, *UpdateBookTagsReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookTags not implemented")
+}
+func (UnimplementedBooksServiceServer) DeleteBook(context.Context, *DeleteBookReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
+}
+func (UnimplementedBooksServiceServer) SearchBooks(context.Context, *SearchBooksReq) (*SearchBooksRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBooks not implemented")
+}
+func (UnimplementedBooksServiceServer) CategoryBooks(context.Context, *CategoryBooksReq) (*BookPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryBooks not implemented")
+}
+func (UnimplementedBooksServiceServer) AvailableBooks(context.Context, *ListBooksReq) (*BookPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableBooks not implemented")
+}
+func (UnimplementedBooksServiceServer) SuggestBooks(context.Context, *SuggestBooksReq) (*SuggestBooksRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestBooks not implemented")
+}
+func (UnimplementedBooksServiceServer) BorrowBook(context.Context, *BorrowBookReq) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BorrowBook not implemented")
+}
+func (UnimplementedBooksServiceServer) ReturnBook(context.Context, *ReturnBookReq) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnBook not implemented")
+}
+func (UnimplementedBooksServiceServer) RenewBook(context.Context, *RenewBookReq) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewBook not implemented")
+}
+func (UnimplementedBooksServiceServer) GetAvailability(context.Context, *GetAvailabilityReq) (*Availability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailability not implemented")
+}
+func (UnimplementedBooksServiceServer) mustEmbedUnimplementedBooksServiceServer() {}
+func (UnimplementedBooksServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeBooksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BooksServiceServer will
+// result in compilation errors.
+type UnsafeBooksServiceServer interface {
+	mustEmbedUnimplementedBooksServiceServer()
+}
+
+func RegisterBooksServiceServer(s grpc.ServiceRegistrar, srv BooksServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBooksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BooksService_ServiceDesc, srv)
+}
+
+func _BooksService_AddBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).AddBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_AddBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).AddBook(ctx, req.(*AddBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).GetBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_GetBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).GetBook(ctx, req.(*GetBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_GetBookByISBN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookByISBNReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).GetBookByISBN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_GetBookByISBN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).GetBookByISBN(ctx, req.(*GetBookByISBNReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_ListBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).ListBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_ListBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).ListBooks(ctx, req.(*ListBooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).UpdateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_UpdateBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).UpdateBook(ctx, req.(*UpdateBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_UpdateBookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).UpdateBookTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_UpdateBookTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).UpdateBookTags(ctx, req.(*UpdateBookTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).DeleteBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_DeleteBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).DeleteBook(ctx, req.(*DeleteBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_SearchBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).SearchBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_SearchBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).SearchBooks(ctx, req.(*SearchBooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_CategoryBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryBooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).CategoryBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_CategoryBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).CategoryBooks(ctx, req.(*CategoryBooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_AvailableBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).AvailableBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_AvailableBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).AvailableBooks(ctx, req.(*ListBooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_SuggestBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestBooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).SuggestBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_SuggestBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).SuggestBooks(ctx, req.(*SuggestBooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_BorrowBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BorrowBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).BorrowBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_BorrowBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).BorrowBook(ctx, req.(*BorrowBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_ReturnBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).ReturnBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_ReturnBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).ReturnBook(ctx, req.(*ReturnBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_RenewBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).RenewBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_RenewBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).RenewBook(ctx, req.(*RenewBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BooksService_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BooksServiceServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BooksService_GetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BooksServiceServer).GetAvailability(ctx, req.(*GetAvailabilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BooksService_ServiceDesc is the grpc.ServiceDesc for BooksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BooksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.BooksService",
+	HandlerType: (*BooksServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddBook",
+			Handler:    _BooksService_AddBook_Handler,
+		},
+		{
+			MethodName: "GetBook",
+			Handler:    _BooksService_GetBook_Handler,
+		},
+		{
+			MethodName: "GetBookByISBN",
+			Handler:    _BooksService_GetBookByISBN_Handler,
+		},
+		{
+			MethodName: "ListBooks",
+			Handler:    _BooksService_ListBooks_Handler,
+		},
+		{
+			MethodName: "UpdateBook",
+			Handler:    _BooksService_UpdateBook_Handler,
+		},
+		{
+			MethodName: "UpdateBookTags",
+			Handler:    _BooksService_UpdateBookTags_Handler,
+		},
+		{
+			MethodName: "DeleteBook",
+			Handler:    _BooksService_DeleteBook_Handler,
+		},
+		{
+			MethodName: "SearchBooks",
+			Handler:    _BooksService_SearchBooks_Handler,
+		},
+		{
+			MethodName: "CategoryBooks",
+			Handler:    _BooksService_CategoryBooks_Handler,
+		},
+		{
+			MethodName: "AvailableBooks",
+			Handler:    _BooksService_AvailableBooks_Handler,
+		},
+		{
+			MethodName: "SuggestBooks",
+			Handler:    _BooksService_SuggestBooks_Handler,
+		},
+		{
+			MethodName: "BorrowBook",
+			Handler:    _BooksService_BorrowBook_Handler,
+		},
+		{
+			MethodName: "ReturnBook",
+			Handler:    _BooksService_ReturnBook_Handler,
+		},
+		{
+			MethodName: "RenewBook",
+			Handler:    _BooksService_RenewBook_Handler,
+		},
+		{
+			MethodName: "GetAvailability",
+			Handler:    _BooksService_GetAvailability_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	AuthorsService_AddAuthor_FullMethodName    = "/books.AuthorsService/AddAuthor"
+	AuthorsService_ListAuthors_FullMethodName  = "/books.AuthorsService/ListAuthors"
+	AuthorsService_GetAuthor_FullMethodName    = "/books.AuthorsService/GetAuthor"
+	AuthorsService_UpdateAuthor_FullMethodName = "/books.AuthorsService/UpdateAuthor"
+	AuthorsService_DeleteAuthor_FullMethodName = "/books.AuthorsService/DeleteAuthor"
+	AuthorsService_AuthorBooks_FullMethodName  = "/books.AuthorsService/AuthorBooks"
+)
+
+// AuthorsServiceClient is the client API for AuthorsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthorsServiceClient interface {
+	AddAuthor(ctx context.Context, in *AddAuthorReq, opts ...grpc.CallOption) (*Author, error)
+	ListAuthors(ctx context.Context, in *ListAuthorsReq, opts ...grpc.CallOption) (*AuthorPage, error)
+	GetAuthor(ctx context.Context, in *GetAuthorReq, opts ...grpc.CallOption) (*Author, error)
+	UpdateAuthor(ctx context.Context, in *UpdateAuthorReq, opts ...grpc.CallOption) (*Author, error)
+	DeleteAuthor(ctx context.Context, in *DeleteAuthorReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AuthorBooks(ctx context.Context, in *AuthorBooksReq, opts ...grpc.CallOption) (*BookPage, error)
+}
+
+type authorsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthorsServiceClient(cc grpc.ClientConnInterface) AuthorsServiceClient {
+	return &authorsServiceClient{cc}
+}
+
+func (c *authorsServiceClient) AddAuthor(ctx context.Context, in *AddAuthorReq, opts ...grpc.CallOption) (*Author, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Author)
+	err := c.cc.Invoke(ctx, AuthorsService_AddAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorsServiceClient) ListAuthors(ctx context.Context, in *ListAuthorsReq, opts ...grpc.CallOption) (*AuthorPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorPage)
+	err := c.cc.Invoke(ctx, AuthorsService_ListAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorsServiceClient) GetAuthor(ctx context.Context, in *GetAuthorReq, opts ...grpc.CallOption) (*Author, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Author)
+	err := c.cc.Invoke(ctx, AuthorsService_GetAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorsServiceClient) UpdateAuthor(ctx context.Context, in *UpdateAuthorReq, opts ...grpc.CallOption) (*Author, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Author)
+	err := c.cc.Invoke(ctx, AuthorsService_UpdateAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorsServiceClient) DeleteAuthor(ctx context.Context, in *DeleteAuthorReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthorsService_DeleteAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorsServiceClient) AuthorBooks(ctx context.Context, in *AuthorBooksReq, opts ...grpc.CallOption) (*BookPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookPage)
+	err := c.cc.Invoke(ctx, AuthorsService_AuthorBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthorsServiceServer is the server API for AuthorsService service.
+// All implementations must embed UnimplementedAuthorsServiceServer
+// for forward compatibility.
+type AuthorsServiceServer interface {
+	AddAuthor(context.Context, *AddAuthorReq) (*Author, error)
+	ListAuthors(context.Context, *ListAuthorsReq) (*AuthorPage, error)
+	GetAuthor(context.Context, *GetAuthorReq) (*Author, error)
+	UpdateAuthor(context.Context, *UpdateAuthorReq) (*Author, error)
+	DeleteAuthor(context.Context, *DeleteAuthorReq) (*emptypb.Empty, error)
+	AuthorBooks(context.Context, *AuthorBooksReq) (*BookPage, error)
+	mustEmbedUnimplementedAuthorsServiceServer()
+}
+
+// UnimplementedAuthorsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthorsServiceServer struct{}
+
+func (UnimplementedAuthorsServiceServer) AddAuthor(context.Context, *AddAuthorReq) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthor not implemented")
+}
+func (UnimplementedAuthorsServiceServer) ListAuthors(context.Context, *ListAuthorsReq) (*AuthorPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthors not implemented")
+}
+func (UnimplementedAuthorsServiceServer) GetAuthor(context.Context, *GetAuthorReq) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthor not implemented")
+}
+func (UnimplementedAuthorsServiceServer) UpdateAuthor(context.Context, *UpdateAuthorReq) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuthor not implemented")
+}
+func (UnimplementedAuthorsServiceServer) DeleteAuthor(context.Context, *DeleteAuthorReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthor not implemented")
+}
+func (UnimplementedAuthorsServiceServer) AuthorBooks(context.Context, *AuthorBooksReq) (*BookPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorBooks not implemented")
+}
+func (UnimplementedAuthorsServiceServer) mustEmbedUnimplementedAuthorsServiceServer() {}
+func (UnimplementedAuthorsServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAuthorsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthorsServiceServer will
+// result in compilation errors.
+type UnsafeAuthorsServiceServer interface {
+	mustEmbedUnimplementedAuthorsServiceServer()
+}
+
+func RegisterAuthorsServiceServer(s grpc.ServiceRegistrar, srv AuthorsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthorsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthorsService_ServiceDesc, srv)
+}
+
+func _AuthorsService_AddAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAuthorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorsServiceServer).AddAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorsService_AddAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorsServiceServer).AddAuthor(ctx, req.(*AddAuthorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorsService_ListAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorsServiceServer).ListAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorsService_ListAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorsServiceServer).ListAuthors(ctx, req.(*ListAuthorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorsService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorsServiceServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorsService_GetAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorsServiceServer).GetAuthor(ctx, req.(*GetAuthorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorsService_UpdateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAuthorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorsServiceServer).UpdateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorsService_UpdateAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorsServiceServer).UpdateAuthor(ctx, req.(*UpdateAuthorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorsService_DeleteAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAuthorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorsServiceServer).DeleteAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorsService_DeleteAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorsServiceServer).DeleteAuthor(ctx, req.(*DeleteAuthorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorsService_AuthorBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorBooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorsServiceServer).AuthorBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorsService_AuthorBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorsServiceServer).AuthorBooks(ctx, req.(*AuthorBooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthorsService_ServiceDesc is the grpc.ServiceDesc for AuthorsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthorsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.AuthorsService",
+	HandlerType: (*AuthorsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAuthor",
+			Handler:    _AuthorsService_AddAuthor_Handler,
+		},
+		{
+			MethodName: "ListAuthors",
+			Handler:    _AuthorsService_ListAuthors_Handler,
+		},
+		{
+			MethodName: "GetAuthor",
+			Handler:    _AuthorsService_GetAuthor_Handler,
+		},
+		{
+			MethodName: "UpdateAuthor",
+			Handler:    _AuthorsService_UpdateAuthor_Handler,
+		},
+		{
+			MethodName: "DeleteAuthor",
+			Handler:    _AuthorsService_DeleteAuthor_Handler,
+		},
+		{
+			MethodName: "AuthorBooks",
+			Handler:    _AuthorsService_AuthorBooks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	WorksService_AddWork_FullMethodName = "/books.WorksService/AddWork"
+	WorksService_GetWork_FullMethodName = "/books.WorksService/GetWork"
+)
+
+// WorksServiceClient is the client API for WorksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorksServiceClient interface {
+	AddWork(ctx context.Context, in *AddWorkReq, opts ...grpc.CallOption) (*Work, error)
+	GetWork(ctx context.Context, in *GetWorkReq, opts ...grpc.CallOption) (*Work, error)
+}
+
+type worksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorksServiceClient(cc grpc.ClientConnInterface) WorksServiceClient {
+	return &worksServiceClient{cc}
+}
+
+func (c *worksServiceClient) AddWork(ctx context.Context, in *AddWorkReq, opts ...grpc.CallOption) (*Work, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Work)
+	err := c.cc.Invoke(ctx, WorksService_AddWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worksServiceClient) GetWork(ctx context.Context, in *GetWorkReq, opts ...grpc.CallOption) (*Work, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Work)
+	err := c.cc.Invoke(ctx, WorksService_GetWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorksServiceServer is the server API for WorksService service.
+// All implementations must embed UnimplementedWorksServiceServer
+// for forward compatibility.
+type WorksServiceServer interface {
+	AddWork(context.Context, *AddWorkReq) (*Work, error)
+	GetWork(context.Context, *GetWorkReq) (*Work, error)
+	mustEmbedUnimplementedWorksServiceServer()
+}
+
+// UnimplementedWorksServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorksServiceServer struct{}
+
+func (UnimplementedWorksServiceServer) AddWork(context.Context, *AddWorkReq) (*Work, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWork not implemented")
+}
+func (UnimplementedWorksServiceServer) GetWork(context.Context, *GetWorkReq) (*Work, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWork not implemented")
+}
+func (UnimplementedWorksServiceServer) mustEmbedUnimplementedWorksServiceServer() {}
+func (UnimplementedWorksServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeWorksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorksServiceServer will
+// result in compilation errors.
+type UnsafeWorksServiceServer interface {
+	mustEmbedUnimplementedWorksServiceServer()
+}
+
+func RegisterWorksServiceServer(s grpc.ServiceRegistrar, srv WorksServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorksService_ServiceDesc, srv)
+}
+
+func _WorksService_AddWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksServiceServer).AddWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksService_AddWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksServiceServer).AddWork(ctx, req.(*AddWorkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorksService_GetWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksServiceServer).GetWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksService_GetWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksServiceServer).GetWork(ctx, req.(*GetWorkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorksService_ServiceDesc is the grpc.ServiceDesc for WorksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.WorksService",
+	HandlerType: (*WorksServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddWork",
+			Handler:    _WorksService_AddWork_Handler,
+		},
+		{
+			MethodName: "GetWork",
+			Handler:    _WorksService_GetWork_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	TagsService_AddTag_FullMethodName    = "/books.TagsService/AddTag"
+	TagsService_ListTags_FullMethodName  = "/books.TagsService/ListTags"
+	TagsService_UpdateTag_FullMethodName = "/books.TagsService/UpdateTag"
+	TagsService_DeleteTag_FullMethodName = "/books.TagsService/DeleteTag"
+)
+
+// TagsServiceClient is the client API for TagsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TagsServiceClient interface {
+	AddTag(ctx context.Context, in *AddTagReq, opts ...grpc.CallOption) (*Tag, error)
+	ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*TagList, error)
+	UpdateTag(ctx context.Context, in *UpdateTagReq, opts ...grpc.CallOption) (*Tag, error)
+	DeleteTag(ctx context.Context, in *DeleteTagReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type tagsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagsServiceClient(cc grpc.ClientConnInterface) TagsServiceClient {
+	return &tagsServiceClient{cc}
+}
+
+func (c *tagsServiceClient) AddTag(ctx context.Context, in *AddTagReq, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, TagsService_AddTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagsServiceClient) ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*TagList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagList)
+	err := c.cc.Invoke(ctx, TagsService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagsServiceClient) UpdateTag(ctx context.Context, in *UpdateTagReq, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, TagsService_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagsServiceClient) DeleteTag(ctx context.Context, in *DeleteTagReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TagsService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagsServiceServer is the server API for TagsService service.
+// All implementations must embed UnimplementedTagsServiceServer
+// for forward compatibility.
+type TagsServiceServer interface {
+	AddTag(context.Context, *AddTagReq) (*Tag, error)
+	ListTags(context.Context, *ListTagsReq) (*TagList, error)
+	UpdateTag(context.Context, *UpdateTagReq) (*Tag, error)
+	DeleteTag(context.Context, *DeleteTagReq) (*emptypb.Empty, error)
+	mustEmbedUnimplementedTagsServiceServer()
+}
+
+// UnimplementedTagsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagsServiceServer struct{}
+
+func (UnimplementedTagsServiceServer) AddTag(context.Context, *AddTagReq) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTag not implemented")
+}
+func (UnimplementedTagsServiceServer) ListTags(context.Context, *ListTagsReq) (*TagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedTagsServiceServer) UpdateTag(context.Context, *UpdateTagReq) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedTagsServiceServer) DeleteTag(context.Context, *DeleteTagReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedTagsServiceServer) mustEmbedUnimplementedTagsServiceServer() {}
+func (UnimplementedTagsServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTagsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagsServiceServer will
+// result in compilation errors.
+type UnsafeTagsServiceServer interface {
+	mustEmbedUnimplementedTagsServiceServer()
+}
+
+func RegisterTagsServiceServer(s grpc.ServiceRegistrar, srv TagsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTagsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagsService_ServiceDesc, srv)
+}
+
+func _TagsService_AddTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagsServiceServer).AddTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagsService_AddTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagsServiceServer).AddTag(ctx, req.(*AddTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagsService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagsServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagsService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagsServiceServer).ListTags(ctx, req.(*ListTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagsService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagsServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagsService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagsServiceServer).UpdateTag(ctx, req.(*UpdateTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagsService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagsServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagsService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagsServiceServer).DeleteTag(ctx, req.(*DeleteTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagsService_ServiceDesc is the grpc.ServiceDesc for TagsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.TagsService",
+	HandlerType: (*TagsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTag",
+			Handler:    _TagsService_AddTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _TagsService_ListTags_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _TagsService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TagsService_DeleteTag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	SubjectsService_AddSubject_FullMethodName    = "/books.SubjectsService/AddSubject"
+	SubjectsService_ListSubjects_FullMethodName  = "/books.SubjectsService/ListSubjects"
+	SubjectsService_UpdateSubject_FullMethodName = "/books.SubjectsService/UpdateSubject"
+	SubjectsService_DeleteSubject_FullMethodName = "/books.SubjectsService/DeleteSubject"
+	SubjectsService_MergeSubject_FullMethodName  = "/books.SubjectsService/MergeSubject"
+)
+
+// SubjectsServiceClient is the client API for SubjectsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubjectsServiceClient interface {
+	AddSubject(ctx context.Context, in *AddSubjectReq, opts ...grpc.CallOption) (*Subject, error)
+	ListSubjects(ctx context.Context, in *ListSubjectsReq, opts ...grpc.CallOption) (*SubjectList, error)
+	UpdateSubject(ctx context.Context, in *UpdateSubjectReq, opts ...grpc.CallOption) (*Subject, error)
+	DeleteSubject(ctx context.Context, in *DeleteSubjectReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeSubject(ctx context.Context, in *MergeSubjectReq, opts ...grpc.CallOption) (*Subject, error)
+}
+
+type subjectsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubjectsServiceClient(cc grpc.ClientConnInterface) SubjectsServiceClient {
+	return &subjectsServiceClient{cc}
+}
+
+func (c *subjectsServiceClient) AddSubject(ctx context.Context, in *AddSubjectReq, opts ...grpc.CallOption) (*Subject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, SubjectsService_AddSubject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) ListSubjects(ctx context.Context, in *ListSubjectsReq, opts ...grpc.CallOption) (*SubjectList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubjectList)
+	err := c.cc.Invoke(ctx, SubjectsService_ListSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) UpdateSubject(ctx context.Context, in *UpdateSubjectReq, opts ...grpc.CallOption) (*Subject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, SubjectsService_UpdateSubject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) DeleteSubject(ctx context.Context, in *DeleteSubjectReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SubjectsService_DeleteSubject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) MergeSubject(ctx context.Context, in *MergeSubjectReq, opts ...grpc.CallOption) (*Subject, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, SubjectsService_MergeSubject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubjectsServiceServer is the server API for SubjectsService service.
+// All implementations must embed UnimplementedSubjectsServiceServer
+// for forward compatibility.
+type SubjectsServiceServer interface {
+	AddSubject(context.Context, *AddSubjectReq) (*Subject, error)
+	ListSubjects(context.Context, *ListSubjectsReq) (*SubjectList, error)
+	UpdateSubject(context.Context, *UpdateSubjectReq) (*Subject, error)
+	DeleteSubject(context.Context, *DeleteSubjectReq) (*emptypb.Empty, error)
+	MergeSubject(context.Context, *MergeSubjectReq) (*Subject, error)
+	mustEmbedUnimplementedSubjectsServiceServer()
+}
+
+// UnimplementedSubjectsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubjectsServiceServer struct{}
+
+func (UnimplementedSubjectsServiceServer) AddSubject(context.Context, *AddSubjectReq) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubject not implemented")
+}
+func (UnimplementedSubjectsServiceServer) ListSubjects(context.Context, *ListSubjectsReq) (*SubjectList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubjects not implemented")
+}
+func (UnimplementedSubjectsServiceServer) UpdateSubject(context.Context, *UpdateSubjectReq) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubject not implemented")
+}
+func (UnimplementedSubjectsServiceServer) DeleteSubject(context.Context, *DeleteSubjectReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubject not implemented")
+}
+func (UnimplementedSubjectsServiceServer) MergeSubject(context.Context, *MergeSubjectReq) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeSubject not implemented")
+}
+func (UnimplementedSubjectsServiceServer) mustEmbedUnimplementedSubjectsServiceServer() {}
+func (UnimplementedSubjectsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeSubjectsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubjectsServiceServer will
+// result in compilation errors.
+type UnsafeSubjectsServiceServer interface {
+	mustEmbedUnimplementedSubjectsServiceServer()
+}
+
+func RegisterSubjectsServiceServer(s grpc.ServiceRegistrar, srv SubjectsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSubjectsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubjectsService_ServiceDesc, srv)
+}
+
+func _SubjectsService_AddSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).AddSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_AddSubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).AddSubject(ctx, req.(*AddSubjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_ListSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubjectsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).ListSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_ListSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).ListSubjects(ctx, req.(*ListSubjectsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_UpdateSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).UpdateSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_UpdateSubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).UpdateSubject(ctx, req.(*UpdateSubjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_DeleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).DeleteSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_DeleteSubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).DeleteSubject(ctx, req.(*DeleteSubjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_MergeSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeSubjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).MergeSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_MergeSubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).MergeSubject(ctx, req.(*MergeSubjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubjectsService_ServiceDesc is the grpc.ServiceDesc for SubjectsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubjectsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.SubjectsService",
+	HandlerType: (*SubjectsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSubject",
+			Handler:    _SubjectsService_AddSubject_Handler,
+		},
+		{
+			MethodName: "ListSubjects",
+			Handler:    _SubjectsService_ListSubjects_Handler,
+		},
+		{
+			MethodName: "UpdateSubject",
+			Handler:    _SubjectsService_UpdateSubject_Handler,
+		},
+		{
+			MethodName: "DeleteSubject",
+			Handler:    _SubjectsService_DeleteSubject_Handler,
+		},
+		{
+			MethodName: "MergeSubject",
+			Handler:    _SubjectsService_MergeSubject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	GenresService_AddGenre_FullMethodName    = "/books.GenresService/AddGenre"
+	GenresService_ListGenres_FullMethodName  = "/books.GenresService/ListGenres"
+	GenresService_UpdateGenre_FullMethodName = "/books.GenresService/UpdateGenre"
+	GenresService_DeleteGenre_FullMethodName = "/books.GenresService/DeleteGenre"
+	GenresService_MergeGenre_FullMethodName  = "/books.GenresService/MergeGenre"
+)
+
+// GenresServiceClient is the client API for GenresService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GenresServiceClient interface {
+	AddGenre(ctx context.Context, in *AddGenreReq, opts ...grpc.CallOption) (*Genre, error)
+	ListGenres(ctx context.Context, in *ListGenresReq, opts ...grpc.CallOption) (*GenreList, error)
+	UpdateGenre(ctx context.Context, in *UpdateGenreReq, opts ...grpc.CallOption) (*Genre, error)
+	DeleteGenre(ctx context.Context, in *DeleteGenreReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeGenre(ctx context.Context, in *MergeGenreReq, opts ...grpc.CallOption) (*Genre, error)
+}
+
+type genresServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGenresServiceClient(cc grpc.ClientConnInterface) GenresServiceClient {
+	return &genresServiceClient{cc}
+}
+
+func (c *genresServiceClient) AddGenre(ctx context.Context, in *AddGenreReq, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, GenresService_AddGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genresServiceClient) ListGenres(ctx context.Context, in *ListGenresReq, opts ...grpc.CallOption) (*GenreList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenreList)
+	err := c.cc.Invoke(ctx, GenresService_ListGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genresServiceClient) UpdateGenre(ctx context.Context, in *UpdateGenreReq, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, GenresService_UpdateGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genresServiceClient) DeleteGenre(ctx context.Context, in *DeleteGenreReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GenresService_DeleteGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genresServiceClient) MergeGenre(ctx context.Context, in *MergeGenreReq, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, GenresService_MergeGenre_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenresServiceServer is the server API for GenresService service.
+// All implementations must embed UnimplementedGenresServiceServer
+// for forward compatibility.
+type GenresServiceServer interface {
+	AddGenre(context.Context, *AddGenreReq) (*Genre, error)
+	ListGenres(context.Context, *ListGenresReq) (*GenreList, error)
+	UpdateGenre(context.Context, *UpdateGenreReq) (*Genre, error)
+	DeleteGenre(context.Context, *DeleteGenreReq) (*emptypb.Empty, error)
+	MergeGenre(context.Context, *MergeGenreReq) (*Genre, error)
+	mustEmbedUnimplementedGenresServiceServer()
+}
+
+// UnimplementedGenresServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGenresServiceServer struct{}
+
+func (UnimplementedGenresServiceServer) AddGenre(context.Context, *AddGenreReq) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGenre not implemented")
+}
+func (UnimplementedGenresServiceServer) ListGenres(context.Context, *ListGenresReq) (*GenreList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGenres not implemented")
+}
+func (UnimplementedGenresServiceServer) UpdateGenre(context.Context, *UpdateGenreReq) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGenre not implemented")
+}
+func (UnimplementedGenresServiceServer) DeleteGenre(context.Context, *DeleteGenreReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGenre not implemented")
+}
+func (UnimplementedGenresServiceServer) MergeGenre(context.Context, *MergeGenreReq) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGenre not implemented")
+}
+func (UnimplementedGenresServiceServer) mustEmbedUnimplementedGenresServiceServer() {}
+func (UnimplementedGenresServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGenresServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GenresServiceServer will
+// result in compilation errors.
+type UnsafeGenresServiceServer interface {
+	mustEmbedUnimplementedGenresServiceServer()
+}
+
+func RegisterGenresServiceServer(s grpc.ServiceRegistrar, srv GenresServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGenresServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GenresService_ServiceDesc, srv)
+}
+
+func _GenresService_AddGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGenreReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenresServiceServer).AddGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenresService_AddGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenresServiceServer).AddGenre(ctx, req.(*AddGenreReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenresService_ListGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGenresReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenresServiceServer).ListGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenresService_ListGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenresServiceServer).ListGenres(ctx, req.(*ListGenresReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenresService_UpdateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGenreReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenresServiceServer).UpdateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenresService_UpdateGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenresServiceServer).UpdateGenre(ctx, req.(*UpdateGenreReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenresService_DeleteGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGenreReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenresServiceServer).DeleteGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenresService_DeleteGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenresServiceServer).DeleteGenre(ctx, req.(*DeleteGenreReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenresService_MergeGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGenreReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenresServiceServer).MergeGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenresService_MergeGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenresServiceServer).MergeGenre(ctx, req.(*MergeGenreReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GenresService_ServiceDesc is the grpc.ServiceDesc for GenresService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GenresService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.GenresService",
+	HandlerType: (*GenresServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddGenre",
+			Handler:    _GenresService_AddGenre_Handler,
+		},
+		{
+			MethodName: "ListGenres",
+			Handler:    _GenresService_ListGenres_Handler,
+		},
+		{
+			MethodName: "UpdateGenre",
+			Handler:    _GenresService_UpdateGenre_Handler,
+		},
+		{
+			MethodName: "DeleteGenre",
+			Handler:    _GenresService_DeleteGenre_Handler,
+		},
+		{
+			MethodName: "MergeGenre",
+			Handler:    _GenresService_MergeGenre_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	CopiesService_AddCopy_FullMethodName    = "/books.CopiesService/AddCopy"
+	CopiesService_ListCopies_FullMethodName = "/books.CopiesService/ListCopies"
+	CopiesService_UpdateCopy_FullMethodName = "/books.CopiesService/UpdateCopy"
+	CopiesService_DeleteCopy_FullMethodName = "/books.CopiesService/DeleteCopy"
+)
+
+// CopiesServiceClient is the client API for CopiesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CopiesServiceClient interface {
+	AddCopy(ctx context.Context, in *AddCopyReq, opts ...grpc.CallOption) (*Copy, error)
+	ListCopies(ctx context.Context, in *ListCopiesReq, opts ...grpc.CallOption) (*CopyList, error)
+	UpdateCopy(ctx context.Context, in *UpdateCopyReq, opts ...grpc.CallOption) (*Copy, error)
+	DeleteCopy(ctx context.Context, in *DeleteCopyReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type copiesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCopiesServiceClient(cc grpc.ClientConnInterface) CopiesServiceClient {
+	return &copiesServiceClient{cc}
+}
+
+func (c *copiesServiceClient) AddCopy(ctx context.Context, in *AddCopyReq, opts ...grpc.CallOption) (*Copy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Copy)
+	err := c.cc.Invoke(ctx, CopiesService_AddCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *copiesServiceClient) ListCopies(ctx context.Context, in *ListCopiesReq, opts ...grpc.CallOption) (*CopyList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyList)
+	err := c.cc.Invoke(ctx, CopiesService_ListCopies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *copiesServiceClient) UpdateCopy(ctx context.Context, in *UpdateCopyReq, opts ...grpc.CallOption) (*Copy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Copy)
+	err := c.cc.Invoke(ctx, CopiesService_UpdateCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *copiesServiceClient) DeleteCopy(ctx context.Context, in *DeleteCopyReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CopiesService_DeleteCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CopiesServiceServer is the server API for CopiesService service.
+// All implementations must embed UnimplementedCopiesServiceServer
+// for forward compatibility.
+type CopiesServiceServer interface {
+	AddCopy(context.Context, *AddCopyReq) (*Copy, error)
+	ListCopies(context.Context, *ListCopiesReq) (*CopyList, error)
+	UpdateCopy(context.Context, *UpdateCopyReq) (*Copy, error)
+	DeleteCopy(context.Context, *DeleteCopyReq) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCopiesServiceServer()
+}
+
+// UnimplementedCopiesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCopiesServiceServer struct{}
+
+func (UnimplementedCopiesServiceServer) AddCopy(context.Context, *AddCopyReq) (*Copy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCopy not implemented")
+}
+func (UnimplementedCopiesServiceServer) ListCopies(context.Context, *ListCopiesReq) (*CopyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCopies not implemented")
+}
+func (UnimplementedCopiesServiceServer) UpdateCopy(context.Context, *UpdateCopyReq) (*Copy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCopy not implemented")
+}
+func (UnimplementedCopiesServiceServer) DeleteCopy(context.Context, *DeleteCopyReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCopy not implemented")
+}
+func (UnimplementedCopiesServiceServer) mustEmbedUnimplementedCopiesServiceServer() {}
+func (UnimplementedCopiesServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCopiesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CopiesServiceServer will
+// result in compilation errors.
+type UnsafeCopiesServiceServer interface {
+	mustEmbedUnimplementedCopiesServiceServer()
+}
+
+func RegisterCopiesServiceServer(s grpc.ServiceRegistrar, srv CopiesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCopiesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CopiesService_ServiceDesc, srv)
+}
+
+func _CopiesService_AddCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCopyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopiesServiceServer).AddCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopiesService_AddCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopiesServiceServer).AddCopy(ctx, req.(*AddCopyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CopiesService_ListCopies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCopiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopiesServiceServer).ListCopies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopiesService_ListCopies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopiesServiceServer).ListCopies(ctx, req.(*ListCopiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CopiesService_UpdateCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCopyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopiesServiceServer).UpdateCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopiesService_UpdateCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopiesServiceServer).UpdateCopy(ctx, req.(*UpdateCopyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CopiesService_DeleteCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCopyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CopiesServiceServer).DeleteCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CopiesService_DeleteCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CopiesServiceServer).DeleteCopy(ctx, req.(*DeleteCopyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CopiesService_ServiceDesc is the grpc.ServiceDesc for CopiesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CopiesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.CopiesService",
+	HandlerType: (*CopiesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCopy",
+			Handler:    _CopiesService_AddCopy_Handler,
+		},
+		{
+			MethodName: "ListCopies",
+			Handler:    _CopiesService_ListCopies_Handler,
+		},
+		{
+			MethodName: "UpdateCopy",
+			Handler:    _CopiesService_UpdateCopy_Handler,
+		},
+		{
+			MethodName: "DeleteCopy",
+			Handler:    _CopiesService_DeleteCopy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	LoansService_ListLoans_FullMethodName     = "/books.LoansService/ListLoans"
+	LoansService_ListMyLoans_FullMethodName   = "/books.LoansService/ListMyLoans"
+	LoansService_ListUserLoans_FullMethodName = "/books.LoansService/ListUserLoans"
+	LoansService_ListBookLoans_FullMethodName = "/books.LoansService/ListBookLoans"
+)
+
+// LoansServiceClient is the client API for LoansService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LoansServiceClient interface {
+	ListLoans(ctx context.Context, in *ListLoansReq, opts ...grpc.CallOption) (*LoanList, error)
+	ListMyLoans(ctx context.Context, in *ListMyLoansReq, opts ...grpc.CallOption) (*LoanList, error)
+	ListUserLoans(ctx context.Context, in *ListUserLoansReq, opts ...grpc.CallOption) (*LoanList, error)
+	ListBookLoans(ctx context.Context, in *ListBookLoansReq, opts ...grpc.CallOption) (*LoanList, error)
+}
+
+type loansServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoansServiceClient(cc grpc.ClientConnInterface) LoansServiceClient {
+	return &loansServiceClient{cc}
+}
+
+func (c *loansServiceClient) ListLoans(ctx context.Context, in *ListLoansReq, opts ...grpc.CallOption) (*LoanList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoanList)
+	err := c.cc.Invoke(ctx, LoansService_ListLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ListMyLoans(ctx context.Context, in *ListMyLoansReq, opts ...grpc.CallOption) (*LoanList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoanList)
+	err := c.cc.Invoke(ctx, LoansService_ListMyLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ListUserLoans(ctx context.Context, in *ListUserLoansReq, opts ...grpc.CallOption) (*LoanList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoanList)
+	err := c.cc.Invoke(ctx, LoansService_ListUserLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ListBookLoans(ctx context.Context, in *ListBookLoansReq, opts ...grpc.CallOption) (*LoanList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoanList)
+	err := c.cc.Invoke(ctx, LoansService_ListBookLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LoansServiceServer is the server API for LoansService service.
+// All implementations must embed UnimplementedLoansServiceServer
+// for forward compatibility.
+type LoansServiceServer interface {
+	ListLoans(context.Context, *ListLoansReq) (*LoanList, error)
+	ListMyLoans(context.Context, *ListMyLoansReq) (*LoanList, error)
+	ListUserLoans(context.Context, *ListUserLoansReq) (*LoanList, error)
+	ListBookLoans(context.Context, *ListBookLoansReq) (*LoanList, error)
+	mustEmbedUnimplementedLoansServiceServer()
+}
+
+// UnimplementedLoansServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLoansServiceServer struct{}
+
+func (UnimplementedLoansServiceServer) ListLoans(context.Context, *ListLoansReq) (*LoanList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedLoansServiceServer) ListMyLoans(context.Context, *ListMyLoansReq) (*LoanList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyLoans not implemented")
+}
+func (UnimplementedLoansServiceServer) ListUserLoans(context.Context, *ListUserLoansReq) (*LoanList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserLoans not implemented")
+}
+func (UnimplementedLoansServiceServer) ListBookLoans(context.Context, *ListBookLoansReq) (*LoanList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookLoans not implemented")
+}
+func (UnimplementedLoansServiceServer) mustEmbedUnimplementedLoansServiceServer() {}
+func (UnimplementedLoansServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeLoansServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LoansServiceServer will
+// result in compilation errors.
+type UnsafeLoansServiceServer interface {
+	mustEmbedUnimplementedLoansServiceServer()
+}
+
+func RegisterLoansServiceServer(s grpc.ServiceRegistrar, srv LoansServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLoansServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LoansService_ServiceDesc, srv)
+}
+
+func _LoansService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListLoans(ctx, req.(*ListLoansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ListMyLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyLoansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListMyLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListMyLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListMyLoans(ctx, req.(*ListMyLoansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ListUserLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserLoansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListUserLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListUserLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListUserLoans(ctx, req.(*ListUserLoansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ListBookLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookLoansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListBookLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListBookLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListBookLoans(ctx, req.(*ListBookLoansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoansService_ServiceDesc is the grpc.ServiceDesc for LoansService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoansService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.LoansService",
+	HandlerType: (*LoansServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLoans",
+			Handler:    _LoansService_ListLoans_Handler,
+		},
+		{
+			MethodName: "ListMyLoans",
+			Handler:    _LoansService_ListMyLoans_Handler,
+		},
+		{
+			MethodName: "ListUserLoans",
+			Handler:    _LoansService_ListUserLoans_Handler,
+		},
+		{
+			MethodName: "ListBookLoans",
+			Handler:    _LoansService_ListBookLoans_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	HoldsService_PlaceHold_FullMethodName     = "/books.HoldsService/PlaceHold"
+	HoldsService_ListMyHolds_FullMethodName   = "/books.HoldsService/ListMyHolds"
+	HoldsService_ListBookHolds_FullMethodName = "/books.HoldsService/ListBookHolds"
+	HoldsService_CancelHold_FullMethodName    = "/books.HoldsService/CancelHold"
+)
+
+// HoldsServiceClient is the client API for HoldsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HoldsServiceClient interface {
+	PlaceHold(ctx context.Context, in *PlaceHoldReq, opts ...grpc.CallOption) (*Hold, error)
+	ListMyHolds(ctx context.Context, in *ListMyHoldsReq, opts ...grpc.CallOption) (*HoldList, error)
+	ListBookHolds(ctx context.Context, in *ListBookHoldsReq, opts ...grpc.CallOption) (*HoldList, error)
+	CancelHold(ctx context.Context, in *CancelHoldReq, opts ...grpc.CallOption) (*Hold, error)
+}
+
+type holdsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHoldsServiceClient(cc grpc.ClientConnInterface) HoldsServiceClient {
+	return &holdsServiceClient{cc}
+}
+
+func (c *holdsServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldReq, opts ...grpc.CallOption) (*Hold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hold)
+	err := c.cc.Invoke(ctx, HoldsService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdsServiceClient) ListMyHolds(ctx context.Context, in *ListMyHoldsReq, opts ...grpc.CallOption) (*HoldList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldList)
+	err := c.cc.Invoke(ctx, HoldsService_ListMyHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdsServiceClient) ListBookHolds(ctx context.Context, in *ListBookHoldsReq, opts ...grpc.CallOption) (*HoldList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldList)
+	err := c.cc.Invoke(ctx, HoldsService_ListBookHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdsServiceClient) CancelHold(ctx context.Context, in *CancelHoldReq, opts ...grpc.CallOption) (*Hold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hold)
+	err := c.cc.Invoke(ctx, HoldsService_CancelHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HoldsServiceServer is the server API for HoldsService service.
+// All implementations must embed UnimplementedHoldsServiceServer
+// for forward compatibility.
+type HoldsServiceServer interface {
+	PlaceHold(context.Context, *PlaceHoldReq) (*Hold, error)
+	ListMyHolds(context.Context, *ListMyHoldsReq) (*HoldList, error)
+	ListBookHolds(context.Context, *ListBookHoldsReq) (*HoldList, error)
+	CancelHold(context.Context, *CancelHoldReq) (*Hold, error)
+	mustEmbedUnimplementedHoldsServiceServer()
+}
+
+// UnimplementedHoldsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHoldsServiceServer struct{}
+
+func (UnimplementedHoldsServiceServer) PlaceHold(context.Context, *PlaceHoldReq) (*Hold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedHoldsServiceServer) ListMyHolds(context.Context, *ListMyHoldsReq) (*HoldList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyHolds not implemented")
+}
+func (UnimplementedHoldsServiceServer) ListBookHolds(context.Context, *ListBookHoldsReq) (*HoldList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookHolds not implemented")
+}
+func (UnimplementedHoldsServiceServer) CancelHold(context.Context, *CancelHoldReq) (*Hold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHold not implemented")
+}
+func (UnimplementedHoldsServiceServer) mustEmbedUnimplementedHoldsServiceServer() {}
+func (UnimplementedHoldsServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeHoldsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HoldsServiceServer will
+// result in compilation errors.
+type UnsafeHoldsServiceServer interface {
+	mustEmbedUnimplementedHoldsServiceServer()
+}
+
+func RegisterHoldsServiceServer(s grpc.ServiceRegistrar, srv HoldsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHoldsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HoldsService_ServiceDesc, srv)
+}
+
+func _HoldsService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldsServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldsService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldsServiceServer).PlaceHold(ctx, req.(*PlaceHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldsService_ListMyHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyHoldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldsServiceServer).ListMyHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldsService_ListMyHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldsServiceServer).ListMyHolds(ctx, req.(*ListMyHoldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldsService_ListBookHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookHoldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldsServiceServer).ListBookHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldsService_ListBookHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldsServiceServer).ListBookHolds(ctx, req.(*ListBookHoldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldsService_CancelHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelHoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldsServiceServer).CancelHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldsService_CancelHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldsServiceServer).CancelHold(ctx, req.(*CancelHoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HoldsService_ServiceDesc is the grpc.ServiceDesc for HoldsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HoldsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.HoldsService",
+	HandlerType: (*HoldsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceHold",
+			Handler:    _HoldsService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "ListMyHolds",
+			Handler:    _HoldsService_ListMyHolds_Handler,
+		},
+		{
+			MethodName: "ListBookHolds",
+			Handler:    _HoldsService_ListBookHolds_Handler,
+		},
+		{
+			MethodName: "CancelHold",
+			Handler:    _HoldsService_CancelHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	FinesService_GetMyFines_FullMethodName    = "/books.FinesService/GetMyFines"
+	FinesService_GetUserFines_FullMethodName  = "/books.FinesService/GetUserFines"
+	FinesService_RecordPayment_FullMethodName = "/books.FinesService/RecordPayment"
+	FinesService_WaiveFine_FullMethodName     = "/books.FinesService/WaiveFine"
+)
+
+// FinesServiceClient is the client API for FinesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FinesServiceClient interface {
+	GetMyFines(ctx context.Context, in *GetMyFinesReq, opts ...grpc.CallOption) (*FineBalance, error)
+	GetUserFines(ctx context.Context, in *GetUserFinesReq, opts ...grpc.CallOption) (*FineBalance, error)
+	RecordPayment(ctx context.Context, in *SettleFineReq, opts ...grpc.CallOption) (*Fine, error)
+	WaiveFine(ctx context.Context, in *SettleFineReq, opts ...grpc.CallOption) (*Fine, error)
+}
+
+type finesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFinesServiceClient(cc grpc.ClientConnInterface) FinesServiceClient {
+	return &finesServiceClient{cc}
+}
+
+func (c *finesServiceClient) GetMyFines(ctx context.Context, in *GetMyFinesReq, opts ...grpc.CallOption) (*FineBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FineBalance)
+	err := c.cc.Invoke(ctx, FinesService_GetMyFines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finesServiceClient) GetUserFines(ctx context.Context, in *GetUserFinesReq, opts ...grpc.CallOption) (*FineBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FineBalance)
+	err := c.cc.Invoke(ctx, FinesService_GetUserFines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finesServiceClient) RecordPayment(ctx context.Context, in *SettleFineReq, opts ...grpc.CallOption) (*Fine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, FinesService_RecordPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finesServiceClient) WaiveFine(ctx context.Context, in *SettleFineReq, opts ...grpc.CallOption) (*Fine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, FinesService_WaiveFine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FinesServiceServer is the server API for FinesService service.
+// All implementations must embed UnimplementedFinesServiceServer
+// for forward compatibility.
+type FinesServiceServer interface {
+	GetMyFines(context.Context, *GetMyFinesReq) (*FineBalance, error)
+	GetUserFines(context.Context, *GetUserFinesReq) (*FineBalance, error)
+	RecordPayment(context.Context, *SettleFineReq) (*Fine, error)
+	WaiveFine(context.Context, *SettleFineReq) (*Fine, error)
+	mustEmbedUnimplementedFinesServiceServer()
+}
+
+// UnimplementedFinesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFinesServiceServer struct{}
+
+func (UnimplementedFinesServiceServer) GetMyFines(context.Context, *GetMyFinesReq) (*FineBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyFines not implemented")
+}
+func (UnimplementedFinesServiceServer) GetUserFines(context.Context, *GetUserFinesReq) (*FineBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFines not implemented")
+}
+func (UnimplementedFinesServiceServer) RecordPayment(context.Context, *SettleFineReq) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPayment not implemented")
+}
+func (UnimplementedFinesServiceServer) WaiveFine(context.Context, *SettleFineReq) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaiveFine not implemented")
+}
+func (UnimplementedFinesServiceServer) mustEmbedUnimplementedFinesServiceServer() {}
+func (UnimplementedFinesServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeFinesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinesServiceServer will
+// result in compilation errors.
+type UnsafeFinesServiceServer interface {
+	mustEmbedUnimplementedFinesServiceServer()
+}
+
+func RegisterFinesServiceServer(s grpc.ServiceRegistrar, srv FinesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFinesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FinesService_ServiceDesc, srv)
+}
+
+func _FinesService_GetMyFines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyFinesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinesServiceServer).GetMyFines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinesService_GetMyFines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinesServiceServer).GetMyFines(ctx, req.(*GetMyFinesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinesService_GetUserFines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFinesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinesServiceServer).GetUserFines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinesService_GetUserFines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinesServiceServer).GetUserFines(ctx, req.(*GetUserFinesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinesService_RecordPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleFineReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinesServiceServer).RecordPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinesService_RecordPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinesServiceServer).RecordPayment(ctx, req.(*SettleFineReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinesService_WaiveFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleFineReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinesServiceServer).WaiveFine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinesService_WaiveFine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinesServiceServer).WaiveFine(ctx, req.(*SettleFineReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FinesService_ServiceDesc is the grpc.ServiceDesc for FinesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FinesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.FinesService",
+	HandlerType: (*FinesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMyFines",
+			Handler:    _FinesService_GetMyFines_Handler,
+		},
+		{
+			MethodName: "GetUserFines",
+			Handler:    _FinesService_GetUserFines_Handler,
+		},
+		{
+			MethodName: "RecordPayment",
+			Handler:    _FinesService_RecordPayment_Handler,
+		},
+		{
+			MethodName: "WaiveFine",
+			Handler:    _FinesService_WaiveFine_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
+
+const (
+	CoversService_UpdateBookCover_FullMethodName = "/books.CoversService/UpdateBookCover"
+	CoversService_DeleteBookCover_FullMethodName = "/books.CoversService/DeleteBookCover"
+	CoversService_GetBookCover_FullMethodName    = "/books.CoversService/GetBookCover"
+)
+
+// CoversServiceClient is the client API for CoversService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CoversServiceClient interface {
+	UpdateBookCover(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UpdateBookCoverReq, Book], error)
+	DeleteBookCover(ctx context.Context, in *DeleteBookCoverReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBookCover(ctx context.Context, in *GetBookCoverReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CoverChunk], error)
+}
+
+type coversServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoversServiceClient(cc grpc.ClientConnInterface) CoversServiceClient {
+	return &coversServiceClient{cc}
+}
+
+func (c *coversServiceClient) UpdateBookCover(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UpdateBookCoverReq, Book], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CoversService_ServiceDesc.Streams[0], CoversService_UpdateBookCover_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UpdateBookCoverReq, Book]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoversService_UpdateBookCoverClient = grpc.ClientStreamingClient[UpdateBookCoverReq, Book]
+
+func (c *coversServiceClient) DeleteBookCover(ctx context.Context, in *DeleteBookCoverReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CoversService_DeleteBookCover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coversServiceClient) GetBookCover(ctx context.Context, in *GetBookCoverReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CoverChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CoversService_ServiceDesc.Streams[1], CoversService_GetBookCover_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetBookCoverReq, CoverChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoversService_GetBookCoverClient = grpc.ServerStreamingClient[CoverChunk]
+
+// CoversServiceServer is the server API for CoversService service.
+// All implementations must embed UnimplementedCoversServiceServer
+// for forward compatibility.
+type CoversServiceServer interface {
+	UpdateBookCover(grpc.ClientStreamingServer[UpdateBookCoverReq, Book]) error
+	DeleteBookCover(context.Context, *DeleteBookCoverReq) (*emptypb.Empty, error)
+	GetBookCover(*GetBookCoverReq, grpc.ServerStreamingServer[CoverChunk]) error
+	mustEmbedUnimplementedCoversServiceServer()
+}
+
+// UnimplementedCoversServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoversServiceServer struct{}
+
+func (UnimplementedCoversServiceServer) UpdateBookCover(grpc.ClientStreamingServer[UpdateBookCoverReq, Book]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateBookCover not implemented")
+}
+func (UnimplementedCoversServiceServer) DeleteBookCover(context.Context, *DeleteBookCoverReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBookCover not implemented")
+}
+func (UnimplementedCoversServiceServer) GetBookCover(*GetBookCoverReq, grpc.ServerStreamingServer[CoverChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method GetBookCover not implemented")
+}
+func (UnimplementedCoversServiceServer) mustEmbedUnimplementedCoversServiceServer() {}
+func (UnimplementedCoversServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCoversServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoversServiceServer will
+// result in compilation errors.
+type UnsafeCoversServiceServer interface {
+	mustEmbedUnimplementedCoversServiceServer()
+}
+
+func RegisterCoversServiceServer(s grpc.ServiceRegistrar, srv CoversServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCoversServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoversService_ServiceDesc, srv)
+}
+
+func _CoversService_UpdateBookCover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CoversServiceServer).UpdateBookCover(&grpc.GenericServerStream[UpdateBookCoverReq, Book]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoversService_UpdateBookCoverServer = grpc.ClientStreamingServer[UpdateBookCoverReq, Book]
+
+func _CoversService_DeleteBookCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookCoverReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoversServiceServer).DeleteBookCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoversService_DeleteBookCover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoversServiceServer).DeleteBookCover(ctx, req.(*DeleteBookCoverReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoversService_GetBookCover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBookCoverReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoversServiceServer).GetBookCover(m, &grpc.GenericServerStream[GetBookCoverReq, CoverChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoversService_GetBookCoverServer = grpc.ServerStreamingServer[CoverChunk]
+
+// CoversService_ServiceDesc is the grpc.ServiceDesc for CoversService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoversService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.CoversService",
+	HandlerType: (*CoversServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteBookCover",
+			Handler:    _CoversService_DeleteBookCover_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UpdateBookCover",
+			Handler:       _CoversService_UpdateBookCover_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetBookCover",
+			Handler:       _CoversService_GetBookCover_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "books.proto",
+}
+
+const (
+	CatalogueService_ImportBooks_FullMethodName = "/books.CatalogueService/ImportBooks"
+	CatalogueService_ImportMarc_FullMethodName  = "/books.CatalogueService/ImportMarc"
+	CatalogueService_ExportBooks_FullMethodName = "/books.CatalogueService/ExportBooks"
+	CatalogueService_ExportMarc_FullMethodName  = "/books.CatalogueService/ExportMarc"
+)
+
+// CatalogueServiceClient is the client API for CatalogueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CatalogueServiceClient interface {
+	ImportBooks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReq, ImportReport], error)
+	ImportMarc(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReq, ImportReport], error)
+	ExportBooks(ctx context.Context, in *ExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error)
+	ExportMarc(ctx context.Context, in *ExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error)
+}
+
+type catalogueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogueServiceClient(cc grpc.ClientConnInterface) CatalogueServiceClient {
+	return &catalogueServiceClient{cc}
+}
+
+func (c *catalogueServiceClient) ImportBooks(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReq, ImportReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CatalogueService_ServiceDesc.Streams[0], CatalogueService_ImportBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportReq, ImportReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ImportBooksClient = grpc.ClientStreamingClient[ImportReq, ImportReport]
+
+func (c *catalogueServiceClient) ImportMarc(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReq, ImportReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CatalogueService_ServiceDesc.Streams[1], CatalogueService_ImportMarc_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportReq, ImportReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ImportMarcClient = grpc.ClientStreamingClient[ImportReq, ImportReport]
+
+func (c *catalogueServiceClient) ExportBooks(ctx context.Context, in *ExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CatalogueService_ServiceDesc.Streams[2], CatalogueService_ExportBooks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReq, FileChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ExportBooksClient = grpc.ServerStreamingClient[FileChunk]
+
+func (c *catalogueServiceClient) ExportMarc(ctx context.Context, in *ExportReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CatalogueService_ServiceDesc.Streams[3], CatalogueService_ExportMarc_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReq, FileChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ExportMarcClient = grpc.ServerStreamingClient[FileChunk]
+
+// CatalogueServiceServer is the server API for CatalogueService service.
+// All implementations must embed UnimplementedCatalogueServiceServer
+// for forward compatibility.
+type CatalogueServiceServer interface {
+	ImportBooks(grpc.ClientStreamingServer[ImportReq, ImportReport]) error
+	ImportMarc(grpc.ClientStreamingServer[ImportReq, ImportReport]) error
+	ExportBooks(*ExportReq, grpc.ServerStreamingServer[FileChunk]) error
+	ExportMarc(*ExportReq, grpc.ServerStreamingServer[FileChunk]) error
+	mustEmbedUnimplementedCatalogueServiceServer()
+}
+
+// UnimplementedCatalogueServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCatalogueServiceServer struct{}
+
+func (UnimplementedCatalogueServiceServer) ImportBooks(grpc.ClientStreamingServer[ImportReq, ImportReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBooks not implemented")
+}
+func (UnimplementedCatalogueServiceServer) ImportMarc(grpc.ClientStreamingServer[ImportReq, ImportReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportMarc not implemented")
+}
+func (UnimplementedCatalogueServiceServer) ExportBooks(*ExportReq, grpc.ServerStreamingServer[FileChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBooks not implemented")
+}
+func (UnimplementedCatalogueServiceServer) ExportMarc(*ExportReq, grpc.ServerStreamingServer[FileChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMarc not implemented")
+}
+func (UnimplementedCatalogueServiceServer) mustEmbedUnimplementedCatalogueServiceServer() {}
+func (UnimplementedCatalogueServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeCatalogueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CatalogueServiceServer will
+// result in compilation errors.
+type UnsafeCatalogueServiceServer interface {
+	mustEmbedUnimplementedCatalogueServiceServer()
+}
+
+func RegisterCatalogueServiceServer(s grpc.ServiceRegistrar, srv CatalogueServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCatalogueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CatalogueService_ServiceDesc, srv)
+}
+
+func _CatalogueService_ImportBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CatalogueServiceServer).ImportBooks(&grpc.GenericServerStream[ImportReq, ImportReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ImportBooksServer = grpc.ClientStreamingServer[ImportReq, ImportReport]
+
+func _CatalogueService_ImportMarc_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CatalogueServiceServer).ImportMarc(&grpc.GenericServerStream[ImportReq, ImportReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ImportMarcServer = grpc.ClientStreamingServer[ImportReq, ImportReport]
+
+func _CatalogueService_ExportBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CatalogueServiceServer).ExportBooks(m, &grpc.GenericServerStream[ExportReq, FileChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ExportBooksServer = grpc.ServerStreamingServer[FileChunk]
+
+func _CatalogueService_ExportMarc_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CatalogueServiceServer).ExportMarc(m, &grpc.GenericServerStream[ExportReq, FileChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CatalogueService_ExportMarcServer = grpc.ServerStreamingServer[FileChunk]
+
+// CatalogueService_ServiceDesc is the grpc.ServiceDesc for CatalogueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CatalogueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.CatalogueService",
+	HandlerType: (*CatalogueServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportBooks",
+			Handler:       _CatalogueService_ImportBooks_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImportMarc",
+			Handler:       _CatalogueService_ImportMarc_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportBooks",
+			Handler:       _CatalogueService_ExportBooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportMarc",
+			Handler:       _CatalogueService_ExportMarc_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "books.proto",
+}
+
+const (
+	ProtocolService_Harvest_FullMethodName        = "/books.ProtocolService/Harvest"
+	ProtocolService_SearchRetrieve_FullMethodName = "/books.ProtocolService/SearchRetrieve"
+)
+
+// ProtocolServiceClient is the client API for ProtocolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProtocolService speaks the metadata protocols partners harvest and search the catalogue with:
+// OAI-PMH 2.0 and SRU 2.0.
+type ProtocolServiceClient interface {
+	Harvest(ctx context.Context, in *ProtocolReq, opts ...grpc.CallOption) (*Document, error)
+	SearchRetrieve(ctx context.Context, in *ProtocolReq, opts ...grpc.CallOption) (*Document, error)
+}
+
+type protocolServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProtocolServiceClient(cc grpc.ClientConnInterface) ProtocolServiceClient {
+	return &protocolServiceClient{cc}
+}
+
+func (c *protocolServiceClient) Harvest(ctx context.Context, in *ProtocolReq, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, ProtocolService_Harvest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *protocolServiceClient) SearchRetrieve(ctx context.Context, in *ProtocolReq, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, ProtocolService_SearchRetrieve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProtocolServiceServer is the server API for ProtocolService service.
+// All implementations must embed UnimplementedProtocolServiceServer
+// for forward compatibility.
+//
+// ProtocolService speaks the metadata protocols partners harvest and search the catalogue with:
+// OAI-PMH 2.0 and SRU 2.0.
+type ProtocolServiceServer interface {
+	Harvest(context.Context, *ProtocolReq) (*Document, error)
+	SearchRetrieve(context.Context, *ProtocolReq) (*Document, error)
+	mustEmbedUnimplementedProtocolServiceServer()
+}
+
+// UnimplementedProtocolServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProtocolServiceServer struct{}
+
+func (UnimplementedProtocolServiceServer) Harvest(context.Context, *ProtocolReq) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
+}
+func (UnimplementedProtocolServiceServer) SearchRetrieve(context.Context, *ProtocolReq) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRetrieve not implemented")
+}
+func (UnimplementedProtocolServiceServer) mustEmbedUnimplementedProtocolServiceServer() {}
+func (UnimplementedProtocolServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeProtocolServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProtocolServiceServer will
+// result in compilation errors.
+type UnsafeProtocolServiceServer interface {
+	mustEmbedUnimplementedProtocolServiceServer()
+}
+
+func RegisterProtocolServiceServer(s grpc.ServiceRegistrar, srv ProtocolServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProtocolServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProtocolService_ServiceDesc, srv)
+}
+
+func _ProtocolService_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtocolReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).Harvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtocolService_Harvest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).Harvest(ctx, req.(*ProtocolReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_SearchRetrieve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtocolReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).SearchRetrieve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProtocolService_SearchRetrieve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).SearchRetrieve(ctx, req.(*ProtocolReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProtocolService_ServiceDesc is the grpc.ServiceDesc for ProtocolService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProtocolService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "books.ProtocolService",
+	HandlerType: (*ProtocolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Harvest",
+			Handler:    _ProtocolService_Harvest_Handler,
+		},
+		{
+			MethodName: "SearchRetrieve",
+			Handler:    _ProtocolService_SearchRetrieve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "books.proto",
+}
@@ -0,0 +1,3 @@
+package books
+
+//go:generate make -C ../../.. proto-books
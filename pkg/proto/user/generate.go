@@ -0,0 +1,3 @@
+package user
+
+//go:generate make -C ../../.. proto-user
@@ -8,6 +8,9 @@ import (
 	"library-management-api/users-service/core/ports"
 	"library-management-api/users-service/init/database"
 	"library-management-api/util/errorhandler"
+	"library-management-api/util/listquery"
+	"strconv"
+	"time"
 )
 
 type UserRepository struct {
@@ -38,13 +41,58 @@ func (u *UserRepository) AddUser(ctx context.Context, user domain.User) (domain.
 	return res, nil
 }
 
+// userSorting lists the columns users can be sorted by.
+var userSorting = listquery.Sorting{
+	Columns: map[string]listquery.Column{
+		"id":         {Expr: "u.id", Cast: "int"},
+		"username":   {Expr: "u.username", Cast: "text"},
+		"created_at": {Expr: "COALESCE(u.created_at, 'epoch')", Cast: "timestamptz"},
+	},
+	Default: "id",
+	ID:      "u.id",
+}
+
+// userSortValue returns the value a user is sorted by, as stored in a cursor.
+func userSortValue(user User, sort string) string {
+	switch sort {
+	case "username":
+		return user.Username.String
+	case "created_at":
+		if !user.CreatedAt.Valid {
+			return time.Unix(0, 0).UTC().Format(time.RFC3339Nano)
+		}
+		return user.CreatedAt.Time.Format(time.RFC3339Nano)
+	}
+	return strconv.FormatUint(uint64(user.ID), 10)
+}
+
 // GetUsers implements ports.UserRepository.
-func (u *UserRepository) GetUsers(ctx context.Context) ([]domain.User, error) {
+func (u *UserRepository) GetUsers(ctx context.Context, filter domain.UserFilter, query listquery.Query) (listquery.Page[domain.User], error) {
+	if query.Sort == "" {
+		query.Sort = userSorting.Default
+	}
+
+	var qb listquery.Builder
+	if filter.IsAdmin != nil {
+		qb.Where("COALESCE(u.is_admin, FALSE)=" + qb.Arg(*filter.IsAdmin))
+	}
+
+	pageClause, pageArgs, err := qb.Page(query, userSorting)
+	if err != nil {
+		return listquery.Page[domain.User]{}, err
+	}
+
+	var total int
+	filterClause, filterArgs := qb.Filter()
+	err = u.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users u"+filterClause, filterArgs...).Scan(&total)
+	if err != nil {
+		return listquery.Page[domain.User]{}, err
+	}
+
 	var users []User
-	query := "SELECT * FROM users"
-	rows, err := u.db.Query(query)
+	rows, err := u.db.QueryContext(ctx, "SELECT u.id, u.username, u.hashed_password, u.email, u.is_admin, u.created_at FROM users u"+pageClause, pageArgs...)
 	if err != nil {
-		return []domain.User{}, err
+		return listquery.Page[domain.User]{}, err
 	}
 	defer rows.Close()
 
@@ -52,12 +100,24 @@ func (u *UserRepository) GetUsers(ctx context.Context) ([]domain.User, error) {
 		var user User
 		err := rows.Scan(&user.ID, &user.Username, &user.HashedPassword, &user.Email, &user.IsAdmin, &user.CreatedAt)
 		if err != nil {
-			return []domain.User{}, err
+			return listquery.Page[domain.User]{}, err
 		}
 		users = append(users, user)
 	}
-	res := MapUsersEntityToUsersDomain(users)
-	return res, nil
+	if err := rows.Err(); err != nil {
+		return listquery.Page[domain.User]{}, err
+	}
+	users, more := listquery.Trim(users, query)
+
+	page := listquery.Page[domain.User]{
+		Items: MapUsersEntityToUsersDomain(users),
+		Total: total,
+	}
+	if more {
+		last := users[len(users)-1]
+		page.NextCursor = listquery.Cursor{Value: userSortValue(last, query.Sort), ID: last.ID}.Encode()
+	}
+	return page, nil
 }
 
 // GetUserByID implements ports.UserRepository.
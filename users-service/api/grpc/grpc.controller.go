@@ -4,6 +4,8 @@ import (
 	"context"
 	"library-management-api/pkg/proto/user"
 	"library-management-api/users-service/core/usecase"
+
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type UserController struct {
@@ -24,3 +26,42 @@ func (c *UserController) GetUserByUsername(ctx context.Context, req *user.GetUse
 	}
 	return MapDomainAuthToProtoUserRes(res), nil
 }
+
+func (c *UserController) AddUser(ctx context.Context, req *user.AddUserReq) (*user.User, error) {
+	res, err := c.userUseCase.AddUser(ctx, MapProtoAddUserReqToDomainUser(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainUserToProtoUser(res), nil
+}
+
+func (c *UserController) ListUsers(ctx context.Context, req *user.ListUsersReq) (*user.UserPage, error) {
+	res, err := c.userUseCase.GetUsers(ctx, MapProtoListUsersReqToDomainUserFilter(req), MapProtoListQueryToListQuery(req.GetList()))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainUserPageToProtoUserPage(res), nil
+}
+
+func (c *UserController) GetUserByID(ctx context.Context, req *user.GetUserByIDReq) (*user.User, error) {
+	res, err := c.userUseCase.GetUserByID(ctx, MapProtoGetUserByIDReqToDomainUser(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainUserToProtoUser(res), nil
+}
+
+func (c *UserController) UpdateUser(ctx context.Context, req *user.UpdateUserReq) (*user.User, error) {
+	res, err := c.userUseCase.UpdateUser(ctx, MapProtoUpdateUserReqToDomainUser(req))
+	if err != nil {
+		return nil, err
+	}
+	return MapDomainUserToProtoUser(res), nil
+}
+
+func (c *UserController) DeleteUser(ctx context.Context, req *user.DeleteUserReq) (*emptypb.Empty, error) {
+	if err := c.userUseCase.DeleteUser(ctx, MapProtoDeleteUserReqToDomainUser(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
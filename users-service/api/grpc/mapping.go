@@ -4,6 +4,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"library-management-api/pkg/proto/user"
 	"library-management-api/users-service/core/domain"
+	"library-management-api/util/listquery"
 )
 
 func MapProtoGetUserReqToDomainAuth(req *user.GetUserReq) domain.User {
@@ -22,3 +23,75 @@ func MapDomainAuthToProtoUserRes(res domain.User) *user.UserRes {
 		CreatedAt: timestamppb.New(res.CreatedAt),
 	}
 }
+
+// MapDomainUserToProtoUser leaves out the password hash, which only the auth service is given.
+func MapDomainUserToProtoUser(res domain.User) *user.User {
+	return &user.User{
+		Id:        int32(res.ID),
+		Username:  res.Username,
+		Email:     res.Email,
+		IsAdmin:   res.IsAdmin,
+		CreatedAt: timestamppb.New(res.CreatedAt),
+	}
+}
+
+func MapDomainUserPageToProtoUserPage(page listquery.Page[domain.User]) *user.UserPage {
+	res := &user.UserPage{
+		Total:      int32(page.Total),
+		NextCursor: page.NextCursor,
+	}
+	for _, u := range page.Items {
+		res.Users = append(res.Users, MapDomainUserToProtoUser(u))
+	}
+	return res
+}
+
+func MapProtoListUsersReqToDomainUserFilter(req *user.ListUsersReq) domain.UserFilter {
+	var filter domain.UserFilter
+	if req.GetIsAdmin() != nil {
+		isAdmin := req.GetIsAdmin().GetValue()
+		filter.IsAdmin = &isAdmin
+	}
+	return filter
+}
+
+func MapProtoListQueryToListQuery(query *user.ListQuery) listquery.Query {
+	return listquery.Query{
+		Limit:  int(query.GetLimit()),
+		Page:   int(query.GetPage()),
+		Cursor: query.GetCursor(),
+		Sort:   query.GetSort(),
+		Order:  listquery.Order(query.GetOrder()),
+	}
+}
+
+func MapProtoAddUserReqToDomainUser(req *user.AddUserReq) domain.User {
+	return domain.User{
+		Username: req.Username,
+		Password: req.Password,
+		Email:    req.Email,
+		IsAdmin:  req.IsAdmin,
+	}
+}
+
+func MapProtoGetUserByIDReqToDomainUser(req *user.GetUserByIDReq) domain.User {
+	return domain.User{
+		ID: uint(req.Id),
+	}
+}
+
+func MapProtoUpdateUserReqToDomainUser(req *user.UpdateUserReq) domain.User {
+	return domain.User{
+		ID:       uint(req.Id),
+		Username: req.Username,
+		Password: req.Password,
+		Email:    req.Email,
+		IsAdmin:  req.IsAdmin,
+	}
+}
+
+func MapProtoDeleteUserReqToDomainUser(req *user.DeleteUserReq) domain.User {
+	return domain.User{
+		ID: uint(req.Id),
+	}
+}
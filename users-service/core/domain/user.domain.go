@@ -10,3 +10,8 @@ type User struct {
 	IsAdmin   bool   
 	CreatedAt time.Time 
 }
+
+// UserFilter narrows a list of users. Criteria left at their zero value are not applied.
+type UserFilter struct {
+	IsAdmin *bool
+}
@@ -3,11 +3,12 @@ package ports
 import (
 	"context"
 	"library-management-api/users-service/core/domain"
+	"library-management-api/util/listquery"
 )
 
 type UserRepository interface {
 	AddUser(ctx context.Context, user domain.User) (domain.User, error)
-	GetUsers(ctx context.Context) ([]domain.User, error)
+	GetUsers(ctx context.Context, filter domain.UserFilter, query listquery.Query) (listquery.Page[domain.User], error)
 	GetUserByID(ctx context.Context, user domain.User) (domain.User, error)
 	GetUserByUsername(ctx context.Context, user domain.User) (domain.User, error)
 	UpdateUser(ctx context.Context, user domain.User) (domain.User, error)
@@ -2,11 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"library-management-api/users-service/adapter/repository"
 	"library-management-api/users-service/adapter/service/auth"
 	"library-management-api/users-service/core/domain"
 	"library-management-api/users-service/core/ports"
 	"library-management-api/util/errorhandler"
+	"library-management-api/util/listquery"
 )
 
 type UserUseCase struct {
@@ -39,10 +41,10 @@ func (u *UserUseCase) AddUser(ctx context.Context, user domain.User) (domain.Use
 }
 
 // GetUsers handles logic for retrieving all users
-func (u *UserUseCase) GetUsers(ctx context.Context) ([]domain.User, error) {
+func (u *UserUseCase) GetUsers(ctx context.Context, filter domain.UserFilter, query listquery.Query) (listquery.Page[domain.User], error) {
 	contextToken, ok := ctx.Value("token").(string)
 	if !ok {
-		return []domain.User{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.User]{}, errorhandler.ErrInvalidSession
 	}
 
 	verifyTokenReq := domain.Auth{
@@ -50,17 +52,22 @@ func (u *UserUseCase) GetUsers(ctx context.Context) ([]domain.User, error) {
 	}
 	verifyTokenRes, err := u.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return []domain.User{}, errorhandler.ErrInvalidSession
+		return listquery.Page[domain.User]{}, sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
 	if !claims.IsAdmin {
-		return []domain.User{}, errorhandler.ErrForbidden
+		return listquery.Page[domain.User]{}, errorhandler.ErrForbidden
 	}
 
-	users, err := u.userRepository.GetUsers(ctx)
+	query, err = query.Normalize()
 	if err != nil {
-		return nil, err
+		return listquery.Page[domain.User]{}, err
+	}
+
+	users, err := u.userRepository.GetUsers(ctx, filter, query)
+	if err != nil {
+		return listquery.Page[domain.User]{}, err
 	}
 	return users, nil
 }
@@ -77,7 +84,7 @@ func (u *UserUseCase) GetUserByID(ctx context.Context, user domain.User) (domain
 	}
 	verifyTokenRes, err := u.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.User{}, errorhandler.ErrInvalidSession
+		return domain.User{}, sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
@@ -113,7 +120,7 @@ func (u *UserUseCase) UpdateUser(ctx context.Context, user domain.User) (domain.
 	}
 	verifyTokenRes, err := u.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return domain.User{}, errorhandler.ErrInvalidSession
+		return domain.User{}, sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
@@ -148,7 +155,7 @@ func (u *UserUseCase) DeleteUser(ctx context.Context, user domain.User) error {
 	}
 	verifyTokenRes, err := u.authService.VerifyToken(ctx, verifyTokenReq)
 	if err != nil {
-		return errorhandler.ErrInvalidSession
+		return sessionError(err)
 	}
 	claims := verifyTokenRes.Claims
 
@@ -162,3 +169,12 @@ func (u *UserUseCase) DeleteUser(ctx context.Context, user domain.User) error {
 	}
 	return nil
 }
+
+// sessionError is the error of a use case whose token could not be verified. A token is only
+// reported as invalid when the auth service could be asked.
+func sessionError(err error) error {
+	if errors.Is(err, errorhandler.ErrAuthUnavailable) {
+		return errorhandler.ErrAuthUnavailable
+	}
+	return errorhandler.ErrInvalidSession
+}
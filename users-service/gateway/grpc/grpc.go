@@ -5,16 +5,18 @@ import (
 	"google.golang.org/grpc"
 	"library-management-api/pkg/proto/user"
 	grpcController "library-management-api/users-service/api/grpc"
+	"library-management-api/users-service/configs"
+	"library-management-api/util/grpcconn"
 	"net"
 )
 
 func RunGRPC() {
-	lis, err := net.Listen("tcp", ":8082")
+	lis, err := net.Listen("tcp", configs.C().GRPC.Address)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to listen")
 	}
 
-	srv := grpc.NewServer()
+	srv := grpc.NewServer(grpcconn.ServerOptions(configs.C().GRPC.UnaryTimeout, configs.C().GRPC.StreamIdleTimeout)...)
 	userController := grpcController.NewUserController()
 	user.RegisterUsersServiceServer(srv, userController)
 
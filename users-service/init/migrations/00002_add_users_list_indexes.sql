@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyset pagination orders by the sort column and breaks ties on id.
+CREATE INDEX users_username_id_idx ON users (username, id);
+CREATE INDEX users_created_at_id_idx ON users ((COALESCE(created_at, 'epoch')), id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS users_created_at_id_idx;
+DROP INDEX IF EXISTS users_username_id_idx;
+-- +goose StatementEnd
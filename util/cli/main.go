@@ -25,6 +25,7 @@ func main() {
 	rootCmd.AddCommand(apiGatewayCmd)
 	rootCmd.AddCommand(authServiceCmd)
 	rootCmd.AddCommand(usersServiceCmd)
+	rootCmd.AddCommand(booksServiceCmd)
 
 	// Execute the root command
 	if err := rootCmd.Execute(); err != nil {
@@ -62,6 +63,16 @@ var usersServiceCmd = &cobra.Command{
 	},
 }
 
+// Define the Books Service command
+var booksServiceCmd = &cobra.Command{
+	Use:   "books-service",
+	Short: "Run the Books Service",
+	Run: func(cmd *cobra.Command, args []string) {
+		log.Info().Msg("Starting Books Service...")
+		runService("./books-service/cmd/main.go")
+	},
+}
+
 // Helper function to run a Go service
 func runService(path string) {
 	cmd := exec.Command("go", "run", path)
@@ -24,9 +24,107 @@ var (
 	ErrBookAlreadyBorrowed  = errors.New("book is already borrowed")
 	ErrBookAlreadyAvailable = errors.New("book is already available")
 	ErrBorrowerIDMismatch   = errors.New("borrower ID does not match")
-	ErrInvalidCategoryType  = errors.New("invalid category type: must be one of 'subject' or 'genre'")
+	ErrInvalidCategoryType  = errors.New("invalid category type: must be one of 'subject', 'genre' or 'tag'")
 	ErrEmptyCategoryValue   = errors.New("category value cannot be empty")
 	ErrInvalidSearchQuery   = errors.New("at least one of the fields must be provided")
+	ErrEmptySuggestPrefix   = errors.New("prefix cannot be empty")
+	ErrInvalidISBN          = errors.New("invalid ISBN")
+	ErrISBNMismatch         = errors.New("isbn_10 and isbn_13 identify different books")
+	ErrDuplicateISBN        = errors.New("a book with this ISBN already exists")
+	ErrBookAuthorRequired   = errors.New("a book needs at least one author")
+	ErrInvalidBookFormat    = errors.New("invalid format: must be one of 'hardcover', 'paperback', 'ebook' or 'audiobook'")
+	ErrSeriesRequired       = errors.New("a series volume needs a series")
+	ErrBookHasLoans         = errors.New("book has loans on record and cannot be deleted")
+)
+
+var (
+	ErrWorkNotFound      = errors.New("work not found")
+	ErrWorkTitleRequired = errors.New("a work needs a title")
+	ErrSeriesNotFound    = errors.New("series not found")
+)
+
+var (
+	ErrAuthorNotFound    = errors.New("author not found")
+	ErrDuplicateAuthor   = errors.New("an author with this name already exists")
+	ErrInvalidAuthorName = errors.New("author name must contain letters or digits and no semicolons")
+	ErrAuthorHasBooks    = errors.New("author is still credited with books")
+)
+
+var (
+	ErrSubjectNotFound  = errors.New("subject not found")
+	ErrDuplicateSubject = errors.New("a subject with this name already exists")
+	ErrUnknownSubject   = errors.New("subject is not in the subject vocabulary")
+	ErrSubjectCycle     = errors.New("a subject cannot be placed under itself or a narrower subject")
+	ErrParentNotFound   = errors.New("parent subject not found")
+	ErrSubjectInUse     = errors.New("subject is still used by books or has narrower subjects")
+	ErrGenreNotFound    = errors.New("genre not found")
+	ErrDuplicateGenre   = errors.New("a genre or genre synonym with this name already exists")
+	ErrUnknownGenre     = errors.New("genre is not in the genre vocabulary")
+	ErrGenreInUse       = errors.New("genre is still used by books")
+	ErrInvalidTermName  = errors.New("term name cannot be empty")
+	ErrMergeIntoSelf    = errors.New("a term cannot be merged into itself")
+)
+
+var (
+	ErrTagNotFound    = errors.New("tag not found")
+	ErrDuplicateTag   = errors.New("a tag with this name already exists")
+	ErrInvalidTagName = errors.New("tag name cannot be empty")
+)
+
+var (
+	ErrCoverNotFound        = errors.New("book has no cover")
+	ErrCoverTooLarge        = errors.New("cover image is too large")
+	ErrUnsupportedCoverType = errors.New("unsupported cover image: must be a JPEG, PNG or GIF")
+	ErrInvalidCover         = errors.New("cover image is malformed")
+	ErrFileNotFound         = errors.New("file not found")
+)
+
+var (
+	ErrCopyNotFound      = errors.New("copy not found")
+	ErrDuplicateBarcode  = errors.New("barcode already exists")
+	ErrInvalidCopyStatus = errors.New("invalid copy status: must be one of 'available', 'maintenance' or 'lost'")
+	ErrCopyBorrowed      = errors.New("copy is currently borrowed")
+	ErrCopyOnHold        = errors.New("copy is reserved on the hold shelf")
+)
+
+var (
+	ErrLoanNotFound        = errors.New("loan not found")
+	ErrLoanLimitReached    = errors.New("maximum number of active loans reached")
+	ErrRenewalLimitReached = errors.New("maximum number of renewals reached")
+)
+
+var (
+	ErrHoldNotFound  = errors.New("hold not found")
+	ErrDuplicateHold = errors.New("you already have an open hold on this book")
+	ErrHoldNotNeeded = errors.New("book has copies available to borrow")
+	ErrHoldNotOpen   = errors.New("hold is no longer open")
+	ErrBookOnHold    = errors.New("book is on hold for another patron")
+	ErrHoldOnOwnLoan = errors.New("you already have this book borrowed")
+)
+
+var (
+	ErrOutstandingFines   = errors.New("outstanding fines exceed the borrowing limit")
+	ErrInvalidFineAmount  = errors.New("amount must be positive")
+	ErrFineExceedsBalance = errors.New("amount exceeds the outstanding balance")
+)
+
+var (
+	ErrInvalidImportFormat = errors.New("unsupported import format: must be one of 'csv' or 'ndjson'")
+	ErrInvalidImportFile   = errors.New("import file is malformed")
+	ErrImportTooLarge      = errors.New("import file is too large")
+	ErrInvalidMarcFormat   = errors.New("unsupported MARC format: must be one of 'iso2709' or 'marcxml'")
+	ErrInvalidMarcRecord   = errors.New("invalid MARC record")
+	ErrInvalidExportFormat = errors.New("unsupported export format: must be one of 'csv', 'ndjson' or 'dc'")
+)
+
+var (
+	ErrInvalidListQuery     = errors.New("invalid list query")
+	ErrInvalidBookCondition = errors.New("invalid search condition")
+)
+
+var (
+	ErrServiceUnavailable = errors.New("service is unavailable")
+	ErrAuthUnavailable    = errors.New("auth service is unavailable")
 )
 
 func ErrorResponse(status int, err error) gin.H {
@@ -0,0 +1,115 @@
+// Package grpcconn manages the gRPC connections between the services. Every process keeps a
+// single long-lived connection per peer, shared by all its clients, which balances the calls
+// round-robin across the addresses of the peer and keeps idle connections alive.
+package grpcconn
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// minKeepaliveTime is the shortest ping interval servers accept from clients, and the shortest
+// one gRPC lets clients use.
+const minKeepaliveTime = 10 * time.Second
+
+// Peer describes how to reach another service. Policy bounds the calls of the clients that
+// apply it and is validated by them.
+type Peer struct {
+	Addresses        []string      `mapstructure:"addresses"`
+	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
+	KeepaliveTime    time.Duration `mapstructure:"keepalive_time"`
+	KeepaliveTimeout time.Duration `mapstructure:"keepalive_timeout"`
+	Policy           Policy        `mapstructure:"policy"`
+}
+
+// Validate ensures that the peer named name can be dialed and kept alive.
+func (p Peer) Validate(name string) error {
+	if len(p.Addresses) == 0 {
+		return fmt.Errorf("%s address is required", name)
+	}
+	for _, address := range p.Addresses {
+		if strings.TrimSpace(address) == "" {
+			return fmt.Errorf("%s addresses cannot be empty", name)
+		}
+	}
+	if p.DialTimeout <= 0 {
+		return fmt.Errorf("%s dial timeout must be positive", name)
+	}
+	if p.KeepaliveTime < minKeepaliveTime {
+		return fmt.Errorf("%s keepalive time must be at least %s", name, minKeepaliveTime)
+	}
+	if p.KeepaliveTimeout <= 0 {
+		return fmt.Errorf("%s keepalive timeout must be positive", name)
+	}
+	return nil
+}
+
+var (
+	mu    sync.Mutex
+	conns = map[string]*grpc.ClientConn{}
+)
+
+// Conn returns the connection to the peer named name, creating it on first use. The connection
+// is established lazily and re-established on failure, so a peer that is down or restarting
+// only fails the calls made in the meantime.
+func Conn(name string, peer Peer) (*grpc.ClientConn, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if conn, ok := conns[name]; ok {
+		return conn, nil
+	}
+
+	addresses := make([]resolver.Address, 0, len(peer.Addresses))
+	for _, address := range peer.Addresses {
+		addresses = append(addresses, resolver.Address{Addr: strings.TrimSpace(address)})
+	}
+	r := manual.NewBuilderWithScheme("peer")
+	r.InitialState(resolver.State{Addresses: addresses})
+
+	conn, err := grpc.NewClient(r.Scheme()+":///"+name,
+		grpc.WithResolvers(r),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"round_robin": {}}]}`),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.DefaultConfig,
+			MinConnectTimeout: peer.DialTimeout,
+		}),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                peer.KeepaliveTime,
+			Timeout:             peer.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithChainUnaryInterceptor(unaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(streamClientInterceptor),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create %s connection: %w", name, err)
+	}
+	conns[name] = conn
+	return conn, nil
+}
+
+// ServerOptions returns the options every service's gRPC server is created with. They let the
+// clients above ping idle connections to keep them alive, and run every call through the
+// interceptors: unary calls without a deadline get unaryTimeout, and streams are cancelled once
+// they have been idle for streamIdleTimeout.
+func ServerOptions(unaryTimeout, streamIdleTimeout time.Duration) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             minKeepaliveTime,
+			PermitWithoutStream: true,
+		}),
+		grpc.ChainUnaryInterceptor(unaryServerInterceptor(unaryTimeout)),
+		grpc.ChainStreamInterceptor(streamServerInterceptor(streamIdleTimeout)),
+	}
+}
@@ -0,0 +1,205 @@
+package grpcconn
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// requestIDKey is the metadata key carrying the request ID between services.
+	requestIDKey = "x-request-id"
+	// RequestIDHeader is the HTTP header carrying the request ID to and from the clients.
+	RequestIDHeader = "X-Request-Id"
+)
+
+type requestIDContextKey struct{}
+
+// WithRequestID returns a context carrying id, which is passed on to every service called with it.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// RequestID returns the ID of the request ctx belongs to, or "" if there is none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// NewRequestID returns a random request ID.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// fromIncoming returns ctx with the access token and request ID of the incoming metadata, as the
+// use cases and the outgoing calls expect them. Calls without a request ID are given a new one.
+func fromIncoming(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		fields := strings.Fields(value)
+		if len(fields) == 2 && strings.EqualFold(fields[0], "bearer") {
+			ctx = context.WithValue(ctx, "token", fields[1])
+			break
+		}
+	}
+
+	id := NewRequestID()
+	if ids := md.Get(requestIDKey); len(ids) > 0 && ids[0] != "" {
+		id = ids[0]
+	}
+	return WithRequestID(ctx, id)
+}
+
+// toOutgoing returns ctx with the access token and request ID it carries added to the outgoing
+// metadata, unless the caller set them already.
+func toOutgoing(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if token, ok := ctx.Value("token").(string); ok && token != "" && len(md.Get("authorization")) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	if id := RequestID(ctx); id != "" && len(md.Get(requestIDKey)) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, requestIDKey, id)
+	}
+	return ctx
+}
+
+// logCall logs a finished call with the level its code deserves.
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := zerolog.InfoLevel
+	switch code {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = zerolog.ErrorLevel
+	default:
+		level = zerolog.WarnLevel
+	}
+	event := log.WithLevel(level).
+		Str("method", method).
+		Str("code", code.String()).
+		Dur("duration", time.Since(start)).
+		Str("request_id", RequestID(ctx))
+	if err != nil {
+		event = event.Err(err)
+	}
+	event.Msg("grpc call")
+}
+
+// recoverPanic turns a panic of the handler into an internal error, so a single bad request
+// cannot bring the service down.
+func recoverPanic(ctx context.Context, method string, err *error) {
+	if r := recover(); r != nil {
+		log.Error().
+			Str("method", method).
+			Str("request_id", RequestID(ctx)).
+			Str("panic", fmt.Sprint(r)).
+			Bytes("stack", debug.Stack()).
+			Msg("grpc handler panicked")
+		*err = status.Error(codes.Internal, "internal error")
+	}
+}
+
+// unaryServerInterceptor is the interceptor chain of every unary call a service serves.
+func unaryServerInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res interface{}, err error) {
+		start := time.Now()
+		ctx = fromIncoming(ctx)
+		defer func() { logCall(ctx, info.FullMethod, start, err) }()
+		defer recoverPanic(ctx, info.FullMethod, &err)
+
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		res, err = handler(ctx, req)
+		return res, toStatus(err)
+	}
+}
+
+// serverStream replaces the context of a stream with one that is cancelled once no message has
+// been sent or received for idleTimeout. Streams carry whole uploads and downloads, so they are
+// bounded by how long they stall rather than by how long they run.
+type serverStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	idleTimeout time.Duration
+	idle        *time.Timer
+}
+
+// errStreamIdle is the cause of the cancellation of a stream that stalled.
+var errStreamIdle = errors.New("stream idle")
+
+func newServerStream(ss grpc.ServerStream, ctx context.Context, idleTimeout time.Duration) (*serverStream, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	s := &serverStream{ServerStream: ss, ctx: ctx, idleTimeout: idleTimeout}
+	s.idle = time.AfterFunc(idleTimeout, func() { cancel(errStreamIdle) })
+	return s, func() {
+		s.idle.Stop()
+		cancel(context.Canceled)
+	}
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg and RecvMsg restart the idle timer both before and after they run, so a message that
+// takes longer than idleTimeout to get through also counts as a stall.
+func (s *serverStream) SendMsg(m interface{}) error {
+	s.idle.Reset(s.idleTimeout)
+	defer s.idle.Reset(s.idleTimeout)
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	s.idle.Reset(s.idleTimeout)
+	defer s.idle.Reset(s.idleTimeout)
+	return s.ServerStream.RecvMsg(m)
+}
+
+// streamServerInterceptor is the interceptor chain of every stream a service serves. A handler
+// that fails after its stream stalled reports DeadlineExceeded, whatever error the cancellation
+// surfaced as.
+func streamServerInterceptor(idleTimeout time.Duration) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		start := time.Now()
+		ctx := fromIncoming(ss.Context())
+		defer func() { logCall(ctx, info.FullMethod, start, err) }()
+		defer recoverPanic(ctx, info.FullMethod, &err)
+
+		stream, stop := newServerStream(ss, ctx, idleTimeout)
+		defer stop()
+
+		err = handler(srv, stream)
+		if err != nil && errors.Is(context.Cause(stream.ctx), errStreamIdle) {
+			err = fmt.Errorf("%w for %s: %w", errStreamIdle, idleTimeout, context.DeadlineExceeded)
+		}
+		return toStatus(err)
+	}
+}
+
+// unaryClientInterceptor passes the access token and request ID of the caller on to the peer.
+func unaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(toOutgoing(ctx), method, req, reply, cc, opts...)
+}
+
+// streamClientInterceptor passes the access token and request ID of the caller on to the peer.
+func streamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(toOutgoing(ctx), desc, cc, method, opts...)
+}
@@ -0,0 +1,65 @@
+package grpcconn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerStream is a stream whose messages are sent and received instantly.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context     { return s.ctx }
+func (s *fakeServerStream) SendMsg(m interface{}) error  { return nil }
+func (s *fakeServerStream) RecvMsg(m interface{}) error  { return nil }
+func (s *fakeServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *fakeServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *fakeServerStream) SetTrailer(metadata.MD)       {}
+
+func TestStreamServerInterceptorIdleTimeout(t *testing.T) {
+	const idleTimeout = 100 * time.Millisecond
+	interceptor := streamServerInterceptor(idleTimeout)
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}
+
+	// A stream that keeps sending runs well past the idle timeout.
+	busy := func(srv interface{}, stream grpc.ServerStream) error {
+		for end := time.Now().Add(3 * idleTimeout); time.Now().Before(end); {
+			if err := stream.Context().Err(); err != nil {
+				return err
+			}
+			if err := stream.SendMsg(nil); err != nil {
+				return err
+			}
+			time.Sleep(idleTimeout / 4)
+		}
+		return nil
+	}
+	if err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, info, busy); err != nil {
+		t.Errorf("busy stream error = %v, want nil", err)
+	}
+
+	// A stream that stalls is cancelled and reported as past its deadline.
+	stalled := func(srv interface{}, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(nil); err != nil {
+			return err
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-time.After(10 * idleTimeout):
+			return nil
+		}
+	}
+	err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, info, stalled)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("stalled stream error = %v, want DeadlineExceeded", err)
+	}
+}
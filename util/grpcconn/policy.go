@@ -0,0 +1,189 @@
+package grpcconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen is returned without calling the peer while its circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Policy bounds the calls made to a peer. Every attempt gets CallTimeout. Idempotent calls that
+// fail because the peer could not be reached are retried up to MaxRetries times, waiting a
+// random time below an exponentially growing backoff in between. After BreakerFailures such
+// failures in a row the circuit breaker opens and calls fail fast for BreakerCooldown, after
+// which a single trial call decides whether it closes again.
+type Policy struct {
+	CallTimeout     time.Duration `mapstructure:"call_timeout"`
+	MaxRetries      int           `mapstructure:"max_retries"`
+	RetryBackoff    time.Duration `mapstructure:"retry_backoff"`
+	MaxRetryBackoff time.Duration `mapstructure:"max_retry_backoff"`
+	BreakerFailures int           `mapstructure:"breaker_failures"`
+	BreakerCooldown time.Duration `mapstructure:"breaker_cooldown"`
+}
+
+// Validate ensures that the calls to the peer named name are bounded.
+func (p Policy) Validate(name string) error {
+	if p.CallTimeout <= 0 {
+		return fmt.Errorf("%s call timeout must be positive", name)
+	}
+	if p.MaxRetries < 0 {
+		return fmt.Errorf("%s max retries cannot be negative", name)
+	}
+	if p.MaxRetries > 0 && p.RetryBackoff <= 0 {
+		return fmt.Errorf("%s retry backoff must be positive", name)
+	}
+	if p.MaxRetryBackoff < p.RetryBackoff {
+		return fmt.Errorf("%s max retry backoff cannot be below the retry backoff", name)
+	}
+	if p.BreakerFailures <= 0 {
+		return fmt.Errorf("%s breaker failures must be positive", name)
+	}
+	if p.BreakerCooldown <= 0 {
+		return fmt.Errorf("%s breaker cooldown must be positive", name)
+	}
+	return nil
+}
+
+// Caller applies the policy of a peer to the calls made to it.
+type Caller struct {
+	policy Policy
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+var callers = map[string]*Caller{}
+
+// CallerFor returns the caller of the peer named name, creating it on first use. It is shared
+// by all clients of the peer, so they trip and recover the circuit breaker together.
+func CallerFor(name string, policy Policy) *Caller {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if caller, ok := callers[name]; ok {
+		return caller
+	}
+	caller := &Caller{policy: policy}
+	callers[name] = caller
+	return caller
+}
+
+// Call runs call under the policy. Only idempotent calls are retried, as a call that timed out
+// may still have taken effect on the peer.
+func (c *Caller) Call(ctx context.Context, idempotent bool, call func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if !c.allow() {
+			// A retry that finds the breaker open reports the failure that opened it.
+			if err != nil {
+				return err
+			}
+			return ErrCircuitOpen
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, c.policy.CallTimeout)
+		err = call(callCtx)
+		cancel()
+		// A call cut short by the caller's own cancellation or deadline says nothing about the peer.
+		if ctx.Err() != nil {
+			return err
+		}
+		c.record(err)
+
+		if err == nil || !Unreachable(err) || !idempotent || attempt >= c.policy.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-time.After(c.backoff(attempt)):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// Stream runs stream, which opens a stream to the peer and sends or receives all its messages,
+// under the circuit breaker of the policy. It is neither given CallTimeout, as its length grows
+// with the data it carries, nor retried, as its messages cannot be replayed; the peer bounds
+// how long it waits for the next message instead.
+func (c *Caller) Stream(ctx context.Context, stream func(ctx context.Context) error) error {
+	if !c.allow() {
+		return ErrCircuitOpen
+	}
+	err := stream(ctx)
+	if ctx.Err() != nil {
+		return err
+	}
+	c.record(err)
+	return err
+}
+
+// backoff returns how long to wait before retrying the attempt: a random time below the retry
+// backoff doubled for every attempt so far, so clients that failed together do not retry together.
+func (c *Caller) backoff(attempt int) time.Duration {
+	ceiling := c.policy.RetryBackoff << attempt
+	if ceiling <= 0 || ceiling > c.policy.MaxRetryBackoff {
+		ceiling = c.policy.MaxRetryBackoff
+	}
+	return rand.N(ceiling) + 1
+}
+
+// allow reports whether a call may be sent. Once the cooldown of an open breaker has passed, one
+// trial call is let through and the others keep failing fast until it has an answer.
+func (c *Caller) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failures < c.policy.BreakerFailures {
+		return true
+	}
+	now := time.Now()
+	if now.Before(c.openUntil) {
+		return false
+	}
+	c.openUntil = now.Add(c.policy.BreakerCooldown)
+	return true
+}
+
+// record counts the failures to reach the peer. Any answer of the peer, errors included, shows
+// that it is reachable and closes the breaker.
+func (c *Caller) record(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil || !Unreachable(err) {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	if c.failures >= c.policy.BreakerFailures {
+		c.openUntil = time.Now().Add(c.policy.BreakerCooldown)
+	}
+}
+
+// Unreachable reports whether err means that the peer could not be reached or did not answer in
+// time, rather than that it answered with an error. An answer keeps its code: a peer that reports
+// ErrAuthUnavailable as Unavailable was reachable.
+func Unreachable(err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return !answered(st)
+	}
+	return false
+}
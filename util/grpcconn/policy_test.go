@@ -0,0 +1,111 @@
+package grpcconn
+
+import (
+	"context"
+	"errors"
+	"library-management-api/util/errorhandler"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testPolicy = Policy{
+	CallTimeout:     time.Second,
+	MaxRetries:      2,
+	RetryBackoff:    time.Millisecond,
+	MaxRetryBackoff: time.Millisecond,
+	BreakerFailures: 2,
+	BreakerCooldown: time.Minute,
+}
+
+func TestCallerOpensOnUnreachablePeer(t *testing.T) {
+	caller := CallerFor(t.Name(), testPolicy)
+
+	calls := 0
+	unreachable := func(ctx context.Context) error {
+		calls++
+		return status.Error(codes.Unavailable, "connection refused")
+	}
+	if err := caller.Call(context.Background(), true, unreachable); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Call() error = %v, want Unavailable", err)
+	}
+	if calls != testPolicy.BreakerFailures {
+		t.Errorf("got %d calls, want %d before the breaker opens", calls, testPolicy.BreakerFailures)
+	}
+
+	calls = 0
+	if err := caller.Call(context.Background(), true, unreachable); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Call() error = %v, want ErrCircuitOpen", err)
+	}
+	if calls != 0 {
+		t.Errorf("got %d calls through an open breaker, want 0", calls)
+	}
+}
+
+func TestCallerIgnoresAnswers(t *testing.T) {
+	caller := CallerFor(t.Name(), testPolicy)
+
+	// The peer is up and answers that the auth service behind it is not.
+	calls := 0
+	authUnavailable := func(ctx context.Context) error {
+		calls++
+		return toStatus(errorhandler.ErrAuthUnavailable)
+	}
+	for i := 0; i < 2*testPolicy.BreakerFailures; i++ {
+		err := caller.Call(context.Background(), true, authUnavailable)
+		if !errors.Is(FromStatus(err), errorhandler.ErrAuthUnavailable) {
+			t.Fatalf("Call() error = %v, want ErrAuthUnavailable", err)
+		}
+	}
+	if want := 2 * testPolicy.BreakerFailures; calls != want {
+		t.Errorf("got %d calls, want %d: answers are neither retried nor open the breaker", calls, want)
+	}
+}
+
+func TestCallerIgnoresCallerContext(t *testing.T) {
+	caller := CallerFor(t.Name(), testPolicy)
+
+	calls := 0
+	wait := func(ctx context.Context) error {
+		calls++
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	for i := 0; i < 2*testPolicy.BreakerFailures; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		err := caller.Call(ctx, true, wait)
+		cancel()
+		if status.Code(err) != codes.DeadlineExceeded {
+			t.Fatalf("Call() error = %v, want DeadlineExceeded", err)
+		}
+	}
+	if want := 2 * testPolicy.BreakerFailures; calls != want {
+		t.Errorf("got %d calls, want %d: deadlines of the caller are neither retried nor open the breaker", calls, want)
+	}
+}
+
+func TestCallerStreamIsNotRetried(t *testing.T) {
+	caller := CallerFor(t.Name(), testPolicy)
+
+	calls := 0
+	unreachable := func(ctx context.Context) error {
+		calls++
+		if _, ok := ctx.Deadline(); ok {
+			t.Error("stream got a deadline")
+		}
+		return status.Error(codes.Unavailable, "connection refused")
+	}
+	for i := 0; i < testPolicy.BreakerFailures; i++ {
+		if err := caller.Stream(context.Background(), unreachable); status.Code(err) != codes.Unavailable {
+			t.Fatalf("Stream() error = %v, want Unavailable", err)
+		}
+	}
+	if calls != testPolicy.BreakerFailures {
+		t.Errorf("got %d calls, want %d", calls, testPolicy.BreakerFailures)
+	}
+	if err := caller.Stream(context.Background(), unreachable); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Stream() error = %v, want ErrCircuitOpen", err)
+	}
+}
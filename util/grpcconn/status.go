@@ -0,0 +1,164 @@
+package grpcconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// handlerError is attached to the statuses of the sentinels returned by a handler. It tells an
+// answer of the peer apart from a status with the same code that gRPC reports when the call did
+// not get through: ErrAuthUnavailable is reported as Unavailable, but the peer did answer.
+var handlerError = &errdetails.ErrorInfo{Reason: "HANDLER_ERROR", Domain: "library-management-api"}
+
+// statusCodes maps the errorhandler sentinels to the gRPC codes they are reported with.
+var statusCodes = []struct {
+	code codes.Code
+	errs []error
+}{
+	{codes.Unauthenticated, []error{
+		errorhandler.ErrInvalidSession, errorhandler.ErrSessionNotFound, errorhandler.ErrSessionRevoked,
+		errorhandler.ErrInvalidCredentials,
+	}},
+	{codes.PermissionDenied, []error{
+		errorhandler.ErrForbidden,
+	}},
+	{codes.NotFound, []error{
+		errorhandler.ErrUserNotFound, errorhandler.ErrBookNotFound, errorhandler.ErrWorkNotFound,
+		errorhandler.ErrSeriesNotFound, errorhandler.ErrAuthorNotFound, errorhandler.ErrSubjectNotFound,
+		errorhandler.ErrParentNotFound, errorhandler.ErrGenreNotFound, errorhandler.ErrTagNotFound,
+		errorhandler.ErrCoverNotFound, errorhandler.ErrFileNotFound, errorhandler.ErrCopyNotFound,
+		errorhandler.ErrLoanNotFound, errorhandler.ErrHoldNotFound,
+	}},
+	{codes.AlreadyExists, []error{
+		errorhandler.ErrDuplicateUsername, errorhandler.ErrDuplicateISBN, errorhandler.ErrDuplicateAuthor,
+		errorhandler.ErrDuplicateSubject, errorhandler.ErrDuplicateGenre, errorhandler.ErrDuplicateTag,
+		errorhandler.ErrDuplicateBarcode, errorhandler.ErrDuplicateHold,
+	}},
+	{codes.InvalidArgument, []error{
+		errorhandler.ErrInvalidCategoryType, errorhandler.ErrEmptyCategoryValue, errorhandler.ErrInvalidSearchQuery,
+		errorhandler.ErrEmptySuggestPrefix, errorhandler.ErrInvalidISBN, errorhandler.ErrISBNMismatch,
+		errorhandler.ErrBookAuthorRequired, errorhandler.ErrInvalidBookFormat, errorhandler.ErrSeriesRequired,
+		errorhandler.ErrWorkTitleRequired, errorhandler.ErrInvalidAuthorName, errorhandler.ErrUnknownSubject,
+		errorhandler.ErrUnknownGenre, errorhandler.ErrInvalidTermName, errorhandler.ErrMergeIntoSelf,
+		errorhandler.ErrInvalidTagName, errorhandler.ErrUnsupportedCoverType, errorhandler.ErrInvalidCover,
+		errorhandler.ErrInvalidCopyStatus, errorhandler.ErrInvalidFineAmount, errorhandler.ErrInvalidImportFormat,
+		errorhandler.ErrInvalidImportFile, errorhandler.ErrInvalidMarcFormat, errorhandler.ErrInvalidMarcRecord, errorhandler.ErrInvalidExportFormat,
+		errorhandler.ErrInvalidListQuery, errorhandler.ErrInvalidBookCondition,
+	}},
+	{codes.ResourceExhausted, []error{
+		errorhandler.ErrCoverTooLarge, errorhandler.ErrImportTooLarge,
+	}},
+	{codes.FailedPrecondition, []error{
+		errorhandler.ErrBookAlreadyBorrowed, errorhandler.ErrBookAlreadyAvailable, errorhandler.ErrBorrowerIDMismatch,
+		errorhandler.ErrAuthorHasBooks, errorhandler.ErrSubjectCycle, errorhandler.ErrSubjectInUse,
+		errorhandler.ErrGenreInUse, errorhandler.ErrCopyBorrowed, errorhandler.ErrCopyOnHold,
+		errorhandler.ErrLoanLimitReached, errorhandler.ErrRenewalLimitReached, errorhandler.ErrHoldNotNeeded,
+		errorhandler.ErrHoldNotOpen, errorhandler.ErrBookOnHold, errorhandler.ErrHoldOnOwnLoan,
+		errorhandler.ErrOutstandingFines, errorhandler.ErrFineExceedsBalance, errorhandler.ErrBookHasLoans,
+	}},
+	{codes.Unavailable, []error{
+		errorhandler.ErrServiceUnavailable, errorhandler.ErrAuthUnavailable,
+	}},
+}
+
+// httpStatuses are the HTTP statuses the codes of statusCodes are answered with.
+var httpStatuses = map[codes.Code]int{
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.ResourceExhausted:  http.StatusRequestEntityTooLarge,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+}
+
+// toStatus converts an error returned by a handler to a gRPC status error. Errors that are
+// already statuses are kept, sentinels get their code and the handlerError detail, and anything
+// else is internal.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	for _, s := range statusCodes {
+		for _, sentinel := range s.errs {
+			if errors.Is(err, sentinel) {
+				st, detailErr := status.New(s.code, err.Error()).WithDetails(handlerError)
+				if detailErr != nil {
+					return status.Error(s.code, err.Error())
+				}
+				return st.Err()
+			}
+		}
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// FromStatus converts a status error returned by a peer back to the sentinel it was reported
+// for, so that callers can tell the errors of the peer apart with errors.Is as if they had been
+// returned locally. The message of the peer is kept. A peer that could not be reached, or whose
+// circuit is open, gives ErrServiceUnavailable; any other error is returned unchanged.
+func FromStatus(err error) error {
+	if st, ok := status.FromError(err); ok && err != nil {
+		msg := st.Message()
+		for _, s := range statusCodes {
+			if s.code != st.Code() {
+				continue
+			}
+			for _, sentinel := range s.errs {
+				if msg == sentinel.Error() {
+					return sentinel
+				}
+				if rest, ok := strings.CutPrefix(msg, sentinel.Error()+": "); ok {
+					return fmt.Errorf("%w: %s", sentinel, rest)
+				}
+			}
+		}
+	}
+	if Unreachable(err) {
+		return errorhandler.ErrServiceUnavailable
+	}
+	return err
+}
+
+// answered reports whether st carries the handlerError detail, that is whether it was returned by
+// a handler of the peer.
+func answered(st *status.Status) bool {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == handlerError.Domain && info.Reason == handlerError.Reason {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPStatus returns the HTTP status an error of a peer, as returned by FromStatus, is answered
+// with: the status of the code its sentinel is reported with, or 500 for any other error.
+// Handlers that answer a sentinel differently check for it before.
+func HTTPStatus(err error) int {
+	for _, s := range statusCodes {
+		for _, sentinel := range s.errs {
+			if errors.Is(err, sentinel) {
+				return httpStatuses[s.code]
+			}
+		}
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,141 @@
+package grpcconn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"library-management-api/util/errorhandler"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		want    codes.Code
+		wantMsg string
+	}{
+		{name: "not found", err: errorhandler.ErrBookNotFound, want: codes.NotFound, wantMsg: "book not found"},
+		{name: "wrapped not found", err: fmt.Errorf("%w: 7", errorhandler.ErrAuthorNotFound), want: codes.NotFound, wantMsg: "author not found: 7"},
+		{name: "permission denied", err: errorhandler.ErrForbidden, want: codes.PermissionDenied},
+		{name: "unauthenticated", err: errorhandler.ErrInvalidSession, want: codes.Unauthenticated},
+		{name: "auth unavailable", err: errorhandler.ErrAuthUnavailable, want: codes.Unavailable},
+		{name: "service unavailable", err: errorhandler.ErrServiceUnavailable, want: codes.Unavailable},
+		{name: "already exists", err: errorhandler.ErrDuplicateISBN, want: codes.AlreadyExists},
+		{name: "invalid argument", err: errorhandler.ErrInvalidISBN, want: codes.InvalidArgument},
+		{name: "failed precondition", err: errorhandler.ErrBookAlreadyBorrowed, want: codes.FailedPrecondition},
+		{name: "resource exhausted", err: errorhandler.ErrCoverTooLarge, want: codes.ResourceExhausted},
+		{name: "deadline", err: fmt.Errorf("query: %w", context.DeadlineExceeded), want: codes.DeadlineExceeded},
+		{name: "canceled", err: context.Canceled, want: codes.Canceled},
+		{name: "status kept", err: status.Error(codes.ResourceExhausted, "slow down"), want: codes.ResourceExhausted, wantMsg: "slow down"},
+		{name: "anything else", err: errors.New("connection reset"), want: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toStatus(tt.err)
+			if code := status.Code(got); code != tt.want {
+				t.Errorf("toStatus(%v) code = %v, want %v", tt.err, code, tt.want)
+			}
+			if tt.wantMsg != "" && status.Convert(got).Message() != tt.wantMsg {
+				t.Errorf("toStatus(%v) message = %q, want %q", tt.err, status.Convert(got).Message(), tt.wantMsg)
+			}
+		})
+	}
+
+	if err := toStatus(nil); err != nil {
+		t.Errorf("toStatus(nil) = %v, want nil", err)
+	}
+}
+
+// TestStatusCodesAreUnambiguous makes sure every sentinel has a single code and a message of its
+// own, which FromStatus relies on to find it again.
+func TestStatusCodesAreUnambiguous(t *testing.T) {
+	seen := map[string]codes.Code{}
+	for _, s := range statusCodes {
+		for _, sentinel := range s.errs {
+			if code, ok := seen[sentinel.Error()]; ok {
+				t.Errorf("%q is listed under %v and %v", sentinel, code, s.code)
+			}
+			seen[sentinel.Error()] = s.code
+		}
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	for _, s := range statusCodes {
+		if _, ok := httpStatuses[s.code]; !ok {
+			t.Errorf("%v has no HTTP status", s.code)
+		}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "not found", err: errorhandler.ErrBookNotFound, want: http.StatusNotFound},
+		{name: "wrapped", err: FromStatus(toStatus(fmt.Errorf("%w: 7", errorhandler.ErrInvalidListQuery))), want: http.StatusBadRequest},
+		{name: "conflict", err: errorhandler.ErrLoanLimitReached, want: http.StatusConflict},
+		{name: "too large", err: errorhandler.ErrImportTooLarge, want: http.StatusRequestEntityTooLarge},
+		{name: "unreachable peer", err: FromStatus(status.Error(codes.Unavailable, "connection refused")), want: http.StatusServiceUnavailable},
+		{name: "internal", err: FromStatus(status.Error(codes.Internal, "boom")), want: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HTTPStatus(tt.err); got != tt.want {
+				t.Errorf("HTTPStatus(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromStatus(t *testing.T) {
+	for _, s := range statusCodes {
+		for _, sentinel := range s.errs {
+			if got := FromStatus(toStatus(sentinel)); got != sentinel {
+				t.Errorf("FromStatus(toStatus(%q)) = %v", sentinel, got)
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		want    error
+		wantMsg string
+	}{
+		{name: "wrapped", err: toStatus(fmt.Errorf("%w: 7", errorhandler.ErrAuthorNotFound)), want: errorhandler.ErrAuthorNotFound, wantMsg: "author not found: 7"},
+		{name: "unreachable peer", err: status.Error(codes.Unavailable, "connection refused"), want: errorhandler.ErrServiceUnavailable},
+		{name: "peer timed out", err: status.Error(codes.DeadlineExceeded, "context deadline exceeded"), want: errorhandler.ErrServiceUnavailable},
+		{name: "circuit open", err: ErrCircuitOpen, want: errorhandler.ErrServiceUnavailable},
+		{name: "peer reports auth unavailable", err: toStatus(errorhandler.ErrAuthUnavailable), want: errorhandler.ErrAuthUnavailable},
+		{name: "code and message disagree", err: status.Error(codes.NotFound, errorhandler.ErrForbidden.Error())},
+		{name: "internal", err: status.Error(codes.Internal, "boom")},
+		{name: "not a status", err: errors.New("boom")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromStatus(tt.err)
+			if tt.want == nil {
+				if got != tt.err {
+					t.Errorf("FromStatus(%v) = %v, want it unchanged", tt.err, got)
+				}
+				return
+			}
+			if !errors.Is(got, tt.want) {
+				t.Errorf("FromStatus(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+			if tt.wantMsg != "" && got.Error() != tt.wantMsg {
+				t.Errorf("FromStatus(%v) message = %q, want %q", tt.err, got.Error(), tt.wantMsg)
+			}
+		})
+	}
+
+	if err := FromStatus(nil); err != nil {
+		t.Errorf("FromStatus(nil) = %v, want nil", err)
+	}
+}
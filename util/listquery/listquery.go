@@ -0,0 +1,183 @@
+// Package listquery is the list model shared by the services: which page of a list
+// to return, in what order, and the metadata needed to fetch the next page.
+package listquery
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"library-management-api/util/errorhandler"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Order is the direction a list is sorted in.
+type Order string
+
+const (
+	Asc  Order = "asc"
+	Desc Order = "desc"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// Query selects one page of a list. Pagination is keyset based when Cursor is set and
+// offset based on Page otherwise.
+type Query struct {
+	Limit  int
+	Page   int
+	Cursor string
+	Sort   string
+	Order  Order
+}
+
+// Page is one page of a list. Total counts every item matching the filters and
+// NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T
+	Total      int
+	NextCursor string
+}
+
+// Normalize fills in the defaults for unset fields and validates the rest.
+func (q Query) Normalize() (Query, error) {
+	if q.Limit == 0 {
+		q.Limit = DefaultLimit
+	}
+	if q.Limit < 0 || q.Limit > MaxLimit {
+		return Query{}, fmt.Errorf("%w: limit must be between 1 and %d", errorhandler.ErrInvalidListQuery, MaxLimit)
+	}
+	if q.Page == 0 {
+		q.Page = 1
+	}
+	if q.Page < 0 {
+		return Query{}, fmt.Errorf("%w: page must be at least 1", errorhandler.ErrInvalidListQuery)
+	}
+	if q.Order == "" {
+		q.Order = Asc
+	}
+	q.Order = Order(strings.ToLower(string(q.Order)))
+	if q.Order != Asc && q.Order != Desc {
+		return Query{}, fmt.Errorf("%w: order must be one of 'asc' or 'desc'", errorhandler.ErrInvalidListQuery)
+	}
+	return q, nil
+}
+
+// Cursor marks the last item of a page by its sort value and ID.
+type Cursor struct {
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+// Encode turns the cursor into the opaque token handed to clients.
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a token produced by Cursor.Encode.
+func DecodeCursor(token string) (Cursor, error) {
+	var c Cursor
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed cursor", errorhandler.ErrInvalidListQuery)
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed cursor", errorhandler.ErrInvalidListQuery)
+	}
+	return c, nil
+}
+
+// Column is a sortable column. Expr is the SQL expression rows are ordered by and must not
+// be NULL; Cast is the SQL type a cursor value is converted to before it is compared with Expr.
+type Column struct {
+	Expr string
+	Cast string
+}
+
+// Sorting lists the columns a list may be sorted by, keyed by their public name.
+// ID is the unique column that breaks ties between rows with equal sort values.
+type Sorting struct {
+	Columns map[string]Column
+	Default string
+	ID      string
+}
+
+// Builder assembles the WHERE, ORDER BY and LIMIT clauses of a list query together with
+// their positional arguments.
+type Builder struct {
+	conds []string
+	args  []interface{}
+}
+
+// Arg registers a query argument and returns its placeholder.
+func (b *Builder) Arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// Where adds a condition that every row of the list has to satisfy.
+func (b *Builder) Where(cond string) {
+	b.conds = append(b.conds, cond)
+}
+
+// Filter returns the WHERE clause built so far and its arguments, as used to count the list.
+func (b *Builder) Filter() (string, []interface{}) {
+	if len(b.conds) == 0 {
+		return "", b.args
+	}
+	return " WHERE " + strings.Join(b.conds, " AND "), b.args
+}
+
+// Page returns the clauses selecting the page described by q and their arguments. One row more
+// than the limit is requested so the caller can tell whether another page follows.
+func (b *Builder) Page(q Query, s Sorting) (string, []interface{}, error) {
+	if q.Sort == "" {
+		q.Sort = s.Default
+	}
+	col, ok := s.Columns[q.Sort]
+	if !ok {
+		names := make([]string, 0, len(s.Columns))
+		for name := range s.Columns {
+			names = append(names, "'"+name+"'")
+		}
+		sort.Strings(names)
+		return "", nil, fmt.Errorf("%w: sort must be one of %s", errorhandler.ErrInvalidListQuery, strings.Join(names, ", "))
+	}
+
+	page := Builder{
+		conds: append([]string(nil), b.conds...),
+		args:  append([]interface{}(nil), b.args...),
+	}
+	direction, comparison := "ASC", ">"
+	if q.Order == Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	offset := ""
+	if q.Cursor != "" {
+		c, err := DecodeCursor(q.Cursor)
+		if err != nil {
+			return "", nil, err
+		}
+		page.Where(fmt.Sprintf("(%s, %s) %s (%s::text::%s, %s)", col.Expr, s.ID, comparison, page.Arg(c.Value), col.Cast, page.Arg(c.ID)))
+	} else if q.Page > 1 {
+		offset = " OFFSET " + page.Arg((q.Page-1)*q.Limit)
+	}
+
+	clause, _ := page.Filter()
+	clause += fmt.Sprintf(" ORDER BY %s %s, %s %s LIMIT %s", col.Expr, direction, s.ID, direction, page.Arg(q.Limit+1))
+	return clause + offset, page.args, nil
+}
+
+// Trim cuts the extra row requested by Builder.Page off items and reports whether it was there.
+func Trim[T any](items []T, q Query) ([]T, bool) {
+	if len(items) > q.Limit {
+		return items[:q.Limit], true
+	}
+	return items, false
+}
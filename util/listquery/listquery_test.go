@@ -0,0 +1,183 @@
+package listquery
+
+import (
+	"errors"
+	"library-management-api/util/errorhandler"
+	"reflect"
+	"testing"
+)
+
+var testSorting = Sorting{
+	Columns: map[string]Column{
+		"title": {Expr: "b.title", Cast: "text"},
+		"year":  {Expr: "b.published_year", Cast: "int"},
+	},
+	Default: "title",
+	ID:      "b.id",
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   Query
+		want    Query
+		wantErr bool
+	}{
+		{name: "defaults", query: Query{}, want: Query{Limit: DefaultLimit, Page: 1, Order: Asc}},
+		{name: "order is case insensitive", query: Query{Limit: 5, Page: 2, Order: "DESC"}, want: Query{Limit: 5, Page: 2, Order: Desc}},
+		{name: "max limit", query: Query{Limit: MaxLimit}, want: Query{Limit: MaxLimit, Page: 1, Order: Asc}},
+		{name: "limit too large", query: Query{Limit: MaxLimit + 1}, wantErr: true},
+		{name: "negative limit", query: Query{Limit: -1}, wantErr: true},
+		{name: "negative page", query: Query{Page: -1}, wantErr: true},
+		{name: "unknown order", query: Query{Order: "up"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Normalize()
+			if tt.wantErr {
+				if !errors.Is(err, errorhandler.ErrInvalidListQuery) {
+					t.Fatalf("Normalize() error = %v, want ErrInvalidListQuery", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Normalize() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuilderPage(t *testing.T) {
+	cursor := Cursor{Value: "Dune", ID: 42}.Encode()
+
+	tests := []struct {
+		name       string
+		conds      func(b *Builder)
+		query      Query
+		wantClause string
+		wantArgs   []interface{}
+	}{
+		{
+			name:       "first page",
+			query:      Query{Limit: 10, Page: 1, Order: Asc},
+			wantClause: " ORDER BY b.title ASC, b.id ASC LIMIT $1",
+			wantArgs:   []interface{}{11},
+		},
+		{
+			name:       "offset page",
+			query:      Query{Limit: 10, Page: 3, Sort: "year", Order: Desc},
+			wantClause: " ORDER BY b.published_year DESC, b.id DESC LIMIT $2 OFFSET $1",
+			wantArgs:   []interface{}{20, 11},
+		},
+		{
+			name: "filters come first",
+			conds: func(b *Builder) {
+				b.Where("b.genre = " + b.Arg("Drama"))
+				b.Where("b.published_year >= " + b.Arg(1990))
+			},
+			query:      Query{Limit: 5, Page: 2, Order: Asc},
+			wantClause: " WHERE b.genre = $1 AND b.published_year >= $2 ORDER BY b.title ASC, b.id ASC LIMIT $4 OFFSET $3",
+			wantArgs:   []interface{}{"Drama", 1990, 5, 6},
+		},
+		{
+			name:       "cursor ascending",
+			query:      Query{Limit: 10, Page: 1, Cursor: cursor, Order: Asc},
+			wantClause: " WHERE (b.title, b.id) > ($1::text::text, $2) ORDER BY b.title ASC, b.id ASC LIMIT $3",
+			wantArgs:   []interface{}{"Dune", uint(42), 11},
+		},
+		{
+			name: "cursor descending ignores page",
+			conds: func(b *Builder) {
+				b.Where("b.genre = " + b.Arg("Drama"))
+			},
+			query:      Query{Limit: 10, Page: 4, Cursor: cursor, Sort: "year", Order: Desc},
+			wantClause: " WHERE b.genre = $1 AND (b.published_year, b.id) < ($2::text::int, $3) ORDER BY b.published_year DESC, b.id DESC LIMIT $4",
+			wantArgs:   []interface{}{"Drama", "Dune", uint(42), 11},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Builder
+			if tt.conds != nil {
+				tt.conds(&b)
+			}
+			clause, args, err := b.Page(tt.query, testSorting)
+			if err != nil {
+				t.Fatalf("Page() error = %v", err)
+			}
+			if clause != tt.wantClause {
+				t.Errorf("Page() clause = %q, want %q", clause, tt.wantClause)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Page() args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestBuilderPageLeavesFilterUntouched(t *testing.T) {
+	var b Builder
+	b.Where("b.genre = " + b.Arg("Drama"))
+
+	_, _, err := b.Page(Query{Limit: 10, Cursor: Cursor{Value: "Dune", ID: 1}.Encode(), Order: Asc}, testSorting)
+	if err != nil {
+		t.Fatalf("Page() error = %v", err)
+	}
+
+	clause, args := b.Filter()
+	if clause != " WHERE b.genre = $1" {
+		t.Errorf("Filter() clause = %q", clause)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"Drama"}) {
+		t.Errorf("Filter() args = %v", args)
+	}
+}
+
+func TestBuilderPageRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+	}{
+		{name: "unknown sort", query: Query{Limit: 10, Sort: "price", Order: Asc}},
+		{name: "cursor not base64", query: Query{Limit: 10, Cursor: "not a cursor!", Order: Asc}},
+		{name: "cursor not json", query: Query{Limit: 10, Cursor: "bm90IGpzb24", Order: Asc}},
+		{name: "cursor with wrong types", query: Query{Limit: 10, Cursor: "eyJ2IjoxLCJpZCI6IngifQ", Order: Asc}},
+		{name: "cursor with negative id", query: Query{Limit: 10, Cursor: "eyJ2IjoiYSIsImlkIjotMX0", Order: Asc}},
+		{name: "cursor with padding", query: Query{Limit: 10, Cursor: Cursor{Value: "Dune", ID: 4}.Encode() + "=", Order: Asc}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Builder
+			_, _, err := b.Page(tt.query, testSorting)
+			if !errors.Is(err, errorhandler.ErrInvalidListQuery) {
+				t.Errorf("Page() error = %v, want ErrInvalidListQuery", err)
+			}
+		})
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, c := range []Cursor{{}, {Value: "Dune", ID: 42}, {Value: `quote " and ünïcode`, ID: 1}} {
+		got, err := DecodeCursor(c.Encode())
+		if err != nil {
+			t.Fatalf("DecodeCursor(%+v) error = %v", c, err)
+		}
+		if got != c {
+			t.Errorf("DecodeCursor() = %+v, want %+v", got, c)
+		}
+	}
+}
+
+func TestTrim(t *testing.T) {
+	items, more := Trim([]int{1, 2, 3}, Query{Limit: 2})
+	if !reflect.DeepEqual(items, []int{1, 2}) || !more {
+		t.Errorf("Trim() = %v, %v, want [1 2], true", items, more)
+	}
+	items, more = Trim([]int{1, 2}, Query{Limit: 2})
+	if !reflect.DeepEqual(items, []int{1, 2}) || more {
+		t.Errorf("Trim() = %v, %v, want [1 2], false", items, more)
+	}
+}